@@ -38,11 +38,21 @@ var agentCmd = &cobra.Command{
 	Short: "Start the heist agent",
 	ValidArgs: []string{
 		"--address",
+		"--api-token-path",
 		"--client-config-name",
 		"--client-config-namespace",
 		"--kubernetes-config-path",
 		"--kubernetes-master-url",
+		"--retry-jitter",
+		"--retry-max-attempts",
+		"--retry-max-delay",
+		"--retry-max-elapsed-time",
+		"--retry-min-delay",
+		"--retry-network-errors",
+		"--retry-status-codes",
 		"--secret-base-path",
+		"--tracing-endpoint",
+		"--tracing-sample-ratio",
 	},
 }
 
@@ -111,11 +121,86 @@ func init() {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	})
 
+	agentCmd.PersistentFlags().String("api-token-path", defaultConfig.Agent.APITokenPath, "Path the token for the agent API is written to. Defaults to agent-token in the secret base path.")
+	_ = viper.BindPFlag("agent.api_token_path", agentCmd.PersistentFlags().Lookup("api-token-path"))
+	_ = agentCmd.RegisterFlagCompletionFunc("api-token-path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveDefault
+	})
+
 	agentCmd.PersistentFlags().String("secret-base-path", defaultConfig.Agent.SecretBasePath, "Base path for secrets synced by the agent.")
 	_ = viper.BindPFlag("agent.secret_base_path", agentCmd.PersistentFlags().Lookup("secret-base-path"))
 	_ = agentCmd.RegisterFlagCompletionFunc("secret-base-path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return nil, cobra.ShellCompDirectiveDefault
 	})
+
+	agentCmd.PersistentFlags().Int("retry-max-attempts", defaultConfig.Agent.RetryMaxAttempts, "Maximum number of attempts of a request to Vault, including the first one.")
+	_ = viper.BindPFlag("agent.retry_max_attempts", agentCmd.PersistentFlags().Lookup("retry-max-attempts"))
+	_ = agentCmd.RegisterFlagCompletionFunc("retry-max-attempts", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().Duration("retry-min-delay", defaultConfig.Agent.RetryMinDelay, "Delay before the first retry of a failed request to Vault, it doubles with every further attempt.")
+	_ = viper.BindPFlag("agent.retry_min_delay", agentCmd.PersistentFlags().Lookup("retry-min-delay"))
+	_ = agentCmd.RegisterFlagCompletionFunc("retry-min-delay", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().Duration("retry-max-delay", defaultConfig.Agent.RetryMaxDelay, "Maximum delay between two attempts of a request to Vault.")
+	_ = viper.BindPFlag("agent.retry_max_delay", agentCmd.PersistentFlags().Lookup("retry-max-delay"))
+	_ = agentCmd.RegisterFlagCompletionFunc("retry-max-delay", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().Duration("retry-max-elapsed-time", defaultConfig.Agent.RetryMaxElapsedTime, "Time after which failed requests to Vault are no longer retried. Set to 0 to disable the limit.")
+	_ = viper.BindPFlag("agent.retry_max_elapsed_time", agentCmd.PersistentFlags().Lookup("retry-max-elapsed-time"))
+	_ = agentCmd.RegisterFlagCompletionFunc("retry-max-elapsed-time", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().Float64("retry-jitter", defaultConfig.Agent.RetryJitter, "Fraction of the retry delay which is randomized, between 0 and 1.")
+	_ = viper.BindPFlag("agent.retry_jitter", agentCmd.PersistentFlags().Lookup("retry-jitter"))
+	_ = agentCmd.RegisterFlagCompletionFunc("retry-jitter", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().StringSlice("retry-status-codes", defaultConfig.Agent.RetryStatusCodes, "Status codes or classes of Vault responses which are retried, e.g. 429 or 5xx. POST requests are only retried for 429 and 503.")
+	_ = viper.BindPFlag("agent.retry_status_codes", agentCmd.PersistentFlags().Lookup("retry-status-codes"))
+	_ = agentCmd.RegisterFlagCompletionFunc("retry-status-codes", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().Bool("retry-network-errors", defaultConfig.Agent.RetryNetworkErrors, "Retry requests to Vault which failed without receiving a response.")
+	_ = viper.BindPFlag("agent.retry_network_errors", agentCmd.PersistentFlags().Lookup("retry-network-errors"))
+
+	agentCmd.PersistentFlags().Float64("rate-limit", defaultConfig.Agent.RateLimit, "Maximum number of requests per second sent to Vault. Set to 0 to disable the limit.")
+	_ = viper.BindPFlag("agent.rate_limit", agentCmd.PersistentFlags().Lookup("rate-limit"))
+	_ = agentCmd.RegisterFlagCompletionFunc("rate-limit", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().Int("rate-limit-burst", defaultConfig.Agent.RateLimitBurst, "Number of requests which may be sent to Vault at once before the rate limit applies.")
+	_ = viper.BindPFlag("agent.rate_limit_burst", agentCmd.PersistentFlags().Lookup("rate-limit-burst"))
+	_ = agentCmd.RegisterFlagCompletionFunc("rate-limit-burst", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().Int("max-in-flight-requests", defaultConfig.Agent.MaxInFlightRequests, "Maximum number of concurrent requests to Vault. Set to 0 to disable the limit.")
+	_ = viper.BindPFlag("agent.max_in_flight_requests", agentCmd.PersistentFlags().Lookup("max-in-flight-requests"))
+	_ = agentCmd.RegisterFlagCompletionFunc("max-in-flight-requests", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().String("tracing-endpoint", defaultConfig.Agent.TracingEndpoint, "URL of the OTLP HTTP receiver traces are exported to. Tracing is disabled if neither this flag nor OTEL_EXPORTER_OTLP_ENDPOINT is set.")
+	_ = viper.BindPFlag("agent.tracing_endpoint", agentCmd.PersistentFlags().Lookup("tracing-endpoint"))
+	_ = agentCmd.RegisterFlagCompletionFunc("tracing-endpoint", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	agentCmd.PersistentFlags().Float64("tracing-sample-ratio", defaultConfig.Agent.TracingSampleRatio, "Fraction of traces which are sampled, between 0 and 1.")
+	_ = viper.BindPFlag("agent.tracing_sample_ratio", agentCmd.PersistentFlags().Lookup("tracing-sample-ratio"))
+	_ = agentCmd.RegisterFlagCompletionFunc("tracing-sample-ratio", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
 }
 
 func createHeistClientSet() (*heist.Clientset, error) {
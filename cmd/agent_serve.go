@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 
 	"github.com/spf13/cobra"
@@ -46,14 +47,28 @@ var serveCmd = &cobra.Command{
 		heistConfig := &HeistConfig{}
 		cobra.CheckErr(viper.Unmarshal(heistConfig))
 
+		shutdownTracer, err := heistConfig.Agent.setupTracing("heist-agent")
+		cobra.CheckErr(err)
+		defer shutdownTracing(shutdownTracer)
+
+		retryPolicy, err := heistConfig.Agent.createRetryPolicy()
+		cobra.CheckErr(err)
+
 		instance, err := agent.New(
 			agent.WithKubeConfig(heistConfig.Agent.KubernetesMasterURL, heistConfig.Agent.KubernetesConfigPath),
 			agent.WithClientConfig(heistConfig.Agent.ClientConfigNamespace, heistConfig.Agent.ClientConfigName),
 			agent.WithBasePath(heistConfig.Agent.SecretBasePath),
+			agent.WithRetryPolicy(retryPolicy),
+			agent.WithRequestLimits(heistConfig.Agent.createRequestLimits()),
 		)
 		cobra.CheckErr(err)
 
-		server := agentserver.New(instance)
+		apiTokenPath := heistConfig.Agent.APITokenPath
+		if apiTokenPath == "" {
+			apiTokenPath = filepath.Join(heistConfig.Agent.SecretBasePath, "agent-token")
+		}
+
+		server := agentserver.New(instance, agentserver.WithAPITokenPath(apiTokenPath))
 		defer server.Stop()
 
 		errChan := make(chan error)
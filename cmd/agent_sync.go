@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"time"
@@ -47,15 +48,24 @@ var syncCmd = &cobra.Command{
 		heistConfig := &HeistConfig{}
 		cobra.CheckErr(viper.Unmarshal(heistConfig))
 
+		shutdownTracer, err := heistConfig.Agent.setupTracing("heist-agent")
+		cobra.CheckErr(err)
+		defer shutdownTracing(shutdownTracer)
+
+		retryPolicy, err := heistConfig.Agent.createRetryPolicy()
+		cobra.CheckErr(err)
+
 		instance, err := agent.New(
 			agent.WithKubeConfig(heistConfig.Agent.KubernetesMasterURL, heistConfig.Agent.KubernetesConfigPath),
 			agent.WithClientConfig(heistConfig.Agent.ClientConfigNamespace, heistConfig.Agent.ClientConfigName),
 			agent.WithBasePath(heistConfig.Agent.SecretBasePath),
+			agent.WithRetryPolicy(retryPolicy),
+			agent.WithRequestLimits(heistConfig.Agent.createRequestLimits()),
 		)
 		cobra.CheckErr(err)
 		defer instance.Stop()
 
-		cobra.CheckErr(syncSecret(instance))
+		cobra.CheckErr(syncSecret(cmd.Context(), instance))
 	},
 }
 
@@ -63,7 +73,7 @@ func init() {
 	agentCmd.AddCommand(syncCmd)
 }
 
-func syncSecret(instance agent.Agent) error {
+func syncSecret(ctx context.Context, instance agent.Agent) error {
 	log := controllerruntime.Log.WithName("agent-sync")
 
 	for instance.GetStatus().Status != agent.StatusSynced {
@@ -78,7 +88,7 @@ func syncSecret(instance agent.Agent) error {
 
 	for _, name := range secrets {
 		secretLog := log.WithValues("secret_name", name)
-		secret, err := instance.FetchSecret(name)
+		secret, err := instance.FetchSecret(ctx, name)
 		if err != nil {
 			secretLog.Info("Failed to fetch secret", "error", err)
 			return err
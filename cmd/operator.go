@@ -30,7 +30,6 @@ import (
 	"github.com/youniqx/heist/pkg/operator"
 	"github.com/youniqx/heist/pkg/vault"
 	"github.com/youniqx/heist/pkg/vault/core"
-	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -40,6 +39,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
@@ -54,12 +54,33 @@ var controllerCmd = &cobra.Command{
 	Use:   "operator",
 	Short: "Starts the heist operator",
 	ValidArgs: []string{
+		"--drift-check-interval",
 		"--health-probe-bind-address",
 		"--leader-elect",
 		"--metrics-bind-address",
+		"--tracing-endpoint",
+		"--tracing-sample-ratio",
 		"--vault-address",
+		"--vault-approle-role-id",
+		"--vault-approle-secret-id-path",
+		"--vault-auth-method",
+		"--vault-auth-mount-path",
+		"--vault-client-cert-path",
+		"--vault-client-key-path",
+		"--vault-enterprise-namespace",
+		"--vault-failover-address",
 		"--vault-jwt-path",
 		"--vault-kubernetes-auth-mount-path",
+		"--vault-max-in-flight-requests",
+		"--vault-rate-limit",
+		"--vault-rate-limit-burst",
+		"--vault-retry-jitter",
+		"--vault-retry-max-attempts",
+		"--vault-retry-max-delay",
+		"--vault-retry-max-elapsed-time",
+		"--vault-retry-min-delay",
+		"--vault-retry-network-errors",
+		"--vault-retry-status-codes",
 		"--vault-role",
 		"--vault-token",
 		"--webhook-port",
@@ -71,34 +92,62 @@ var controllerCmd = &cobra.Command{
 
 		ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+		ctx := ctrl.SetupSignalHandler()
+
 		heistConfig := &HeistConfig{}
 		cobra.CheckErr(viper.Unmarshal(heistConfig))
 
+		shutdownTracer, err := heistConfig.Operator.setupTracing("heist-operator")
+		if err != nil {
+			setupLog.Error(err, "unable to set up tracing")
+			os.Exit(1)
+		}
+
 		cas := make([]core.StringSource, 0, len(heistConfig.Vault.CACerts))
 		for _, cert := range heistConfig.Vault.CACerts {
 			cas = append(cas, core.File(cert))
 		}
 
-		var api vault.API
-		var err error
-		if heistConfig.Vault.Token != "" {
-			api, err = vault.NewAPI().
-				WithAddressFrom(core.Value(heistConfig.Vault.Address)).
-				WithTokenFrom(core.Value(heistConfig.Vault.Token)).
-				WithCAsFrom(cas...).
-				Complete()
-		} else {
-			api, err = vault.NewAPI().
-				WithAddressFrom(core.Value(heistConfig.Vault.Address)).
-				WithCAsFrom(cas...).
-				WithAuthProvider(kubernetesauth.AuthProvider(
-					core.MountPath(heistConfig.Vault.KubernetesAuthMountPath),
-					core.Value(heistConfig.Vault.Role),
-					core.File(heistConfig.Vault.JWTPath),
-				)).
-				Complete()
+		addresses := make([]core.StringSource, 0, len(heistConfig.Vault.FailoverAddresses)+1)
+		addresses = append(addresses, core.Value(heistConfig.Vault.Address))
+		for _, address := range heistConfig.Vault.FailoverAddresses {
+			addresses = append(addresses, core.Value(address))
+		}
+
+		if err := core.RegisterMetrics(ctrlmetrics.Registry); err != nil {
+			setupLog.Error(err, "unable to register Vault metrics")
+			os.Exit(1)
+		}
+
+		builder := vault.NewAPI().
+			WithAddressFrom(addresses...).
+			WithNamespaceFrom(core.Value(heistConfig.Vault.Namespace)).
+			WithCAsFrom(cas...)
+
+		if heistConfig.Vault.ClientCertPath != "" {
+			builder = builder.WithClientCertFrom(core.File(heistConfig.Vault.ClientCertPath))
+		}
+
+		if heistConfig.Vault.ClientKeyPath != "" {
+			builder = builder.WithClientKeyFrom(core.File(heistConfig.Vault.ClientKeyPath))
+		}
+
+		retryPolicy, err := heistConfig.Vault.createRetryPolicy()
+		if err != nil {
+			setupLog.Error(err, "unable to configure Vault retry policy")
+			os.Exit(1)
+		}
+
+		builder = builder.WithRetryPolicy(retryPolicy).
+			WithRequestLimits(heistConfig.Vault.createRequestLimits())
+
+		provider, err := createVaultAuthProvider(heistConfig.Vault)
+		if err != nil {
+			setupLog.Error(err, "unable to configure Vault auth method")
+			os.Exit(1)
 		}
 
+		api, err := builder.WithAuthProvider(provider).Complete()
 		if err != nil {
 			setupLog.Error(err, "unable to create Vault API instance")
 			os.Exit(1)
@@ -118,23 +167,29 @@ var controllerCmd = &cobra.Command{
 			WithOptions(generateManagerConfig(heistConfig)).
 			Register(controllers.Component(&controllers.Config{
 				SyncSecretNamespaceAllowList: heistConfig.Operator.SyncSecretNamespaceAllowList,
+				DriftCheckInterval:           heistConfig.Operator.DriftCheckInterval,
 			})).
 			Register(heistv1alpha1.Component()).
 			Register(injector.Component(&injector.Config{
 				AgentImage: heistConfig.Operator.AgentImage,
 				OpenShift:  openshift,
 			})).
-			Complete()
+			Complete(ctx)
 		if err != nil {
 			setupLog.Error(err, "unable to create manager")
 			os.Exit(1)
 		}
 
 		setupLog.Info("starting manager")
-		if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		if err := mgr.Start(ctx); err != nil {
 			setupLog.Error(err, "problem running manager")
+			revokeVaultToken(api)
+			shutdownTracing(shutdownTracer)
 			os.Exit(1)
 		}
+
+		revokeVaultToken(api)
+		shutdownTracing(shutdownTracer)
 	},
 }
 
@@ -193,7 +248,19 @@ func init() {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	})
 
-	controllerCmd.Flags().String("vault-role", defaultConfig.Vault.Role, "Role used by the operator to authenticate in the Vault instance when using Kubernetes Auth.")
+	controllerCmd.Flags().StringSlice("vault-failover-address", defaultConfig.Vault.FailoverAddresses, "Addresses of further nodes of the Vault cluster the operator fails over to when the current node becomes unavailable.")
+	_ = viper.BindPFlag("vault.failover_addresses", controllerCmd.Flags().Lookup("vault-failover-address"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-failover-address", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().String("vault-enterprise-namespace", defaultConfig.Vault.Namespace, "Vault Enterprise namespace containing the engines, policies and auth methods managed by the operator.")
+	_ = viper.BindPFlag("vault.namespace", controllerCmd.Flags().Lookup("vault-enterprise-namespace"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-enterprise-namespace", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().String("vault-role", defaultConfig.Vault.Role, "Role used by the operator to authenticate in the Vault instance when using Kubernetes, JWT or TLS Certificate Auth.")
 	_ = viper.BindPFlag("vault.role", controllerCmd.Flags().Lookup("vault-role"))
 	_ = controllerCmd.RegisterFlagCompletionFunc("vault-role", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return nil, cobra.ShellCompDirectiveNoFileComp
@@ -211,12 +278,105 @@ func init() {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	})
 
-	controllerCmd.Flags().String("vault-jwt-path", defaultConfig.Vault.JWTPath, "Path to the file containing the JWT used to authenticate in Vault when using Kubernetes Auth.")
+	controllerCmd.Flags().String("vault-jwt-path", defaultConfig.Vault.JWTPath, "Path to the file containing the JWT used to authenticate in Vault when using Kubernetes or JWT Auth.")
 	_ = viper.BindPFlag("vault.jwt_path", controllerCmd.Flags().Lookup("vault-jwt-path"))
 	_ = controllerCmd.RegisterFlagCompletionFunc("vault-jwt-path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return nil, cobra.ShellCompDirectiveDefault
 	})
 
+	controllerCmd.Flags().String("vault-auth-method", defaultConfig.Vault.AuthMethod, "Auth method used by the operator to authenticate in Vault (kubernetes, jwt, approle, cert or token). Defaults to token if a token is set and kubernetes otherwise.")
+	_ = viper.BindPFlag("vault.auth_method", controllerCmd.Flags().Lookup("vault-auth-method"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-auth-method", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"kubernetes", "jwt", "approle", "cert", "token"}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().String("vault-auth-mount-path", defaultConfig.Vault.AuthMountPath, "Path of the JWT, AppRole or TLS certificate auth method used to authenticate in Vault. Defaults to the name of the auth method.")
+	_ = viper.BindPFlag("vault.auth_mount_path", controllerCmd.Flags().Lookup("vault-auth-mount-path"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-auth-mount-path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().String("vault-approle-role-id", defaultConfig.Vault.AppRoleRoleID, "Role ID used by the operator to authenticate in Vault when using AppRole Auth.")
+	_ = viper.BindPFlag("vault.approle_role_id", controllerCmd.Flags().Lookup("vault-approle-role-id"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-approle-role-id", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().String("vault-approle-secret-id-path", defaultConfig.Vault.AppRoleSecretIDPath, "Path to the file containing the Secret ID used to authenticate in Vault when using AppRole Auth.")
+	_ = viper.BindPFlag("vault.approle_secret_id_path", controllerCmd.Flags().Lookup("vault-approle-secret-id-path"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-approle-secret-id-path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveDefault
+	})
+
+	controllerCmd.Flags().String("vault-client-cert-path", defaultConfig.Vault.ClientCertPath, "Path to the file containing the TLS client certificate presented to Vault for mutual TLS, required when using TLS Certificate Auth. Rotated certificates are reloaded automatically.")
+	_ = viper.BindPFlag("vault.client_cert_path", controllerCmd.Flags().Lookup("vault-client-cert-path"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-client-cert-path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveDefault
+	})
+
+	controllerCmd.Flags().String("vault-client-key-path", defaultConfig.Vault.ClientKeyPath, "Path to the file containing the private key of the TLS client certificate.")
+	_ = viper.BindPFlag("vault.client_key_path", controllerCmd.Flags().Lookup("vault-client-key-path"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-client-key-path", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveDefault
+	})
+
+	controllerCmd.Flags().Int("vault-retry-max-attempts", defaultConfig.Vault.RetryMaxAttempts, "Maximum number of attempts of a request to Vault, including the first one.")
+	_ = viper.BindPFlag("vault.retry_max_attempts", controllerCmd.Flags().Lookup("vault-retry-max-attempts"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-retry-max-attempts", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().Duration("vault-retry-min-delay", defaultConfig.Vault.RetryMinDelay, "Delay before the first retry of a failed request to Vault, it doubles with every further attempt.")
+	_ = viper.BindPFlag("vault.retry_min_delay", controllerCmd.Flags().Lookup("vault-retry-min-delay"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-retry-min-delay", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().Duration("vault-retry-max-delay", defaultConfig.Vault.RetryMaxDelay, "Maximum delay between two attempts of a request to Vault.")
+	_ = viper.BindPFlag("vault.retry_max_delay", controllerCmd.Flags().Lookup("vault-retry-max-delay"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-retry-max-delay", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().Duration("vault-retry-max-elapsed-time", defaultConfig.Vault.RetryMaxElapsedTime, "Time after which failed requests to Vault are no longer retried. Set to 0 to disable the limit.")
+	_ = viper.BindPFlag("vault.retry_max_elapsed_time", controllerCmd.Flags().Lookup("vault-retry-max-elapsed-time"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-retry-max-elapsed-time", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().Float64("vault-retry-jitter", defaultConfig.Vault.RetryJitter, "Fraction of the retry delay which is randomized, between 0 and 1.")
+	_ = viper.BindPFlag("vault.retry_jitter", controllerCmd.Flags().Lookup("vault-retry-jitter"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-retry-jitter", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().StringSlice("vault-retry-status-codes", defaultConfig.Vault.RetryStatusCodes, "Status codes or classes of Vault responses which are retried, e.g. 429 or 5xx. POST requests are only retried for 429 and 503.")
+	_ = viper.BindPFlag("vault.retry_status_codes", controllerCmd.Flags().Lookup("vault-retry-status-codes"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-retry-status-codes", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().Bool("vault-retry-network-errors", defaultConfig.Vault.RetryNetworkErrors, "Retry requests to Vault which failed without receiving a response.")
+	_ = viper.BindPFlag("vault.retry_network_errors", controllerCmd.Flags().Lookup("vault-retry-network-errors"))
+
+	controllerCmd.Flags().Float64("vault-rate-limit", defaultConfig.Vault.RateLimit, "Maximum number of requests per second sent to Vault. Set to 0 to disable the limit.")
+	_ = viper.BindPFlag("vault.rate_limit", controllerCmd.Flags().Lookup("vault-rate-limit"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-rate-limit", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().Int("vault-rate-limit-burst", defaultConfig.Vault.RateLimitBurst, "Number of requests which may be sent to Vault at once before the rate limit applies.")
+	_ = viper.BindPFlag("vault.rate_limit_burst", controllerCmd.Flags().Lookup("vault-rate-limit-burst"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-rate-limit-burst", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().Int("vault-max-in-flight-requests", defaultConfig.Vault.MaxInFlightRequests, "Maximum number of concurrent requests to Vault. Set to 0 to disable the limit.")
+	_ = viper.BindPFlag("vault.max_in_flight_requests", controllerCmd.Flags().Lookup("vault-max-in-flight-requests"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("vault-max-in-flight-requests", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
 	controllerCmd.Flags().String("metrics-bind-address", defaultConfig.Operator.MetricsBindAddress, "The address the metric endpoint binds to.")
 	_ = viper.BindPFlag("operator.metrics_bind_address", controllerCmd.Flags().Lookup("metrics-bind-address"))
 	_ = controllerCmd.RegisterFlagCompletionFunc("metrics-bind-address", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -229,6 +389,18 @@ func init() {
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	})
 
+	controllerCmd.Flags().String("tracing-endpoint", defaultConfig.Operator.TracingEndpoint, "URL of the OTLP HTTP receiver traces are exported to. Tracing is disabled if neither this flag nor OTEL_EXPORTER_OTLP_ENDPOINT is set.")
+	_ = viper.BindPFlag("operator.tracing_endpoint", controllerCmd.Flags().Lookup("tracing-endpoint"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("tracing-endpoint", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	controllerCmd.Flags().Float64("tracing-sample-ratio", defaultConfig.Operator.TracingSampleRatio, "Fraction of traces which are sampled, between 0 and 1.")
+	_ = viper.BindPFlag("operator.tracing_sample_ratio", controllerCmd.Flags().Lookup("tracing-sample-ratio"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("tracing-sample-ratio", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
 	controllerCmd.Flags().String("health-probe-bind-address", defaultConfig.Operator.HealthProbeBindAddress, "The address the probe endpoint binds to.")
 	_ = viper.BindPFlag("operator.health_probe_bind_address", controllerCmd.Flags().Lookup("health-probe-bind-address"))
 	_ = controllerCmd.RegisterFlagCompletionFunc("health-probe-bind-address", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -271,6 +443,12 @@ func init() {
 		return names, cobra.ShellCompDirectiveNoFileComp
 	})
 
+	controllerCmd.Flags().Duration("drift-check-interval", defaultConfig.Operator.DriftCheckInterval, "Interval in which KV secrets, transit keys and certificate roles are compared with their state in Vault to detect changes made outside of Heist.")
+	_ = viper.BindPFlag("operator.drift_check_interval", controllerCmd.Flags().Lookup("drift-check-interval"))
+	_ = controllerCmd.RegisterFlagCompletionFunc("drift-check-interval", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(heistv1alpha1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
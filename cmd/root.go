@@ -21,10 +21,12 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/youniqx/heist/pkg/controllers/common"
 	"github.com/youniqx/heist/pkg/managed"
 	"github.com/youniqx/heist/pkg/vault"
 	"gopkg.in/yaml.v3"
@@ -60,10 +62,13 @@ func Execute() {
 var defaultConfig = &HeistConfig{
 	Vault: &VaultConfig{
 		Address:                 "",
+		Namespace:               "",
 		Role:                    "",
 		Token:                   "",
 		KubernetesAuthMountPath: managed.KubernetesAuthPath,
 		JWTPath:                 vault.DefaultKubernetesTokenPath,
+		RetryConfig:             defaultRetryConfig(),
+		RequestLimitConfig:      defaultRequestLimitConfig(),
 	},
 	Operator: &OperatorConfig{
 		MetricsBindAddress:           ":8080",
@@ -73,6 +78,8 @@ var defaultConfig = &HeistConfig{
 		LeaderElectionID:             "8b2618e3.youniqx.com",
 		AgentImage:                   fmt.Sprintf("youniqx/heist:%s", tag),
 		SyncSecretNamespaceAllowList: nil,
+		DriftCheckInterval:           common.DefaultDriftCheckInterval,
+		TracingConfig:                defaultTracingConfig(),
 	},
 	Agent: &AgentConfig{
 		KubernetesMasterURL:   "",
@@ -81,23 +88,28 @@ var defaultConfig = &HeistConfig{
 		ClientConfigName:      "",
 		SecretBasePath:        "/heist",
 		Address:               ":8080",
+		APITokenPath:          "",
+		RetryConfig:           defaultRetryConfig(),
+		RequestLimitConfig:    defaultRequestLimitConfig(),
+		TracingConfig:         defaultTracingConfig(),
 	},
 	Setup: &SetupConfig{
-		VaultNamespace:       "",
-		VaultServiceName:     "",
-		VaultPort:            "",
-		VaultCACerts:         nil,
-		VaultScheme:          "http",
-		VaultToken:           "",
-		VaultURL:             "",
-		PolicyName:           "heist",
-		RoleName:             "heist",
-		HeistNamespace:       "heist-system",
-		HeistServiceAccount:  "heist",
-		KubernetesHost:       "https://kubernetes.default.svc.cluster.local",
-		KubernetesJWTIssuer:  "",
-		KubernetesJWTCACert:  "",
-		KubernetesJWTPemKeys: nil,
+		VaultNamespace:           "",
+		VaultServiceName:         "",
+		VaultPort:                "",
+		VaultCACerts:             nil,
+		VaultScheme:              "http",
+		VaultToken:               "",
+		VaultURL:                 "",
+		VaultEnterpriseNamespace: "",
+		PolicyName:               "heist",
+		RoleName:                 "heist",
+		HeistNamespace:           "heist-system",
+		HeistServiceAccount:      "heist",
+		KubernetesHost:           "https://kubernetes.default.svc.cluster.local",
+		KubernetesJWTIssuer:      "",
+		KubernetesJWTCACert:      "",
+		KubernetesJWTPemKeys:     nil,
 	},
 }
 
@@ -138,11 +150,21 @@ type HeistConfig struct {
 
 type VaultConfig struct {
 	Address                 string   `mapstructure:"address" yaml:"address" json:"address"`
+	FailoverAddresses       []string `mapstructure:"failover_addresses" yaml:"failover_addresses" json:"failover_addresses"`
+	Namespace               string   `mapstructure:"namespace" yaml:"namespace" json:"namespace"`
 	CACerts                 []string `mapstructure:"ca_certs" yaml:"ca_certs" json:"ca_certs"`
 	Role                    string   `mapstructure:"role" yaml:"role" json:"role"`
 	Token                   string   `mapstructure:"token" yaml:"token" json:"token"`
 	KubernetesAuthMountPath string   `mapstructure:"kubernetes_auth_mount_path" yaml:"kubernetes_auth_mount_path" json:"kubernetes_auth_mount_path"`
 	JWTPath                 string   `mapstructure:"jwt_path" yaml:"jwt_path" json:"jwt_path"`
+	AuthMethod              string   `mapstructure:"auth_method" yaml:"auth_method" json:"auth_method"`
+	AuthMountPath           string   `mapstructure:"auth_mount_path" yaml:"auth_mount_path" json:"auth_mount_path"`
+	AppRoleRoleID           string   `mapstructure:"approle_role_id" yaml:"approle_role_id" json:"approle_role_id"`
+	AppRoleSecretIDPath     string   `mapstructure:"approle_secret_id_path" yaml:"approle_secret_id_path" json:"approle_secret_id_path"`
+	ClientCertPath          string   `mapstructure:"client_cert_path" yaml:"client_cert_path" json:"client_cert_path"`
+	ClientKeyPath           string   `mapstructure:"client_key_path" yaml:"client_key_path" json:"client_key_path"`
+	RetryConfig             `mapstructure:",squash" yaml:",inline" json:",inline"`
+	RequestLimitConfig      `mapstructure:",squash" yaml:",inline" json:",inline"`
 }
 
 type AgentConfig struct {
@@ -152,34 +174,41 @@ type AgentConfig struct {
 	ClientConfigName      string `mapstructure:"client_config_name" yaml:"client_config_name" json:"client_config_name"`
 	SecretBasePath        string `mapstructure:"secret_base_path" yaml:"secret_base_path" json:"secret_base_path"`
 	Address               string `mapstructure:"address" yaml:"address" json:"address"`
+	APITokenPath          string `mapstructure:"api_token_path" yaml:"api_token_path" json:"api_token_path"`
+	RetryConfig           `mapstructure:",squash" yaml:",inline" json:",inline"`
+	RequestLimitConfig    `mapstructure:",squash" yaml:",inline" json:",inline"`
+	TracingConfig         `mapstructure:",squash" yaml:",inline" json:",inline"`
 }
 
 type SetupConfig struct {
-	VaultNamespace       string   `mapstructure:"vault_namespace" yaml:"vault_namespace" json:"vault_namespace"`
-	VaultServiceName     string   `mapstructure:"vault_service_name" yaml:"vault_service_name" json:"vault_service_name"`
-	VaultPort            string   `mapstructure:"vault_port" yaml:"vault_port" json:"vault_port"`
-	VaultCACerts         []string `mapstructure:"vault_ca_certs" yaml:"vault_ca_certs" json:"vault_ca_certs"`
-	VaultScheme          string   `mapstructure:"vault_scheme" yaml:"vault_scheme" json:"vault_scheme"`
-	VaultToken           string   `mapstructure:"vault_token" yaml:"vault_token" json:"vault_token"`
-	VaultURL             string   `mapstructure:"vault_url" yaml:"vault_url" json:"vault_url"`
-	PolicyName           string   `mapstructure:"policy_name" yaml:"policy_name" json:"policy_name"`
-	RoleName             string   `mapstructure:"role_name" yaml:"role_name" json:"role_name"`
-	HeistNamespace       string   `mapstructure:"heist_namespace" yaml:"heist_namespace" json:"heist_namespace"`
-	HeistServiceAccount  string   `mapstructure:"heist_service_account" yaml:"heist_service_account" json:"heist_service_account"`
-	KubernetesHost       string   `mapstructure:"kubernetes_host" yaml:"kubernetes_host" json:"kubernetes_host"`
-	KubernetesJWTIssuer  string   `mapstructure:"kubernetes_jwt_issuer" yaml:"kubernetes_jwt_issuer" json:"kubernetes_jwt_issuer"`
-	KubernetesJWTCACert  string   `mapstructure:"kubernetes_jwt_ca_cert" yaml:"kubernetes_jwt_ca_cert" json:"kubernetes_jwt_ca_cert"`
-	KubernetesJWTPemKeys []string `mapstructure:"kubernetes_jwt_pem_keys" yaml:"kubernetes_jwt_pem_keys" json:"kubernetes_jwt_pem_keys"`
+	VaultNamespace           string   `mapstructure:"vault_namespace" yaml:"vault_namespace" json:"vault_namespace"`
+	VaultServiceName         string   `mapstructure:"vault_service_name" yaml:"vault_service_name" json:"vault_service_name"`
+	VaultPort                string   `mapstructure:"vault_port" yaml:"vault_port" json:"vault_port"`
+	VaultCACerts             []string `mapstructure:"vault_ca_certs" yaml:"vault_ca_certs" json:"vault_ca_certs"`
+	VaultScheme              string   `mapstructure:"vault_scheme" yaml:"vault_scheme" json:"vault_scheme"`
+	VaultToken               string   `mapstructure:"vault_token" yaml:"vault_token" json:"vault_token"`
+	VaultURL                 string   `mapstructure:"vault_url" yaml:"vault_url" json:"vault_url"`
+	VaultEnterpriseNamespace string   `mapstructure:"vault_enterprise_namespace" yaml:"vault_enterprise_namespace" json:"vault_enterprise_namespace"`
+	PolicyName               string   `mapstructure:"policy_name" yaml:"policy_name" json:"policy_name"`
+	RoleName                 string   `mapstructure:"role_name" yaml:"role_name" json:"role_name"`
+	HeistNamespace           string   `mapstructure:"heist_namespace" yaml:"heist_namespace" json:"heist_namespace"`
+	HeistServiceAccount      string   `mapstructure:"heist_service_account" yaml:"heist_service_account" json:"heist_service_account"`
+	KubernetesHost           string   `mapstructure:"kubernetes_host" yaml:"kubernetes_host" json:"kubernetes_host"`
+	KubernetesJWTIssuer      string   `mapstructure:"kubernetes_jwt_issuer" yaml:"kubernetes_jwt_issuer" json:"kubernetes_jwt_issuer"`
+	KubernetesJWTCACert      string   `mapstructure:"kubernetes_jwt_ca_cert" yaml:"kubernetes_jwt_ca_cert" json:"kubernetes_jwt_ca_cert"`
+	KubernetesJWTPemKeys     []string `mapstructure:"kubernetes_jwt_pem_keys" yaml:"kubernetes_jwt_pem_keys" json:"kubernetes_jwt_pem_keys"`
 }
 
 type OperatorConfig struct {
-	MetricsBindAddress           string   `mapstructure:"metrics_bind_address" yaml:"metrics_bind_address" json:"metrics_bind_address"`
-	HealthProbeBindAddress       string   `mapstructure:"health_probe_bind_address" yaml:"health_probe_bind" json:"health_probe_bind"`
-	WebhookPort                  int      `mapstructure:"webhook_port" yaml:"webhook_port" json:"webhook_port"`
-	LeaderElect                  bool     `mapstructure:"leader_elect" yaml:"leader_elect" json:"leader_elect"`
-	LeaderElectionID             string   `mapstructure:"leader_election_id" yaml:"leader_election_id" json:"leader_election_id"`
-	AgentImage                   string   `mapstructure:"agent_image" yaml:"agent_image" json:"agent_image"`
-	SyncSecretNamespaceAllowList []string `mapstructure:"sync_secret_namespace_allow_list" yaml:"sync_secret_namespace_allow_list" json:"sync_secret_namespace_allow_list"`
+	MetricsBindAddress           string        `mapstructure:"metrics_bind_address" yaml:"metrics_bind_address" json:"metrics_bind_address"`
+	HealthProbeBindAddress       string        `mapstructure:"health_probe_bind_address" yaml:"health_probe_bind" json:"health_probe_bind"`
+	WebhookPort                  int           `mapstructure:"webhook_port" yaml:"webhook_port" json:"webhook_port"`
+	LeaderElect                  bool          `mapstructure:"leader_elect" yaml:"leader_elect" json:"leader_elect"`
+	LeaderElectionID             string        `mapstructure:"leader_election_id" yaml:"leader_election_id" json:"leader_election_id"`
+	AgentImage                   string        `mapstructure:"agent_image" yaml:"agent_image" json:"agent_image"`
+	SyncSecretNamespaceAllowList []string      `mapstructure:"sync_secret_namespace_allow_list" yaml:"sync_secret_namespace_allow_list" json:"sync_secret_namespace_allow_list"`
+	DriftCheckInterval           time.Duration `mapstructure:"drift_check_interval" yaml:"drift_check_interval" json:"drift_check_interval"`
+	TracingConfig                `mapstructure:",squash" yaml:",inline" json:",inline"`
 }
 
 func loadDefaultConfig(value interface{}) {
@@ -42,6 +42,12 @@ func init() {
 	})
 	_ = setupCmd.MarkFlagRequired("vault-token")
 
+	setupCmd.PersistentFlags().String("vault-enterprise-namespace", defaultConfig.Setup.VaultEnterpriseNamespace, "Vault Enterprise namespace in which the operator policy and kubernetes auth method are configured.")
+	_ = viper.BindPFlag("setup.vault_enterprise_namespace", setupCmd.PersistentFlags().Lookup("vault-enterprise-namespace"))
+	_ = setupCmd.RegisterFlagCompletionFunc("vault-enterprise-namespace", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	})
+
 	setupCmd.PersistentFlags().String("heist-namespace", defaultConfig.Setup.HeistNamespace, "Namespace containing the heist deployment.")
 	_ = viper.BindPFlag("setup.heist_namespace", setupCmd.PersistentFlags().Lookup("heist-namespace"))
 	_ = setupCmd.RegisterFlagCompletionFunc("heist-namespace", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
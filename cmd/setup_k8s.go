@@ -60,23 +60,24 @@ var k8sCmd = &cobra.Command{
 		heistConfig := &HeistConfig{}
 		cobra.CheckErr(viper.Unmarshal(heistConfig))
 
-		err = operator.SetupOperator(&operator.SetupConfig{
-			VaultNamespace:       heistConfig.Setup.VaultNamespace,
-			VaultServiceName:     heistConfig.Setup.VaultServiceName,
-			VaultPort:            heistConfig.Setup.VaultPort,
-			VaultToken:           heistConfig.Setup.VaultToken,
-			VaultCAs:             parseValues(heistConfig.Setup.VaultCACerts),
-			VaultScheme:          heistConfig.Setup.VaultScheme,
-			VaultURL:             "",
-			KubernetesHost:       heistConfig.Setup.KubernetesHost,
-			KubernetesJWTIssuer:  heistConfig.Setup.KubernetesJWTIssuer,
-			KubernetesJWTCACert:  heistConfig.Setup.KubernetesJWTCACert,
-			KubernetesJWTPemKeys: heistConfig.Setup.KubernetesJWTPemKeys,
-			PolicyName:           heistConfig.Setup.PolicyName,
-			RoleName:             heistConfig.Setup.RoleName,
-			HeistNamespace:       heistConfig.Setup.HeistNamespace,
-			HeistServiceAccount:  heistConfig.Setup.HeistServiceAccount,
-			RESTConfig:           config,
+		err = operator.SetupOperator(cmd.Context(), &operator.SetupConfig{
+			VaultNamespace:           heistConfig.Setup.VaultNamespace,
+			VaultServiceName:         heistConfig.Setup.VaultServiceName,
+			VaultPort:                heistConfig.Setup.VaultPort,
+			VaultToken:               heistConfig.Setup.VaultToken,
+			VaultEnterpriseNamespace: heistConfig.Setup.VaultEnterpriseNamespace,
+			VaultCAs:                 parseValues(heistConfig.Setup.VaultCACerts),
+			VaultScheme:              heistConfig.Setup.VaultScheme,
+			VaultURL:                 "",
+			KubernetesHost:           heistConfig.Setup.KubernetesHost,
+			KubernetesJWTIssuer:      heistConfig.Setup.KubernetesJWTIssuer,
+			KubernetesJWTCACert:      heistConfig.Setup.KubernetesJWTCACert,
+			KubernetesJWTPemKeys:     heistConfig.Setup.KubernetesJWTPemKeys,
+			PolicyName:               heistConfig.Setup.PolicyName,
+			RoleName:                 heistConfig.Setup.RoleName,
+			HeistNamespace:           heistConfig.Setup.HeistNamespace,
+			HeistServiceAccount:      heistConfig.Setup.HeistServiceAccount,
+			RESTConfig:               config,
 		})
 		cobra.CheckErr(err)
 	},
@@ -42,23 +42,24 @@ var staticCmd = &cobra.Command{
 		heistConfig := &HeistConfig{}
 		cobra.CheckErr(viper.Unmarshal(heistConfig))
 
-		err := operator.SetupOperator(&operator.SetupConfig{
-			VaultNamespace:       "",
-			VaultServiceName:     "",
-			VaultPort:            "",
-			VaultToken:           heistConfig.Setup.VaultToken,
-			VaultURL:             heistConfig.Setup.VaultURL,
-			VaultCAs:             parseValues(heistConfig.Setup.VaultCACerts),
-			VaultScheme:          heistConfig.Setup.VaultScheme,
-			KubernetesHost:       heistConfig.Setup.KubernetesHost,
-			KubernetesJWTIssuer:  heistConfig.Setup.KubernetesJWTIssuer,
-			KubernetesJWTCACert:  heistConfig.Setup.KubernetesJWTCACert,
-			KubernetesJWTPemKeys: heistConfig.Setup.KubernetesJWTPemKeys,
-			PolicyName:           heistConfig.Setup.PolicyName,
-			RoleName:             heistConfig.Setup.RoleName,
-			HeistNamespace:       heistConfig.Setup.HeistNamespace,
-			HeistServiceAccount:  heistConfig.Setup.HeistServiceAccount,
-			RESTConfig:           nil,
+		err := operator.SetupOperator(cmd.Context(), &operator.SetupConfig{
+			VaultNamespace:           "",
+			VaultServiceName:         "",
+			VaultPort:                "",
+			VaultToken:               heistConfig.Setup.VaultToken,
+			VaultEnterpriseNamespace: heistConfig.Setup.VaultEnterpriseNamespace,
+			VaultURL:                 heistConfig.Setup.VaultURL,
+			VaultCAs:                 parseValues(heistConfig.Setup.VaultCACerts),
+			VaultScheme:              heistConfig.Setup.VaultScheme,
+			KubernetesHost:           heistConfig.Setup.KubernetesHost,
+			KubernetesJWTIssuer:      heistConfig.Setup.KubernetesJWTIssuer,
+			KubernetesJWTCACert:      heistConfig.Setup.KubernetesJWTCACert,
+			KubernetesJWTPemKeys:     heistConfig.Setup.KubernetesJWTPemKeys,
+			PolicyName:               heistConfig.Setup.PolicyName,
+			RoleName:                 heistConfig.Setup.RoleName,
+			HeistNamespace:           heistConfig.Setup.HeistNamespace,
+			HeistServiceAccount:      heistConfig.Setup.HeistServiceAccount,
+			RESTConfig:               nil,
 		})
 		cobra.CheckErr(err)
 	},
@@ -0,0 +1,55 @@
+/*
+Copyright 2022 youniqx Identity AG.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/youniqx/heist/pkg/tracing"
+)
+
+const tracingShutdownTimeout = 5 * time.Second
+
+type TracingConfig struct {
+	TracingEndpoint    string  `mapstructure:"tracing_endpoint" yaml:"tracing_endpoint" json:"tracing_endpoint"`
+	TracingSampleRatio float64 `mapstructure:"tracing_sample_ratio" yaml:"tracing_sample_ratio" json:"tracing_sample_ratio"`
+}
+
+func defaultTracingConfig() TracingConfig {
+	return TracingConfig{
+		TracingEndpoint:    "",
+		TracingSampleRatio: 1,
+	}
+}
+
+func (t *TracingConfig) setupTracing(serviceName string) (tracing.ShutdownFunc, error) {
+	return tracing.Setup(context.Background(), &tracing.Config{
+		ServiceName: serviceName,
+		Endpoint:    t.TracingEndpoint,
+		SampleRatio: t.TracingSampleRatio,
+	})
+}
+
+func shutdownTracing(shutdown tracing.ShutdownFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+
+	if err := shutdown(ctx); err != nil {
+		setupLog.Info("failed to flush pending spans", "error", err)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 youniqx Identity AG.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/youniqx/heist/pkg/vault"
+	"github.com/youniqx/heist/pkg/vault/approleauth"
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/certauth"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/jwtauth"
+	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
+)
+
+// createVaultAuthProvider returns the auth provider for the auth method configured
+// in the vault config. If no auth method is set, token auth is used if a token is
+// configured and kubernetes auth otherwise.
+func createVaultAuthProvider(config *VaultConfig) (core.AuthProvider, error) {
+	method := auth.Type(config.AuthMethod)
+	if method == "" {
+		method = auth.MethodKubernetes
+		if config.Token != "" {
+			method = auth.MethodToken
+		}
+	}
+
+	mountPath := config.AuthMountPath
+	if mountPath == "" {
+		mountPath = string(method)
+	}
+
+	switch method {
+	case auth.MethodToken:
+		return core.StaticToken(config.Token), nil
+	case auth.MethodKubernetes:
+		return kubernetesauth.AuthProvider(
+			core.MountPath(config.KubernetesAuthMountPath),
+			core.Value(config.Role),
+			core.File(config.JWTPath),
+		), nil
+	case auth.MethodJWT:
+		return jwtauth.AuthProvider(
+			core.MountPath(mountPath),
+			core.Value(config.Role),
+			core.File(config.JWTPath),
+		), nil
+	case auth.MethodAppRole:
+		return approleauth.AuthProvider(
+			core.MountPath(mountPath),
+			core.Value(config.AppRoleRoleID),
+			core.File(config.AppRoleSecretIDPath),
+		), nil
+	case auth.MethodCert:
+		if config.ClientCertPath == "" || config.ClientKeyPath == "" {
+			return nil, fmt.Errorf("a client certificate and key are required for the %s auth method", method)
+		}
+
+		return certauth.AuthProvider(
+			core.MountPath(mountPath),
+			core.Value(config.Role),
+		), nil
+	default:
+		return nil, fmt.Errorf("unsupported vault auth method: %s", method)
+	}
+}
+
+const revokeTokenTimeout = 5 * time.Second
+
+// revokeVaultToken revokes the token the operator obtained by logging in to Vault
+// so it doesn't linger in Vault until it expires.
+func revokeVaultToken(api vault.API) {
+	ctx, cancel := context.WithTimeout(context.Background(), revokeTokenTimeout)
+	defer cancel()
+
+	if err := api.RevokeToken(ctx); err != nil {
+		setupLog.Error(err, "unable to revoke Vault token")
+	}
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2022 youniqx Identity AG.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type RequestLimitConfig struct {
+	RateLimit           float64 `mapstructure:"rate_limit" yaml:"rate_limit" json:"rate_limit"`
+	RateLimitBurst      int     `mapstructure:"rate_limit_burst" yaml:"rate_limit_burst" json:"rate_limit_burst"`
+	MaxInFlightRequests int     `mapstructure:"max_in_flight_requests" yaml:"max_in_flight_requests" json:"max_in_flight_requests"`
+}
+
+func defaultRequestLimitConfig() RequestLimitConfig {
+	limits := core.DefaultRequestLimits()
+
+	return RequestLimitConfig{
+		RateLimit:           limits.RequestsPerSecond,
+		RateLimitBurst:      limits.Burst,
+		MaxInFlightRequests: limits.MaxInFlight,
+	}
+}
+
+func (r *RequestLimitConfig) createRequestLimits() *core.RequestLimits {
+	return &core.RequestLimits{
+		RequestsPerSecond: r.RateLimit,
+		Burst:             r.RateLimitBurst,
+		MaxInFlight:       r.MaxInFlightRequests,
+	}
+}
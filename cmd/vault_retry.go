@@ -0,0 +1,74 @@
+/*
+Copyright 2022 youniqx Identity AG.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type RetryConfig struct {
+	RetryMaxAttempts    int           `mapstructure:"retry_max_attempts" yaml:"retry_max_attempts" json:"retry_max_attempts"`
+	RetryMinDelay       time.Duration `mapstructure:"retry_min_delay" yaml:"retry_min_delay" json:"retry_min_delay"`
+	RetryMaxDelay       time.Duration `mapstructure:"retry_max_delay" yaml:"retry_max_delay" json:"retry_max_delay"`
+	RetryMaxElapsedTime time.Duration `mapstructure:"retry_max_elapsed_time" yaml:"retry_max_elapsed_time" json:"retry_max_elapsed_time"`
+	RetryJitter         float64       `mapstructure:"retry_jitter" yaml:"retry_jitter" json:"retry_jitter"`
+	RetryStatusCodes    []string      `mapstructure:"retry_status_codes" yaml:"retry_status_codes" json:"retry_status_codes"`
+	RetryNetworkErrors  bool          `mapstructure:"retry_network_errors" yaml:"retry_network_errors" json:"retry_network_errors"`
+}
+
+func defaultRetryConfig() RetryConfig {
+	policy := core.DefaultRetryPolicy()
+
+	statusCodes := make([]string, 0, len(policy.RetryableStatusClasses))
+	for _, class := range policy.RetryableStatusClasses {
+		statusCodes = append(statusCodes, string(class))
+	}
+
+	return RetryConfig{
+		RetryMaxAttempts:    policy.MaxAttempts,
+		RetryMinDelay:       policy.MinDelay,
+		RetryMaxDelay:       policy.MaxDelay,
+		RetryMaxElapsedTime: policy.MaxElapsedTime,
+		RetryJitter:         policy.Jitter,
+		RetryStatusCodes:    statusCodes,
+		RetryNetworkErrors:  policy.RetryNetworkErrors,
+	}
+}
+
+func (r *RetryConfig) createRetryPolicy() (core.RetryPolicy, error) {
+	classes := make([]core.StatusClass, 0, len(r.RetryStatusCodes))
+	for _, code := range r.RetryStatusCodes {
+		class := core.StatusClass(code)
+		if err := class.Validate(); err != nil {
+			return nil, err
+		}
+
+		classes = append(classes, class)
+	}
+
+	return &core.BackoffRetryPolicy{
+		MaxAttempts:            r.RetryMaxAttempts,
+		MinDelay:               r.RetryMinDelay,
+		MaxDelay:               r.RetryMaxDelay,
+		MaxElapsedTime:         r.RetryMaxElapsedTime,
+		Jitter:                 r.RetryJitter,
+		RetryableStatusClasses: classes,
+		RetryNetworkErrors:     r.RetryNetworkErrors,
+	}, nil
+}
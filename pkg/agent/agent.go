@@ -1,20 +1,32 @@
 package agent
 
 import (
+	"context"
 	"sync"
 
 	"github.com/go-logr/logr"
 	"github.com/youniqx/heist/pkg/client/heist.youniqx.com/v1alpha1/clientset/heist"
 	"github.com/youniqx/heist/pkg/erx"
 	"github.com/youniqx/heist/pkg/vault"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/pki"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 )
 
 type Agent interface {
 	ListSecrets() (names []string, err error)
-	FetchSecret(name string) (secret *Secret, err error)
+	FetchSecret(ctx context.Context, name string) (secret *Secret, err error)
+	FetchKvSecretField(ctx context.Context, name string, field string) (value string, err error)
+	IssueCertificate(ctx context.Context, alias string) (certificate *pki.Certificate, err error)
+	TransitEncrypt(ctx context.Context, name string, plainText []byte) (cipherText string, err error)
+	TransitDecrypt(ctx context.Context, name string, cipherText string) (plainText []byte, err error)
+	TransitSign(ctx context.Context, name string, input []byte) (signature string, err error)
+	TransitVerify(ctx context.Context, name string, input []byte, signature string) (valid bool, err error)
+	TransitHMAC(ctx context.Context, name string, input []byte) (hmac string, err error)
+	TransitRewrap(ctx context.Context, name string, cipherText string) (rewrapped string, err error)
 	GetClientSecret() *Secret
 	GetStatus() *SyncStatus
+	GetCertificateStatus() []*CertificateStatus
 	Stop()
 	CreateUpdateChannel(chan bool)
 }
@@ -29,8 +41,12 @@ type agent struct {
 	BasePath       string
 	ConfigLock     sync.Mutex
 	StopChannel    chan bool
+	Context        context.Context
+	Cancel         context.CancelFunc
 	TokenPath      string
 	VaultToken     string
+	RetryPolicy    core.RetryPolicy
+	RequestLimits  *core.RequestLimits
 	UpdateChannels []chan bool
 }
 
@@ -76,6 +92,7 @@ func New(opts ...Option) (Agent, error) {
 	}
 
 	instance.Log = instance.Log.WithValues("config_namespace", instance.Namespace, "config_name", instance.Name)
+	instance.Context, instance.Cancel = context.WithCancel(context.Background())
 
 	go instance.Run()
 
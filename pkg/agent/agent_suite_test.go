@@ -17,6 +17,7 @@ limitations under the License.
 package agent_test
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 	"time"
@@ -86,7 +87,7 @@ func BeforeSuiteSetup() func() {
 		Expect(VaultEnv).NotTo(BeNil())
 
 		By("setup Vault for Operator")
-		err = operator.SetupOperator(&operator.SetupConfig{
+		err = operator.SetupOperator(context.TODO(), &operator.SetupConfig{
 			VaultURL:             VaultEnv.GetAddress(),
 			VaultToken:           "root",
 			PolicyName:           "heist",
@@ -139,7 +140,7 @@ func BeforeSuiteSetup() func() {
 			}).
 			Register(heistv1alpha1.Component()).
 			Register(controllers.Component(&controllers.Config{})).
-			Complete()
+			Complete(context.TODO())
 		Expect(err).ToNot(HaveOccurred())
 		Expect(mgr).ToNot(BeNil())
 
@@ -150,7 +151,7 @@ func BeforeSuiteSetup() func() {
 
 		K8sEnv = testhelper.New(cfg, K8sClient)
 
-		DefaultCipherText, err = RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
+		DefaultCipherText, err = RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
 		Expect(err).NotTo(HaveOccurred())
 		Expect(DefaultCipherText).NotTo(BeEmpty())
 
@@ -2,6 +2,7 @@ package agent
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -10,6 +11,8 @@ import (
 
 	"github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
 	"github.com/youniqx/heist/pkg/erx"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/pki"
 )
 
 var (
@@ -28,11 +31,20 @@ const (
 	fileModeDefault = 0o640
 )
 
+// noOwner is used for UID and GID of secrets which don't configure an owner.
+const noOwner = -1
+
 type Secret struct {
 	Value      string
 	Name       string
 	OutputPath string
 	Mode       os.FileMode
+	// UID is the desired owner of the output file, -1 keeps the agent as owner.
+	UID int
+	// GID is the desired group of the output file, -1 keeps the agents group.
+	GID int
+	// Reload is performed after the content of the output file has changed.
+	Reload *v1alpha1.VaultBindingReloadAction
 }
 
 func (a *agent) GetClientSecret() *Secret {
@@ -41,6 +53,8 @@ func (a *agent) GetClientSecret() *Secret {
 		Name:       "heist.json",
 		OutputPath: filepath.Join(a.BasePath, "config.json"),
 		Mode:       fileModeDefault,
+		UID:        noOwner,
+		GID:        noOwner,
 	}
 
 	conf := a.getConfig()
@@ -83,7 +97,7 @@ func (a *agent) ListSecrets() (names []string, err error) {
 	return result, nil
 }
 
-func (a *agent) FetchSecret(name string) (value *Secret, err error) {
+func (a *agent) FetchSecret(ctx context.Context, name string) (value *Secret, err error) {
 	log := a.Log.WithValues("operation", "FetchSecret", "name", name)
 
 	conf := a.getConfig()
@@ -94,14 +108,81 @@ func (a *agent) FetchSecret(name string) (value *Secret, err error) {
 
 	for _, secret := range conf.ClientConfig.Spec.Templates.Templates {
 		if name == secret.Path {
-			return a.renderTemplate(secret)
+			return a.renderTemplate(ctx, secret)
 		}
 	}
 
 	return nil, ErrNotFound.WithDetails(fmt.Sprintf("secret with name %s not found", name))
 }
 
-func (a *agent) renderTemplate(secret v1alpha1.VaultBindingValueTemplate) (*Secret, error) {
+// FetchKvSecretField returns the value of a single field of a bound KV secret.
+func (a *agent) FetchKvSecretField(ctx context.Context, name string, field string) (string, error) {
+	log := a.Log.WithValues("operation", "FetchKvSecretField", "name", name, "field", field)
+
+	conf := a.getConfig()
+	if conf == nil {
+		log.Info("failed to fetch kv secret field because config is not synced yet")
+		return "", ErrNotYetSynced
+	}
+
+	renderer := &secretRenderer{
+		Context:      ctx,
+		ClientConfig: conf.ClientConfig,
+		Cache:        conf.Cache,
+	}
+
+	ref, err := renderer.getKvSecretRef(name)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := conf.Cache.ReadKvSecretVersion(ctx, core.MountPath(ref.EnginePath), core.SecretPath(ref.SecretPath), ref.Version)
+	if err != nil {
+		log.Info("failed to read kv secret", "error", err)
+		return "", ErrAPIRequestFailed.WithDetails("failed to read kv secret").WithCause(err)
+	}
+
+	value, ok := secret.Fields[field]
+	if !ok {
+		return "", ErrNotFound.WithDetails(fmt.Sprintf("kv secret %s has no field %s", name, field))
+	}
+
+	return value, nil
+}
+
+// IssueCertificate issues a new certificate for the certificate template with
+// the given alias. Other than certificates used in templates, the certificate
+// is always freshly issued and not cached.
+func (a *agent) IssueCertificate(ctx context.Context, alias string) (*pki.Certificate, error) {
+	log := a.Log.WithValues("operation", "IssueCertificate", "alias", alias)
+
+	conf := a.getConfig()
+	if conf == nil {
+		log.Info("failed to issue certificate because config is not synced yet")
+		return nil, ErrNotYetSynced
+	}
+
+	renderer := &secretRenderer{
+		Context:      ctx,
+		ClientConfig: conf.ClientConfig,
+		Cache:        conf.Cache,
+	}
+
+	enginePath, role, options, err := renderer.getCertificatePaths(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := conf.API.IssueCertificate(ctx, enginePath, role, options)
+	if err != nil {
+		log.Info("failed to issue certificate", "error", err)
+		return nil, ErrAPIRequestFailed.WithDetails("failed to issue certificate").WithCause(err)
+	}
+
+	return certificate, nil
+}
+
+func (a *agent) renderTemplate(ctx context.Context, secret v1alpha1.VaultBindingValueTemplate) (*Secret, error) {
 	log := a.Log.WithValues("operation", "renderTemplate")
 	conf := a.getConfig()
 	if conf == nil {
@@ -110,6 +191,7 @@ func (a *agent) renderTemplate(secret v1alpha1.VaultBindingValueTemplate) (*Secr
 	}
 
 	renderer := &secretRenderer{
+		Context:      ctx,
 		ClientConfig: conf.ClientConfig,
 		Cache:        conf.Cache,
 	}
@@ -124,6 +206,9 @@ func (a *agent) renderTemplate(secret v1alpha1.VaultBindingValueTemplate) (*Secr
 		Name:       secret.Path,
 		OutputPath: a.createOutputPath(secret.Path),
 		Mode:       parseMode(secret.Mode),
+		UID:        parseOwner(secret.OwnerUID),
+		GID:        parseOwner(secret.OwnerGID),
+		Reload:     secret.Reload,
 	}, nil
 }
 
@@ -146,3 +231,11 @@ func parseMode(mode string) os.FileMode {
 
 	return os.FileMode(result)
 }
+
+func parseOwner(id *int64) int {
+	if id == nil {
+		return noOwner
+	}
+
+	return int(*id)
+}
@@ -0,0 +1,46 @@
+package agent
+
+import (
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/erx"
+	"github.com/youniqx/heist/pkg/vault/approleauth"
+	"github.com/youniqx/heist/pkg/vault/certauth"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/jwtauth"
+	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
+)
+
+// ErrUnsupportedAuthMethod is returned when the client config refers to an unknown auth method.
+var ErrUnsupportedAuthMethod = erx.New("Heist Agent", "unsupported auth method")
+
+func (a *agent) createAuthProvider(spec *v1alpha1.VaultClientConfigSpec) (core.AuthProvider, error) {
+	if a.VaultToken != "" {
+		return core.StaticToken(a.VaultToken), nil
+	}
+
+	mountPath := core.MountPath(spec.AuthMountPath)
+
+	switch spec.AuthMethod {
+	case "", v1alpha1.VaultClientConfigAuthMethodKubernetes:
+		return kubernetesauth.AuthProvider(mountPath, core.Value(spec.Role), core.File(a.TokenPath)), nil
+	case v1alpha1.VaultClientConfigAuthMethodJWT:
+		jwtPath := spec.JWTPath
+		if jwtPath == "" {
+			jwtPath = a.TokenPath
+		}
+
+		return jwtauth.AuthProvider(mountPath, core.Value(spec.Role), core.File(jwtPath)), nil
+	case v1alpha1.VaultClientConfigAuthMethodAppRole:
+		return approleauth.AuthProvider(mountPath, core.Value(spec.AppRoleRoleID), core.File(spec.AppRoleSecretIDPath)), nil
+	case v1alpha1.VaultClientConfigAuthMethodCert:
+		if spec.ClientCertPath == "" || spec.ClientKeyPath == "" {
+			return nil, ErrUnsupportedAuthMethod.WithDetails("a client certificate and key are required for the cert auth method")
+		}
+
+		return certauth.AuthProvider(mountPath, core.Value(spec.Role)), nil
+	default:
+		return nil, ErrUnsupportedAuthMethod.WithDetails(fmt.Sprintf("auth method %s is not supported", spec.AuthMethod))
+	}
+}
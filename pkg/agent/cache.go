@@ -1,8 +1,12 @@
 package agent
 
 import (
+	"context"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -23,7 +27,12 @@ type kvCacheEntry struct {
 }
 
 type certificateCacheEntry struct {
+	// Key identifies the engine, role and options the certificate has been
+	// issued with. The certificate is reissued if the key changes.
+	Key         string
+	CommonName  string
 	ExpiresAt   time.Time
+	NotAfter    *time.Time
 	Certificate *pki.Certificate
 }
 
@@ -43,7 +52,12 @@ func newCache(api vault.API) *agentCache {
 	}
 }
 
-func (c *agentCache) ReadKvSecret(enginePath core.MountPathEntity, secretPath core.SecretPathEntity) (*kvsecret.KvSecret, error) {
+func (c *agentCache) ReadKvSecret(ctx context.Context, enginePath core.MountPathEntity, secretPath core.SecretPathEntity) (*kvsecret.KvSecret, error) {
+	return c.ReadKvSecretVersion(ctx, enginePath, secretPath, 0)
+}
+
+// ReadKvSecretVersion reads a specific version of a kv secret, the latest version is read if version is 0.
+func (c *agentCache) ReadKvSecretVersion(ctx context.Context, enginePath core.MountPathEntity, secretPath core.SecretPathEntity, version int) (*kvsecret.KvSecret, error) {
 	mountPath, err := enginePath.GetMountPath()
 	if err != nil {
 		return nil, err
@@ -54,7 +68,7 @@ func (c *agentCache) ReadKvSecret(enginePath core.MountPathEntity, secretPath co
 		return nil, err
 	}
 
-	cacheKey := mountPath + "|" + path
+	cacheKey := mountPath + "|" + path + "|" + strconv.Itoa(version)
 
 	c.KVMutex.Lock()
 	defer c.KVMutex.Unlock()
@@ -64,7 +78,12 @@ func (c *agentCache) ReadKvSecret(enginePath core.MountPathEntity, secretPath co
 		return cacheEntry.Secret, nil
 	}
 
-	kvSecret, err := c.API.ReadKvSecret(enginePath, secretPath)
+	var kvSecret *kvsecret.KvSecret
+	if version != 0 {
+		kvSecret, err = c.API.ReadKvSecretVersion(ctx, enginePath, secretPath, version)
+	} else {
+		kvSecret, err = c.API.ReadKvSecret(ctx, enginePath, secretPath)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +96,11 @@ func (c *agentCache) ReadKvSecret(enginePath core.MountPathEntity, secretPath co
 	return kvSecret, nil
 }
 
-func (c *agentCache) IssueCertificate(enginePath core.MountPathEntity, role core.RoleNameEntity, options *pki.IssueCertOptions) (*pki.Certificate, error) {
+// IssueCertificate returns a cached certificate for the certificate template
+// with the given alias. A new certificate is issued if there is no cached
+// certificate yet, if it is due for renewal or if the options used to issue it
+// have changed.
+func (c *agentCache) IssueCertificate(ctx context.Context, alias string, enginePath core.MountPathEntity, role core.RoleNameEntity, options *pki.IssueCertOptions) (*pki.Certificate, error) {
 	mountPath, err := enginePath.GetMountPath()
 	if err != nil {
 		return nil, err
@@ -88,35 +111,82 @@ func (c *agentCache) IssueCertificate(enginePath core.MountPathEntity, role core
 		return nil, err
 	}
 
-	cacheKey := mountPath + "|" + roleName
+	encodedOptions, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := mountPath + "|" + roleName + "|" + string(encodedOptions)
 
 	c.CertificateMutex.Lock()
 	defer c.CertificateMutex.Unlock()
 
-	cacheEntry := c.CertificateCache[cacheKey]
-	if cacheEntry != nil && cacheEntry.ExpiresAt.After(time.Now()) {
+	cacheEntry := c.CertificateCache[alias]
+	if cacheEntry != nil && cacheEntry.Key == cacheKey && cacheEntry.ExpiresAt.After(time.Now()) {
 		return cacheEntry.Certificate, nil
 	}
 
-	certificate, err := c.API.IssueCertificate(enginePath, role, options)
+	certificate, err := c.API.IssueCertificate(ctx, enginePath, role, options)
 	if err != nil {
 		return nil, err
 	}
 
-	var expiresAt time.Time
-	block, _ := pem.Decode([]byte(certificate.Certificate))
-	switch parsedCert, err := x509.ParseCertificate(block.Bytes); {
+	entry := &certificateCacheEntry{
+		Key:         cacheKey,
+		CommonName:  options.CommonName,
+		Certificate: certificate,
+	}
+
+	switch notAfter, err := parseNotAfter(certificate.Certificate); {
 	case err != nil:
-		expiresAt = time.Now().Add(fallbackCertificateExpiryDuration)
+		entry.ExpiresAt = time.Now().Add(fallbackCertificateExpiryDuration)
 	default:
-		duration := time.Until(parsedCert.NotAfter)
-		expiresAt = time.Now().Add(duration / certificateExpiryTTLDivisor)
+		entry.NotAfter = &notAfter
+		entry.ExpiresAt = time.Now().Add(time.Until(notAfter) / certificateExpiryTTLDivisor)
 	}
 
-	c.CertificateCache[cacheKey] = &certificateCacheEntry{
-		ExpiresAt:   expiresAt,
-		Certificate: certificate,
-	}
+	c.CertificateCache[alias] = entry
 
 	return certificate, nil
 }
+
+// ListCertificates returns the status of all cached certificates sorted by alias.
+func (c *agentCache) ListCertificates() []*CertificateStatus {
+	c.CertificateMutex.Lock()
+	defer c.CertificateMutex.Unlock()
+
+	result := make([]*CertificateStatus, 0, len(c.CertificateCache))
+	for alias, entry := range c.CertificateCache {
+		status := &CertificateStatus{
+			Alias:        alias,
+			CommonName:   entry.CommonName,
+			SerialNumber: entry.Certificate.SerialNumber,
+			NextRenewal:  entry.ExpiresAt,
+		}
+		if entry.NotAfter != nil {
+			notAfter := *entry.NotAfter
+			status.NotAfter = &notAfter
+		}
+		result = append(result, status)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Alias < result[j].Alias
+	})
+
+	return result
+}
+
+func parseNotAfter(certificate string) (time.Time, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return time.Time{}, ErrAPIRequestFailed.WithDetails("issued certificate is not PEM encoded")
+	}
+
+	parsedCert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return parsedCert.NotAfter, nil
+}
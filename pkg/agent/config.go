@@ -17,8 +17,11 @@ type config struct {
 func (c *config) AddToLogger(log logr.Logger) logr.Logger {
 	return log.WithValues(
 		"vault_address", c.ClientConfig.Spec.Address,
+		"vault_failover_addresses", c.ClientConfig.Spec.FailoverAddresses,
 		"vault_role", c.ClientConfig.Spec.Role,
 		"vault_auth_mount_path", c.ClientConfig.Spec.AuthMountPath,
+		"vault_auth_method", c.ClientConfig.Spec.AuthMethod,
+		"vault_namespace", c.ClientConfig.Spec.Namespace,
 		"kv_secret_count", len(c.ClientConfig.Spec.KvSecrets),
 		"certificate_count", len(c.ClientConfig.Spec.Certificates),
 		"ca_count", len(c.ClientConfig.Spec.CertificateAuthorities),
@@ -33,6 +36,9 @@ func (c *config) SameVault(other *config) bool {
 	if c.ClientConfig.Spec.Address != other.ClientConfig.Spec.Address {
 		return false
 	}
+	if !reflect.DeepEqual(c.ClientConfig.Spec.FailoverAddresses, other.ClientConfig.Spec.FailoverAddresses) {
+		return false
+	}
 	if c.ClientConfig.Spec.Role != other.ClientConfig.Spec.Role {
 		return false
 	}
@@ -42,5 +48,26 @@ func (c *config) SameVault(other *config) bool {
 	if c.ClientConfig.Spec.AuthMountPath != other.ClientConfig.Spec.AuthMountPath {
 		return false
 	}
+	if c.ClientConfig.Spec.Namespace != other.ClientConfig.Spec.Namespace {
+		return false
+	}
+	if c.ClientConfig.Spec.AuthMethod != other.ClientConfig.Spec.AuthMethod {
+		return false
+	}
+	if c.ClientConfig.Spec.JWTPath != other.ClientConfig.Spec.JWTPath {
+		return false
+	}
+	if c.ClientConfig.Spec.AppRoleRoleID != other.ClientConfig.Spec.AppRoleRoleID {
+		return false
+	}
+	if c.ClientConfig.Spec.AppRoleSecretIDPath != other.ClientConfig.Spec.AppRoleSecretIDPath {
+		return false
+	}
+	if c.ClientConfig.Spec.ClientCertPath != other.ClientConfig.Spec.ClientCertPath {
+		return false
+	}
+	if c.ClientConfig.Spec.ClientKeyPath != other.ClientConfig.Spec.ClientKeyPath {
+		return false
+	}
 	return true
 }
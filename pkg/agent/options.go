@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/youniqx/heist/pkg/client/heist.youniqx.com/v1alpha1/clientset/heist"
+	"github.com/youniqx/heist/pkg/vault/core"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
@@ -47,6 +48,22 @@ func WithVaultToken(token string) Option {
 	}
 }
 
+// WithRetryPolicy sets the policy used to retry failed requests to Vault.
+func WithRetryPolicy(policy core.RetryPolicy) Option {
+	return func(a *agent) (err error) {
+		a.RetryPolicy = policy
+		return err
+	}
+}
+
+// WithRequestLimits limits the rate and concurrency of requests sent to Vault.
+func WithRequestLimits(limits *core.RequestLimits) Option {
+	return func(a *agent) (err error) {
+		a.RequestLimits = limits
+		return err
+	}
+}
+
 func WithKubeConfig(masterURL string, kubeConfigPath string) Option {
 	return func(a *agent) (err error) {
 		config, err := clientcmd.BuildConfigFromFlags(masterURL, kubeConfigPath)
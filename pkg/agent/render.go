@@ -2,6 +2,7 @@ package agent
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"text/template"
@@ -14,31 +15,32 @@ import (
 )
 
 type secretRenderer struct {
+	Context      context.Context
 	ClientConfig *v1alpha1.VaultClientConfig
 	Cache        *agentCache
 }
 
 func (r *secretRenderer) kvSecret(name string, field string) (string, error) {
-	enginePath, secretPath, err := r.getKvSecretPaths(name)
+	ref, err := r.getKvSecretRef(name)
 	if err != nil {
 		return "", err
 	}
 
-	secret, err := r.Cache.ReadKvSecret(enginePath, secretPath)
+	secret, err := r.Cache.ReadKvSecretVersion(r.Context, core.MountPath(ref.EnginePath), core.SecretPath(ref.SecretPath), ref.Version)
 	if err != nil {
 		return "", err
 	}
 	return secret.Fields[field], nil
 }
 
-func (r *secretRenderer) getKvSecretPaths(name string) (enginePath core.MountPathEntity, secretPath core.SecretPathEntity, err error) {
+func (r *secretRenderer) getKvSecretRef(name string) (*v1alpha1.VaultKVSecretRef, error) {
 	for _, kvSecret := range r.ClientConfig.Spec.KvSecrets {
 		if kvSecret.Name == name {
-			return core.MountPath(kvSecret.EnginePath), core.SecretPath(kvSecret.SecretPath), nil
+			return kvSecret, nil
 		}
 	}
 
-	return nil, nil, ErrNotFound.WithDetails(fmt.Sprintf("failed to find kv secret with name %s", name))
+	return nil, ErrNotFound.WithDetails(fmt.Sprintf("failed to find kv secret with name %s", name))
 }
 
 func (r *secretRenderer) caField(name string, field v1alpha1.VaultCertificateFieldType) (string, error) {
@@ -49,25 +51,25 @@ func (r *secretRenderer) caField(name string, field v1alpha1.VaultCertificateFie
 
 	switch field {
 	case v1alpha1.VaultBindingCertificateFieldTypeFullCertChain:
-		secret, err := r.Cache.ReadKvSecret(core.MountPath(info.KVSecrets.EnginePath), core.SecretPath(info.KVSecrets.PublicSecretPath))
+		secret, err := r.Cache.ReadKvSecret(r.Context, core.MountPath(info.KVSecrets.EnginePath), core.SecretPath(info.KVSecrets.PublicSecretPath))
 		if err != nil {
 			return "", err
 		}
 		return secret.Fields[common.CACertificateFullChainField], nil
 	case v1alpha1.VaultBindingCertificateFieldTypeCertChain:
-		secret, err := r.Cache.ReadKvSecret(core.MountPath(info.KVSecrets.EnginePath), core.SecretPath(info.KVSecrets.PublicSecretPath))
+		secret, err := r.Cache.ReadKvSecret(r.Context, core.MountPath(info.KVSecrets.EnginePath), core.SecretPath(info.KVSecrets.PublicSecretPath))
 		if err != nil {
 			return "", err
 		}
 		return secret.Fields[common.CACertificateChainField], nil
 	case v1alpha1.VaultBindingCertificateFieldTypeCertificate:
-		secret, err := r.Cache.ReadKvSecret(core.MountPath(info.KVSecrets.EnginePath), core.SecretPath(info.KVSecrets.PublicSecretPath))
+		secret, err := r.Cache.ReadKvSecret(r.Context, core.MountPath(info.KVSecrets.EnginePath), core.SecretPath(info.KVSecrets.PublicSecretPath))
 		if err != nil {
 			return "", err
 		}
 		return secret.Fields[common.CACertificateField], nil
 	case v1alpha1.VaultBindingCertificateFieldTypePrivateKey:
-		secret, err := r.Cache.ReadKvSecret(core.MountPath(info.KVSecrets.EnginePath), core.SecretPath(info.KVSecrets.PrivateSecretPath))
+		secret, err := r.Cache.ReadKvSecret(r.Context, core.MountPath(info.KVSecrets.EnginePath), core.SecretPath(info.KVSecrets.PrivateSecretPath))
 		if err != nil {
 			return "", err
 		}
@@ -93,7 +95,7 @@ func (r *secretRenderer) certField(name string, field v1alpha1.VaultCertificateF
 		return "", err
 	}
 
-	certificate, err := r.Cache.IssueCertificate(enginePath, roleName, issueOptions)
+	certificate, err := r.Cache.IssueCertificate(r.Context, name, enginePath, roleName, issueOptions)
 	if err != nil {
 		return "", err
 	}
@@ -9,16 +9,38 @@ import (
 	"github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
 	"github.com/youniqx/heist/pkg/vault"
 	"github.com/youniqx/heist/pkg/vault/core"
-	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
 )
 
 func (a *agent) Stop() {
+	if conf := a.getConfig(); conf != nil {
+		a.revokeToken(conf.API)
+	}
+
+	a.Cancel()
 	a.StopChannel <- true
 }
 
-const watchRetryDelay = 5 * time.Second
+const (
+	watchRetryDelay    = 5 * time.Second
+	revokeTokenTimeout = 5 * time.Second
+)
+
+// revokeToken revokes the Vault token of the passed API instance, so agent restarts
+// don't leave orphaned tokens in Vault.
+func (a *agent) revokeToken(api vault.API) {
+	if api == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), revokeTokenTimeout)
+	defer cancel()
+
+	if err := api.RevokeToken(ctx); err != nil {
+		a.Log.Info("failed to revoke Vault token", "error", err)
+	}
+}
 
 //nolint:cyclop,gocognit
 func (a *agent) Run() {
@@ -39,7 +61,7 @@ func (a *agent) Run() {
 		select {
 		case <-timer.C:
 			if informer == nil {
-				informer, err = a.ClientSet.HeistV1alpha1().VaultClientConfigs(a.Namespace).Watch(context.Background(), metav1.ListOptions{})
+				informer, err = a.ClientSet.HeistV1alpha1().VaultClientConfigs(a.Namespace).Watch(a.Context, metav1.ListOptions{})
 				if err != nil {
 					a.Log.Info("failed to create informer for the targeted VaultClientConfig object", "error", err)
 					resultChannel = fallbackResultChannel
@@ -49,7 +71,7 @@ func (a *agent) Run() {
 			}
 
 			if configSyncTime.Before(time.Now().Add(-time.Minute)) {
-				config, err := a.ClientSet.HeistV1alpha1().VaultClientConfigs(a.Namespace).Get(context.TODO(), a.Name, metav1.GetOptions{})
+				config, err := a.ClientSet.HeistV1alpha1().VaultClientConfigs(a.Namespace).Get(a.Context, a.Name, metav1.GetOptions{})
 				if err != nil {
 					a.Log.Info("failed to fetch VaultClientConfig object", "error", err)
 					continue
@@ -126,30 +148,45 @@ func (a *agent) updateConfig(conf *v1alpha1.VaultClientConfig) {
 		newConfig.Cache = a.Config.Cache
 		log.Info("reusing Vault API instance from old config since they refer to the same Vault instance")
 	} else {
-		var err error
-
 		cas := make([]core.StringSource, 0, len(newConfig.ClientConfig.Spec.CACerts))
 		for _, cert := range newConfig.ClientConfig.Spec.CACerts {
 			cas = append(cas, core.Value(cert))
 		}
 
-		if a.VaultToken != "" {
-			newConfig.API, err = vault.NewAPI().
-				WithAddressFrom(core.Value(newConfig.ClientConfig.Spec.Address)).
-				WithTokenFrom(core.Value(a.VaultToken)).
-				WithCAsFrom(cas...).
-				Complete()
-		} else {
-			newConfig.API, err = vault.NewAPI().
-				WithAddressFrom(core.Value(newConfig.ClientConfig.Spec.Address)).
-				WithAuthProvider(kubernetesauth.AuthProvider(
-					core.MountPath(newConfig.ClientConfig.Spec.AuthMountPath),
-					core.Value(newConfig.ClientConfig.Spec.Role),
-					core.File(a.TokenPath),
-				)).
-				WithCAsFrom(cas...).
-				Complete()
+		spec := &newConfig.ClientConfig.Spec
+
+		addresses := make([]core.StringSource, 0, len(spec.FailoverAddresses)+1)
+		addresses = append(addresses, core.Value(spec.Address))
+		for _, address := range spec.FailoverAddresses {
+			addresses = append(addresses, core.Value(address))
+		}
+
+		builder := vault.NewAPI().
+			WithAddressFrom(addresses...).
+			WithNamespaceFrom(core.Value(spec.Namespace)).
+			WithCAsFrom(cas...)
+
+		if a.RetryPolicy != nil {
+			builder = builder.WithRetryPolicy(a.RetryPolicy)
+		}
+
+		if a.RequestLimits != nil {
+			builder = builder.WithRequestLimits(a.RequestLimits)
 		}
+
+		if spec.ClientCertPath != "" {
+			builder = builder.WithClientCertFrom(core.File(spec.ClientCertPath))
+		}
+
+		if spec.ClientKeyPath != "" {
+			builder = builder.WithClientKeyFrom(core.File(spec.ClientKeyPath))
+		}
+
+		provider, err := a.createAuthProvider(spec)
+		if err == nil {
+			newConfig.API, err = builder.WithAuthProvider(provider).Complete()
+		}
+
 		if err != nil {
 			a.Status = &SyncStatus{
 				Status: StatusError,
@@ -160,6 +197,10 @@ func (a *agent) updateConfig(conf *v1alpha1.VaultClientConfig) {
 		}
 		newConfig.Cache = newCache(newConfig.API)
 		log.Info("created new Vault API instance")
+
+		if a.Config != nil {
+			a.revokeToken(a.Config.API)
+		}
 	}
 
 	a.Status = &SyncStatus{
@@ -1,5 +1,7 @@
 package agent
 
+import "time"
+
 type SyncStatus struct {
 	Status StatusType
 	Reason string
@@ -25,3 +27,46 @@ func (a *agent) GetStatus() *SyncStatus {
 		Reason: a.Status.Reason,
 	}
 }
+
+// CertificateStatus describes a certificate issued for a certificate template.
+type CertificateStatus struct {
+	// Alias is the alias of the certificate template.
+	Alias string `json:"alias"`
+	// CommonName is the common name the certificate has been issued for.
+	CommonName string `json:"commonName"`
+	// SerialNumber is the serial number of the certificate.
+	SerialNumber string `json:"serialNumber"`
+	// NotAfter is the time the certificate expires, it is not set if the
+	// certificate could not be parsed.
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+	// NextRenewal is the time after which the agent issues a new certificate.
+	NextRenewal time.Time `json:"nextRenewal"`
+}
+
+func (a *agent) GetCertificateStatus() []*CertificateStatus {
+	conf := a.getConfig()
+	if conf == nil || conf.Cache == nil {
+		return []*CertificateStatus{}
+	}
+
+	// The cache may still contain certificates of templates that have since
+	// been removed from the config, those are not reported.
+	configured := make(map[string]bool, len(conf.ClientConfig.Spec.Templates.CertificateTemplates))
+	for _, template := range conf.ClientConfig.Spec.Templates.CertificateTemplates {
+		if template.Alias != "" {
+			configured[template.Alias] = true
+		} else {
+			configured[template.CertificateRole] = true
+		}
+	}
+
+	certificates := conf.Cache.ListCertificates()
+	result := make([]*CertificateStatus, 0, len(certificates))
+	for _, certificate := range certificates {
+		if configured[certificate.Alias] {
+			result = append(result, certificate)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,125 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/erx"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/transit"
+)
+
+// ErrCapabilityNotGranted is returned when a bound object is used in a way its capabilities don't allow.
+var ErrCapabilityNotGranted = erx.New("Heist Agent", "capability not granted")
+
+func (a *agent) TransitEncrypt(ctx context.Context, name string, plainText []byte) (string, error) {
+	api, engine, key, err := a.getTransitKey(name, v1alpha1.VaultBindingTransitKeyCapabilityEncrypt)
+	if err != nil {
+		return "", err
+	}
+
+	cipherText, err := api.TransitEncrypt(ctx, engine, key, plainText)
+	if err != nil {
+		return "", ErrAPIRequestFailed.WithDetails("failed to encrypt plain text").WithCause(err)
+	}
+
+	return cipherText, nil
+}
+
+func (a *agent) TransitDecrypt(ctx context.Context, name string, cipherText string) ([]byte, error) {
+	api, engine, key, err := a.getTransitKey(name, v1alpha1.VaultBindingTransitKeyCapabilityDecrypt)
+	if err != nil {
+		return nil, err
+	}
+
+	plainText, err := api.TransitDecrypt(ctx, engine, key, cipherText)
+	if err != nil {
+		return nil, ErrAPIRequestFailed.WithDetails("failed to decrypt cipher text").WithCause(err)
+	}
+
+	return plainText, nil
+}
+
+func (a *agent) TransitSign(ctx context.Context, name string, input []byte) (string, error) {
+	api, engine, key, err := a.getTransitKey(name, v1alpha1.VaultBindingTransitKeyCapabilitySign)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := api.TransitSign(ctx, engine, key, input)
+	if err != nil {
+		return "", ErrAPIRequestFailed.WithDetails("failed to sign input").WithCause(err)
+	}
+
+	return signature, nil
+}
+
+func (a *agent) TransitVerify(ctx context.Context, name string, input []byte, signature string) (bool, error) {
+	api, engine, key, err := a.getTransitKey(name, v1alpha1.VaultBindingTransitKeyCapabilityVerify)
+	if err != nil {
+		return false, err
+	}
+
+	valid, err := api.TransitVerify(ctx, engine, key, input, signature)
+	if err != nil {
+		return false, ErrAPIRequestFailed.WithDetails("failed to verify signature").WithCause(err)
+	}
+
+	return valid, nil
+}
+
+func (a *agent) TransitHMAC(ctx context.Context, name string, input []byte) (string, error) {
+	api, engine, key, err := a.getTransitKey(name, v1alpha1.VaultBindingTransitKeyCapabilityHmac)
+	if err != nil {
+		return "", err
+	}
+
+	hmac, err := api.TransitHMAC(ctx, engine, key, input)
+	if err != nil {
+		return "", ErrAPIRequestFailed.WithDetails("failed to generate hmac").WithCause(err)
+	}
+
+	return hmac, nil
+}
+
+func (a *agent) TransitRewrap(ctx context.Context, name string, cipherText string) (string, error) {
+	api, engine, key, err := a.getTransitKey(name, v1alpha1.VaultBindingTransitKeyCapabilityRewrap)
+	if err != nil {
+		return "", err
+	}
+
+	rewrapped, err := api.TransitRewrap(ctx, engine, key, cipherText)
+	if err != nil {
+		return "", ErrAPIRequestFailed.WithDetails("failed to rewrap cipher text").WithCause(err)
+	}
+
+	return rewrapped, nil
+}
+
+func (a *agent) getTransitKey(name string, capability v1alpha1.VaultBindingTransitKeyCapability) (transit.API, core.MountPathEntity, transit.KeyNameEntity, error) {
+	log := a.Log.WithValues("operation", "getTransitKey", "name", name, "capability", capability)
+
+	conf := a.getConfig()
+	if conf == nil {
+		log.Info("failed to use transit key because config is not synced yet")
+		return nil, nil, nil, ErrNotYetSynced
+	}
+
+	for _, ref := range conf.ClientConfig.Spec.TransitKeys {
+		if ref.Name != name {
+			continue
+		}
+
+		for _, granted := range ref.Capabilities {
+			if granted == capability {
+				return conf.API, core.MountPath(ref.EnginePath), transit.KeyName(ref.KeyName), nil
+			}
+		}
+
+		log.Info("capability has not been granted for transit key")
+		return nil, nil, nil, ErrCapabilityNotGranted.WithDetails(fmt.Sprintf("capability %s has not been granted for transit key %s", capability, name))
+	}
+
+	return nil, nil, nil, ErrNotFound.WithDetails(fmt.Sprintf("failed to find transit key with name %s", name))
+}
@@ -17,12 +17,21 @@ limitations under the License.
 package agentserver_test
 
 import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -316,6 +325,10 @@ var _ = Describe("Heist Agent Server", func() {
 								Path:     "certificate-chain",
 								Template: "{{ certField \"cert_template_001\" \"cert_chain\" }}",
 							},
+							{
+								Path:     "other-certificate",
+								Template: "{{ certField \"cert_template_002\" \"certificate\" }}",
+							},
 						},
 						CertificateTemplates: []v1alpha1.VaultCertificateTemplate{
 							{
@@ -324,6 +337,12 @@ var _ = Describe("Heist Agent Server", func() {
 								CommonName:        "some-common-name",
 								ExcludeCNFromSans: true,
 							},
+							{
+								Alias:             "cert_template_002",
+								CertificateRole:   certificate.Name,
+								CommonName:        "other-common-name",
+								ExcludeCNFromSans: true,
+							},
 						},
 					},
 				},
@@ -383,6 +402,26 @@ var _ = Describe("Heist Agent Server", func() {
 			Expect(ReadFile(filepath.Join(secretOutputPath, "config.json"))).NotTo(BeEmpty())
 			Expect(ReadFilePerm(filepath.Join(secretOutputPath, "config.json"))).To(Equal(os.FileMode(0o640)))
 		})
+
+		It("Should issue separate certificates for templates using the same certificate role", func() {
+			Expect(ReadFile(filepath.Join(secretOutputPath, "secrets", "other-certificate"))).To(HavePrefix("-----"))
+			Expect(ReadFile(filepath.Join(secretOutputPath, "secrets", "other-certificate"))).
+				NotTo(Equal(ReadFile(filepath.Join(secretOutputPath, "secrets", "certificate"))))
+
+			recorder := httptest.NewRecorder()
+			request, _ := http.NewRequest("GET", "/status", nil)
+			instance.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			var response agentserver.StatusResponse
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.Certificates).To(HaveLen(2))
+			Expect(response.Certificates[0].Alias).To(Equal("cert_template_001"))
+			Expect(response.Certificates[0].CommonName).To(Equal("some-common-name"))
+			Expect(response.Certificates[1].Alias).To(Equal("cert_template_002"))
+			Expect(response.Certificates[1].CommonName).To(Equal("other-common-name"))
+			Expect(response.Certificates[0].SerialNumber).NotTo(Equal(response.Certificates[1].SerialNumber))
+		})
 	})
 })
 
@@ -423,7 +462,7 @@ func (m *mockAgent) ListSecrets() (names []string, err error) {
 	return []string{"some-secret"}, nil
 }
 
-func (m *mockAgent) FetchSecret(name string) (secret *agent.Secret, err error) {
+func (m *mockAgent) FetchSecret(_ context.Context, name string) (secret *agent.Secret, err error) {
 	if !m.Synced {
 		return nil, agent.ErrNotYetSynced
 	}
@@ -441,6 +480,89 @@ func (m *mockAgent) FetchSecret(name string) (secret *agent.Secret, err error) {
 	}
 }
 
+func (m *mockAgent) FetchKvSecretField(_ context.Context, name string, field string) (string, error) {
+	if !m.Synced {
+		return "", agent.ErrNotYetSynced
+	}
+
+	if name == "some-kv-secret" && field == "some-field" {
+		return "ASDF ASDF", nil
+	}
+
+	return "", agent.ErrNotFound
+}
+
+func (m *mockAgent) IssueCertificate(_ context.Context, alias string) (*pki.Certificate, error) {
+	if !m.Synced {
+		return nil, agent.ErrNotYetSynced
+	}
+
+	if alias != "some-certificate" {
+		return nil, agent.ErrNotFound
+	}
+
+	return &pki.Certificate{
+		Certificate:  "some-certificate",
+		PrivateKey:   "some-private-key",
+		SerialNumber: "01:02:03",
+	}, nil
+}
+
+func (m *mockAgent) getTransitKey(name string) error {
+	switch {
+	case !m.Synced:
+		return agent.ErrNotYetSynced
+	case name == "read-only-key":
+		return agent.ErrCapabilityNotGranted
+	case name != "some-key":
+		return agent.ErrNotFound
+	default:
+		return nil
+	}
+}
+
+func (m *mockAgent) TransitEncrypt(_ context.Context, name string, plainText []byte) (string, error) {
+	if err := m.getTransitKey(name); err != nil {
+		return "", err
+	}
+	return "vault:v1:" + base64.StdEncoding.EncodeToString(plainText), nil
+}
+
+func (m *mockAgent) TransitDecrypt(_ context.Context, name string, cipherText string) ([]byte, error) {
+	if err := m.getTransitKey(name); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(strings.TrimPrefix(cipherText, "vault:v1:"))
+}
+
+func (m *mockAgent) TransitSign(_ context.Context, name string, input []byte) (string, error) {
+	if err := m.getTransitKey(name); err != nil {
+		return "", err
+	}
+	return "vault:v1:signature", nil
+}
+
+func (m *mockAgent) TransitVerify(_ context.Context, name string, input []byte, signature string) (bool, error) {
+	if err := m.getTransitKey(name); err != nil {
+		return false, err
+	}
+	return signature == "vault:v1:signature", nil
+}
+
+func (m *mockAgent) TransitHMAC(_ context.Context, name string, input []byte) (string, error) {
+	if err := m.getTransitKey(name); err != nil {
+		return "", err
+	}
+	return "vault:v1:hmac", nil
+}
+
+func (m *mockAgent) TransitRewrap(_ context.Context, name string, cipherText string) (string, error) {
+	if err := m.getTransitKey(name); err != nil {
+		return "", err
+	}
+	return strings.Replace(cipherText, "vault:v1:", "vault:v2:", 1), nil
+}
+
 func (m *mockAgent) GetStatus() *agent.SyncStatus {
 	if !m.Synced {
 		return &agent.SyncStatus{
@@ -455,6 +577,24 @@ func (m *mockAgent) GetStatus() *agent.SyncStatus {
 	}
 }
 
+func (m *mockAgent) GetCertificateStatus() []*agent.CertificateStatus {
+	if !m.Synced {
+		return []*agent.CertificateStatus{}
+	}
+
+	notAfter := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	return []*agent.CertificateStatus{
+		{
+			Alias:        "some-certificate",
+			CommonName:   "some-common-name",
+			SerialNumber: "01:02:03",
+			NotAfter:     &notAfter,
+			NextRenewal:  notAfter.Add(-time.Hour),
+		},
+	}
+}
+
 func (m *mockAgent) Stop() {
 }
 
@@ -591,7 +731,7 @@ var _ = Describe("The Agent Server REST API", func() {
 			instance = agentserver.New(&mockAgent{
 				BasePath: secretOutputPath,
 				Synced:   true,
-			})
+			}, agentserver.WithAPITokenPath(filepath.Join(secretOutputPath, "agent-token")))
 			go func() {
 				defer GinkgoRecover()
 				Expect(instance.ListenAndServer(":8085")).NotTo(Succeed())
@@ -664,6 +804,142 @@ var _ = Describe("The Agent Server REST API", func() {
 			})
 		})
 
+		When("using the agent API", func() {
+			var token string
+
+			BeforeEach(func() {
+				token = ReadFile(filepath.Join(secretOutputPath, "agent-token"))
+				Expect(token).NotTo(BeEmpty())
+			})
+
+			newAPIRequest := func(method string, path string) *http.Request {
+				request, _ := http.NewRequest(method, path, nil)
+				request.Header.Set("Authorization", "Bearer "+token)
+				return request
+			}
+
+			It("rejects requests without a token", func() {
+				request, _ := http.NewRequest("GET", "/v1/templates", nil)
+				instance.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("rejects requests with an invalid token", func() {
+				request, _ := http.NewRequest("GET", "/v1/templates", nil)
+				request.Header.Set("Authorization", "Bearer invalid")
+				instance.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("lists all templates", func() {
+				instance.ServeHTTP(recorder, newAPIRequest("GET", "/v1/templates"))
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var response agentserver.TemplateListResponse
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Templates).To(ConsistOf("some-secret"))
+			})
+
+			It("renders a template", func() {
+				instance.ServeHTTP(recorder, newAPIRequest("GET", "/v1/templates/render?path=some-secret"))
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var response agentserver.ValueResponse
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Value).To(Equal("ASDF ASDF"))
+			})
+
+			It("returns a 404 status code for unknown templates", func() {
+				instance.ServeHTTP(recorder, newAPIRequest("GET", "/v1/templates/render?path=unknown"))
+				Expect(recorder.Code).To(Equal(http.StatusNotFound))
+			})
+
+			It("fetches a kv secret field", func() {
+				instance.ServeHTTP(recorder, newAPIRequest("GET", "/v1/kv/some-kv-secret/some-field"))
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var response agentserver.ValueResponse
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Value).To(Equal("ASDF ASDF"))
+			})
+
+			It("encrypts and decrypts values with a transit key", func() {
+				request := newAPIRequest("POST", "/v1/transit/some-key/encrypt")
+				request.Body = io.NopCloser(strings.NewReader(`{"plaintext":"QVNERiBBU0RG"}`))
+				instance.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var encrypted agentserver.TransitResponse
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &encrypted)).To(Succeed())
+				Expect(encrypted.CipherText).To(HavePrefix("vault:v1:"))
+
+				recorder = httptest.NewRecorder()
+				request = newAPIRequest("POST", "/v1/transit/some-key/decrypt")
+				request.Body = io.NopCloser(strings.NewReader(fmt.Sprintf(`{"ciphertext":%q}`, encrypted.CipherText)))
+				instance.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var decrypted agentserver.TransitResponse
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &decrypted)).To(Succeed())
+				Expect(string(decrypted.PlainText)).To(Equal("ASDF ASDF"))
+			})
+
+			It("verifies signatures with a transit key", func() {
+				request := newAPIRequest("POST", "/v1/transit/some-key/verify")
+				request.Body = io.NopCloser(strings.NewReader(`{"input":"QVNERiBBU0RG","signature":"vault:v1:signature"}`))
+				instance.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var response agentserver.TransitResponse
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Valid).NotTo(BeNil())
+				Expect(*response.Valid).To(BeTrue())
+			})
+
+			It("returns a 403 status code if the capability has not been granted", func() {
+				request := newAPIRequest("POST", "/v1/transit/read-only-key/encrypt")
+				request.Body = io.NopCloser(strings.NewReader(`{"plaintext":"QVNERiBBU0RG"}`))
+				instance.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+			})
+
+			It("returns a 400 status code for invalid request bodies", func() {
+				request := newAPIRequest("POST", "/v1/transit/some-key/encrypt")
+				request.Body = io.NopCloser(strings.NewReader(`not json`))
+				instance.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			})
+
+			It("issues a certificate", func() {
+				instance.ServeHTTP(recorder, newAPIRequest("POST", "/v1/certificates/some-certificate"))
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var response agentserver.CertificateResponse
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Certificate).To(Equal("some-certificate"))
+				Expect(response.PrivateKey).To(Equal("some-private-key"))
+				Expect(response.SerialNumber).To(Equal("01:02:03"))
+			})
+		})
+
+		When("requesting the agent status at /status", func() {
+			It("returns the sync status and all issued certificates", func() {
+				request, _ := http.NewRequest("GET", "/status", nil)
+				instance.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+
+				var response agentserver.StatusResponse
+				Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Status).To(Equal(agent.StatusSynced))
+				Expect(response.Synced).To(BeTrue())
+				Expect(response.Certificates).To(HaveLen(1))
+				Expect(response.Certificates[0].Alias).To(Equal("some-certificate"))
+				Expect(response.Certificates[0].SerialNumber).To(Equal("01:02:03"))
+				Expect(response.Certificates[0].NotAfter).NotTo(BeNil())
+				Expect(response.Certificates[0].NextRenewal).To(BeTemporally("<", *response.Certificates[0].NotAfter))
+			})
+		})
+
 		When("sending a shutdown request to /shutdown", func() {
 			Context("If making a GET request", func() {
 				It("returns a 200 status code", func() {
@@ -699,3 +975,238 @@ var _ = Describe("The Agent Server REST API", func() {
 		})
 	})
 })
+
+var _ = Describe("Writing files atomically", func() {
+	var (
+		directory string
+		path      string
+	)
+
+	BeforeEach(func() {
+		var err error
+		directory, err = os.MkdirTemp("", "")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(directory, "secret")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(directory)).To(Succeed())
+	})
+
+	listDirectory := func() []string {
+		entries, err := os.ReadDir(directory)
+		Expect(err).NotTo(HaveOccurred())
+
+		names := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		return names
+	}
+
+	It("replaces the file by renaming a temporary file instead of truncating it", func() {
+		Expect(os.WriteFile(path, []byte("old value"), 0o600)).To(Succeed())
+		previous, err := os.Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		defer previous.Close()
+		previousInfo, err := previous.Stat()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(agentserver.WriteFileAtomically(path, []byte("new value"), 0o600, agentserver.KeepOwner, agentserver.KeepOwner)).To(Succeed())
+
+		currentInfo, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.SameFile(previousInfo, currentInfo)).To(BeFalse())
+
+		previousContent, err := io.ReadAll(previous)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(previousContent)).To(Equal("old value"))
+
+		currentContent, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(currentContent)).To(Equal("new value"))
+		Expect(listDirectory()).To(ConsistOf("secret"))
+	})
+
+	It("applies the mode without the umask", func() {
+		oldMask := syscall.Umask(0o077)
+		defer syscall.Umask(oldMask)
+
+		Expect(agentserver.WriteFileAtomically(path, []byte("value"), 0o644, agentserver.KeepOwner, agentserver.KeepOwner)).To(Succeed())
+
+		info, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0o644)))
+	})
+
+	It("changes the owner of the file or fails if it is not permitted to", func() {
+		err := agentserver.WriteFileAtomically(path, []byte("value"), 0o600, 1234, 5678)
+
+		if os.Geteuid() != 0 {
+			Expect(err).To(HaveOccurred())
+			Expect(listDirectory()).To(BeEmpty())
+			return
+		}
+
+		Expect(err).NotTo(HaveOccurred())
+		info, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		stat, ok := info.Sys().(*syscall.Stat_t)
+		Expect(ok).To(BeTrue())
+		Expect(stat.Uid).To(Equal(uint32(1234)))
+		Expect(stat.Gid).To(Equal(uint32(5678)))
+	})
+
+	It("does not leave a temporary file behind if the write fails", func() {
+		Expect(os.MkdirAll(filepath.Join(path, "nested"), 0o700)).To(Succeed())
+
+		Expect(agentserver.WriteFileAtomically(path, []byte("value"), 0o600, agentserver.KeepOwner, agentserver.KeepOwner)).NotTo(Succeed())
+		Expect(listDirectory()).To(ConsistOf("secret"))
+	})
+})
+
+var _ = Describe("Reload actions", func() {
+	var directory string
+
+	BeforeEach(func() {
+		var err error
+		directory, err = os.MkdirTemp("", "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(directory)).To(Succeed())
+	})
+
+	It("sends a POST request to the configured endpoint by default", func() {
+		var method string
+		endpoint := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			method = request.Method
+		}))
+		defer endpoint.Close()
+
+		action := &v1alpha1.VaultBindingReloadAction{HTTP: &v1alpha1.VaultBindingHTTPReload{URL: endpoint.URL}}
+		Expect(agentserver.PerformReload(context.TODO(), action)).To(Succeed())
+		Expect(method).To(Equal(http.MethodPost))
+	})
+
+	It("fails if the endpoint does not respond with a 2xx status code", func() {
+		endpoint := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer endpoint.Close()
+
+		action := &v1alpha1.VaultBindingReloadAction{HTTP: &v1alpha1.VaultBindingHTTPReload{URL: endpoint.URL, Method: http.MethodPut}}
+		Expect(agentserver.PerformReload(context.TODO(), action)).To(MatchError(agentserver.ErrReloadFailed))
+	})
+
+	It("runs the configured command", func() {
+		marker := filepath.Join(directory, "reloaded")
+
+		action := &v1alpha1.VaultBindingReloadAction{Exec: &v1alpha1.VaultBindingExecReload{Command: []string{"touch", marker}}}
+		Expect(agentserver.PerformReload(context.TODO(), action)).To(Succeed())
+		Expect(marker).To(BeAnExistingFile())
+	})
+
+	It("fails if the command fails", func() {
+		action := &v1alpha1.VaultBindingReloadAction{Exec: &v1alpha1.VaultBindingExecReload{Command: []string{"false"}}}
+		Expect(agentserver.PerformReload(context.TODO(), action)).To(MatchError(agentserver.ErrReloadFailed))
+	})
+
+	When("sending a signal", func() {
+		writeProcess := func(pid string, name string, cgroup string) {
+			Expect(os.MkdirAll(filepath.Join(directory, pid), 0o700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(directory, pid, "comm"), []byte(name+"\n"), 0o600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(directory, pid, "cgroup"), []byte(cgroup), 0o600)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			DeferCleanup(agentserver.SetProcPath(directory))
+			writeProcess("self", "agent", "0::/agent\n")
+		})
+
+		It("signals matching processes outside of the agent container", func() {
+			command := exec.Command("sleep", "60")
+			Expect(command.Start()).To(Succeed())
+			defer func() { _ = command.Process.Kill() }()
+
+			writeProcess(strconv.Itoa(command.Process.Pid), "sleep", "0::/app\n")
+			// Sending a signal to this process would fail, as it does not exist.
+			writeProcess("2147483646", "sleep", "0::/agent\n")
+			writeProcess("2147483647", "other", "0::/app\n")
+
+			action := &v1alpha1.VaultBindingReloadAction{Signal: &v1alpha1.VaultBindingSignalReload{ProcessName: "sleep", Signal: v1alpha1.VaultBindingReloadSignalTERM}}
+			Expect(agentserver.PerformReload(context.TODO(), action)).To(Succeed())
+
+			err := command.Wait()
+			var exitError *exec.ExitError
+			Expect(errors.As(err, &exitError)).To(BeTrue())
+			status, ok := exitError.Sys().(syscall.WaitStatus)
+			Expect(ok).To(BeTrue())
+			Expect(status.Signal()).To(Equal(syscall.SIGTERM))
+		})
+
+		It("fails if only processes in the agent container match", func() {
+			writeProcess("2147483646", "sleep", "0::/agent\n")
+
+			action := &v1alpha1.VaultBindingReloadAction{Signal: &v1alpha1.VaultBindingSignalReload{ProcessName: "sleep"}}
+			Expect(agentserver.PerformReload(context.TODO(), action)).To(MatchError(agentserver.ErrReloadFailed))
+		})
+	})
+
+	It("is only performed after the content of a template has changed", func() {
+		marker := filepath.Join(directory, "reloaded")
+		templates := &templateAgent{
+			ClientSecret: &agent.Secret{Name: "config", OutputPath: filepath.Join(directory, "config"), Value: "{}", Mode: 0o600, UID: -1, GID: -1},
+			Secrets: map[string]*agent.Secret{
+				"some-template": {
+					Name:       "some-template",
+					OutputPath: filepath.Join(directory, "some-template"),
+					Value:      "first",
+					Mode:       0o600,
+					UID:        -1,
+					GID:        -1,
+					Reload:     &v1alpha1.VaultBindingReloadAction{Exec: &v1alpha1.VaultBindingExecReload{Command: []string{"touch", marker}}},
+				},
+			},
+		}
+		instance := agentserver.New(templates)
+
+		By("writing the template for the first time")
+		Expect(agentserver.TrySyncingSecrets(context.TODO(), instance)).To(Succeed())
+		Expect(marker).NotTo(BeAnExistingFile())
+
+		By("writing unchanged content")
+		Expect(agentserver.TrySyncingSecrets(context.TODO(), instance)).To(Succeed())
+		Expect(marker).NotTo(BeAnExistingFile())
+
+		By("writing changed content")
+		templates.Secrets["some-template"].Value = "second"
+		Expect(agentserver.TrySyncingSecrets(context.TODO(), instance)).To(Succeed())
+		Expect(marker).To(BeAnExistingFile())
+	})
+})
+
+// templateAgent is an agent.Agent which renders fixed templates.
+type templateAgent struct {
+	agent.Agent
+	ClientSecret *agent.Secret
+	Secrets      map[string]*agent.Secret
+}
+
+func (a *templateAgent) GetClientSecret() *agent.Secret {
+	return a.ClientSecret
+}
+
+func (a *templateAgent) ListSecrets() ([]string, error) {
+	names := make([]string, 0, len(a.Secrets))
+	for name := range a.Secrets {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func (a *templateAgent) FetchSecret(_ context.Context, name string) (*agent.Secret, error) {
+	secret := *a.Secrets[name]
+	return &secret, nil
+}
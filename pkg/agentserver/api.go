@@ -31,6 +31,8 @@ type server struct {
 	SyncedSecretMap   map[string]string
 	StopChannel       chan bool
 	ReadHeaderTimeout time.Duration
+	APITokenPath      string
+	APIToken          string
 	StatusLock        sync.Mutex
 	GlobalWorkChannel chan bool
 	SyncCompleted     bool
@@ -46,7 +48,7 @@ func (s *server) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 
 const stopChannelCapacity = 10
 
-func New(agent agent.Agent) Server {
+func New(agent agent.Agent, opts ...Option) Server {
 	instance := &server{
 		Log:               defaultLogger,
 		Agent:             agent,
@@ -56,9 +58,33 @@ func New(agent agent.Agent) Server {
 		ReadHeaderTimeout: readHeaderTimeout,
 	}
 
+	for _, opt := range opts {
+		opt(instance)
+	}
+
+	if instance.APITokenPath != "" {
+		token, err := generateAPIToken()
+		if err != nil {
+			instance.Log.Info("failed to generate agent API token, the agent API is disabled", "error", err)
+		}
+		instance.APIToken = token
+	}
+
 	instance.Mux.HandleFunc("/live", instance.live)
 	instance.Mux.HandleFunc("/ready", instance.ready)
 	instance.Mux.HandleFunc("/shutdown", instance.shutdown)
+	instance.Mux.HandleFunc("/status", instance.status)
+	instance.Mux.Handle("/metrics", newMetricsHandler(instance.Log))
+	instance.Mux.HandleFunc("GET /v1/templates", instance.authenticated(instance.listTemplates))
+	instance.Mux.HandleFunc("GET /v1/templates/render", instance.authenticated(instance.renderTemplate))
+	instance.Mux.HandleFunc("GET /v1/kv/{name}/{field}", instance.authenticated(instance.fetchKvSecretField))
+	instance.Mux.HandleFunc("POST /v1/certificates/{alias}", instance.authenticated(instance.issueCertificate))
+	instance.Mux.HandleFunc("POST /v1/transit/{key}/encrypt", instance.authenticated(instance.transitEncrypt))
+	instance.Mux.HandleFunc("POST /v1/transit/{key}/decrypt", instance.authenticated(instance.transitDecrypt))
+	instance.Mux.HandleFunc("POST /v1/transit/{key}/sign", instance.authenticated(instance.transitSign))
+	instance.Mux.HandleFunc("POST /v1/transit/{key}/verify", instance.authenticated(instance.transitVerify))
+	instance.Mux.HandleFunc("POST /v1/transit/{key}/hmac", instance.authenticated(instance.transitHMAC))
+	instance.Mux.HandleFunc("POST /v1/transit/{key}/rewrap", instance.authenticated(instance.transitRewrap))
 
 	return instance
 }
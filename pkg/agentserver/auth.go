@@ -0,0 +1,55 @@
+package agentserver
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	apiTokenBytes = 32
+	// apiTokenPerm allows all containers of the pod to read the token, it
+	// only protects the API from being accessed from outside the pod.
+	apiTokenPerm = 0o644
+)
+
+func generateAPIToken() (string, error) {
+	token := make([]byte, apiTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+func (s *server) writeAPIToken() error {
+	if s.APITokenPath == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.APITokenPath), secretFolderPerm); err != nil {
+		return err
+	}
+
+	return writeFileAtomically(s.APITokenPath, []byte(s.APIToken), apiTokenPerm, keepOwner, keepOwner)
+}
+
+func (s *server) authenticated(handler http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		if s.APIToken == "" {
+			writeError(writer, http.StatusForbidden, "the agent API is disabled")
+			return
+		}
+
+		token, found := strings.CutPrefix(request.Header.Get("Authorization"), "Bearer ")
+		if !found || subtle.ConstantTimeCompare([]byte(token), []byte(s.APIToken)) != 1 {
+			writeError(writer, http.StatusUnauthorized, "missing or invalid agent API token")
+			return
+		}
+
+		handler(writer, request)
+	}
+}
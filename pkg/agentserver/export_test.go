@@ -0,0 +1,32 @@
+package agentserver
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+)
+
+// These exports make internal functions available to the tests in the
+// agentserver_test package.
+
+var WriteFileAtomically = writeFileAtomically
+
+const KeepOwner = keepOwner
+
+func PerformReload(ctx context.Context, action *v1alpha1.VaultBindingReloadAction) error {
+	return (&server{Log: defaultLogger}).performReload(ctx, action)
+}
+
+func TrySyncingSecrets(ctx context.Context, instance Server) error {
+	return instance.(*server).TrySyncingSecrets(ctx)
+}
+
+// SetProcPath changes the path processes are searched in and returns a
+// function restoring the previous path.
+func SetProcPath(path string) func() {
+	previous := procPath
+	procPath = path
+	return func() {
+		procPath = previous
+	}
+}
@@ -0,0 +1,109 @@
+package agentserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/youniqx/heist/pkg/agent"
+)
+
+// TemplateListResponse is returned when listing the templates of the agent.
+type TemplateListResponse struct {
+	Templates []string `json:"templates"`
+}
+
+// ValueResponse is returned when fetching a rendered template or a KV secret field.
+type ValueResponse struct {
+	Value string `json:"value"`
+}
+
+// CertificateResponse is returned when issuing a certificate.
+type CertificateResponse struct {
+	Certificate  string   `json:"certificate"`
+	PrivateKey   string   `json:"private_key"`
+	IssuingCA    string   `json:"issuing_ca"`
+	CAChain      []string `json:"ca_chain"`
+	SerialNumber string   `json:"serial_number"`
+}
+
+// ErrorResponse is returned when a request to the agent API failed.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+func (s *server) listTemplates(writer http.ResponseWriter, request *http.Request) {
+	names, err := s.Agent.ListSecrets()
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &TemplateListResponse{Templates: names})
+}
+
+func (s *server) renderTemplate(writer http.ResponseWriter, request *http.Request) {
+	path := request.URL.Query().Get("path")
+	if path == "" {
+		writeError(writer, http.StatusBadRequest, "query parameter path is required")
+		return
+	}
+
+	secret, err := s.Agent.FetchSecret(request.Context(), path)
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &ValueResponse{Value: secret.Value})
+}
+
+func (s *server) fetchKvSecretField(writer http.ResponseWriter, request *http.Request) {
+	value, err := s.Agent.FetchKvSecretField(request.Context(), request.PathValue("name"), request.PathValue("field"))
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &ValueResponse{Value: value})
+}
+
+func (s *server) issueCertificate(writer http.ResponseWriter, request *http.Request) {
+	certificate, err := s.Agent.IssueCertificate(request.Context(), request.PathValue("alias"))
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &CertificateResponse{
+		Certificate:  certificate.Certificate,
+		PrivateKey:   certificate.PrivateKey,
+		IssuingCA:    certificate.IssuingCA,
+		CAChain:      certificate.CAChain,
+		SerialNumber: certificate.SerialNumber,
+	})
+}
+
+func (s *server) writeAgentError(writer http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, agent.ErrNotFound):
+		writeError(writer, http.StatusNotFound, err.Error())
+	case errors.Is(err, agent.ErrCapabilityNotGranted):
+		writeError(writer, http.StatusForbidden, err.Error())
+	case errors.Is(err, agent.ErrNotYetSynced):
+		writeError(writer, http.StatusServiceUnavailable, err.Error())
+	default:
+		s.Log.Info("agent API request failed", "error", err)
+		writeError(writer, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func writeError(writer http.ResponseWriter, status int, message string) {
+	writeJSON(writer, status, &ErrorResponse{Error: message})
+}
+
+func writeJSON(writer http.ResponseWriter, status int, value interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(value)
+}
@@ -0,0 +1,27 @@
+package agentserver
+
+import (
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+// newMetricsHandler serves the metrics of the requests the agent sends to
+// Vault, together with the default Go and process metrics.
+func newMetricsHandler(log logr.Logger) http.Handler {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	if err := core.RegisterMetrics(registry); err != nil {
+		log.Info("failed to register Vault metrics", "error", err)
+	}
+
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
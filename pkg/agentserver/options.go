@@ -0,0 +1,20 @@
+package agentserver
+
+import "github.com/go-logr/logr"
+
+type Option func(s *server)
+
+func WithLogger(logger logr.Logger) Option {
+	return func(s *server) {
+		s.Log = logger
+	}
+}
+
+// WithAPITokenPath enables the secret API of the agent server. A random token
+// is generated on startup and written to the given path. Clients have to send
+// it as bearer token to access the API.
+func WithAPITokenPath(path string) Option {
+	return func(s *server) {
+		s.APITokenPath = path
+	}
+}
@@ -0,0 +1,156 @@
+package agentserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/erx"
+)
+
+// ErrReloadFailed is returned when a reload action of a template could not be performed.
+var ErrReloadFailed = erx.New("Agent Server", "reload action failed")
+
+const (
+	reloadTimeout     = 30 * time.Second
+	defaultHTTPMethod = http.MethodPost
+)
+
+// procPath is the mount point of the proc filesystem used to find processes.
+var procPath = "/proc"
+
+var reloadSignals = map[v1alpha1.VaultBindingReloadSignal]syscall.Signal{
+	v1alpha1.VaultBindingReloadSignalHUP:  syscall.SIGHUP,
+	v1alpha1.VaultBindingReloadSignalINT:  syscall.SIGINT,
+	v1alpha1.VaultBindingReloadSignalQUIT: syscall.SIGQUIT,
+	v1alpha1.VaultBindingReloadSignalTERM: syscall.SIGTERM,
+	v1alpha1.VaultBindingReloadSignalUSR1: syscall.SIGUSR1,
+	v1alpha1.VaultBindingReloadSignalUSR2: syscall.SIGUSR2,
+}
+
+func (s *server) performReload(ctx context.Context, action *v1alpha1.VaultBindingReloadAction) error {
+	ctx, cancel := context.WithTimeout(ctx, reloadTimeout)
+	defer cancel()
+
+	switch {
+	case action.Signal != nil:
+		return sendSignal(action.Signal)
+	case action.HTTP != nil:
+		return sendHTTPRequest(ctx, action.HTTP)
+	case action.Exec != nil:
+		return runCommand(ctx, action.Exec)
+	default:
+		return ErrReloadFailed.WithDetails("no reload action configured")
+	}
+}
+
+func sendSignal(config *v1alpha1.VaultBindingSignalReload) error {
+	signalName := config.Signal
+	if signalName == "" {
+		signalName = v1alpha1.VaultBindingReloadSignalHUP
+	}
+
+	signal, ok := reloadSignals[signalName]
+	if !ok {
+		return ErrReloadFailed.WithDetails(fmt.Sprintf("unsupported signal %s", signalName))
+	}
+
+	pids, err := findProcesses(config.ProcessName)
+	if err != nil {
+		return ErrReloadFailed.WithDetails("failed to list processes").WithCause(err)
+	}
+
+	if len(pids) == 0 {
+		return ErrReloadFailed.WithDetails(fmt.Sprintf("no process with name %s found outside of the agent container, is shareProcessNamespace enabled for the pod?", config.ProcessName))
+	}
+
+	for _, pid := range pids {
+		if err := syscall.Kill(pid, signal); err != nil {
+			return ErrReloadFailed.WithDetails(fmt.Sprintf("failed to send %s to process %d", signalName, pid)).WithCause(err)
+		}
+	}
+
+	return nil
+}
+
+// findProcesses returns the IDs of all processes with the given name. The
+// agent itself and all other processes in the agent container are skipped,
+// which are identified by sharing the cgroup of the agent.
+func findProcesses(name string) ([]int, error) {
+	entries, err := os.ReadDir(procPath)
+	if err != nil {
+		return nil, err
+	}
+
+	self := os.Getpid()
+	selfCgroup, _ := os.ReadFile(filepath.Join(procPath, "self", "cgroup"))
+
+	var pids []int
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || pid == self {
+			continue
+		}
+
+		// Processes may exit while iterating, so errors are ignored here.
+		comm, err := os.ReadFile(filepath.Join(procPath, entry.Name(), "comm"))
+		if err != nil || strings.TrimSpace(string(comm)) != name {
+			continue
+		}
+
+		cgroup, err := os.ReadFile(filepath.Join(procPath, entry.Name(), "cgroup"))
+		if err != nil || (len(selfCgroup) > 0 && string(cgroup) == string(selfCgroup)) {
+			continue
+		}
+
+		pids = append(pids, pid)
+	}
+
+	return pids, nil
+}
+
+func sendHTTPRequest(ctx context.Context, config *v1alpha1.VaultBindingHTTPReload) error {
+	method := config.Method
+	if method == "" {
+		method = defaultHTTPMethod
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, config.URL, http.NoBody)
+	if err != nil {
+		return ErrReloadFailed.WithDetails("failed to create reload request").WithCause(err)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return ErrReloadFailed.WithDetails(fmt.Sprintf("failed to send reload request to %s", config.URL)).WithCause(err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return ErrReloadFailed.WithDetails(fmt.Sprintf("reload request to %s returned status %d", config.URL, response.StatusCode))
+	}
+
+	return nil
+}
+
+func runCommand(ctx context.Context, config *v1alpha1.VaultBindingExecReload) error {
+	if len(config.Command) == 0 {
+		return ErrReloadFailed.WithDetails("no command configured")
+	}
+
+	//nolint:gosec // the command is configured by the owner of the VaultBinding
+	cmd := exec.CommandContext(ctx, config.Command[0], config.Command[1:]...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return ErrReloadFailed.WithDetails(fmt.Sprintf("reload command failed: %s", strings.TrimSpace(string(output)))).WithCause(err)
+	}
+
+	return nil
+}
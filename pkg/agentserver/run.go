@@ -1,6 +1,7 @@
 package agentserver
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"time"
@@ -19,10 +20,13 @@ func (s *server) WorkerLoop(workChannel chan bool) {
 	s.GlobalWorkChannel = make(chan bool)
 	defer close(s.GlobalWorkChannel)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	timer := time.NewTicker(pollInterval)
 	defer timer.Stop()
 
-	s.performSync()
+	s.performSync(ctx)
 
 	updateChannel := make(chan bool, 1)
 	s.Agent.CreateUpdateChannel(updateChannel)
@@ -32,11 +36,12 @@ func (s *server) WorkerLoop(workChannel chan bool) {
 		case <-updateChannel:
 			s.NextSyncTime = time.Now()
 			s.Log.Info("syncing secrets after config update")
-			s.performSync()
+			s.performSync(ctx)
 		case <-timer.C:
-			s.performSync()
+			s.performSync(ctx)
 		case <-s.StopChannel:
 			s.Log.Info("Received stop signal, shutting down agent & server")
+			cancel()
 			s.setSyncStatus(false)
 			s.Agent.Stop()
 			if err := s.StopListening(); err != nil {
@@ -48,14 +53,14 @@ func (s *server) WorkerLoop(workChannel chan bool) {
 	}
 }
 
-func (s *server) performSync() {
+func (s *server) performSync(ctx context.Context) {
 	s.SyncLock.Lock()
 	defer s.SyncLock.Unlock()
 	if s.NextSyncTime.After(time.Now()) {
 		return
 	}
 	s.Log.Info("started syncing secrets")
-	if err := s.TrySyncingSecrets(); err != nil {
+	if err := s.TrySyncingSecrets(ctx); err != nil {
 		s.Log.Info("Failed to sync secrets", "error", err)
 		s.NextSyncTime = time.Now().Add(pollInterval)
 		return
@@ -78,12 +83,12 @@ func (s *server) IsSynced() bool {
 }
 
 //nolint:cyclop,gocognit
-func (s *server) TrySyncingSecrets() error {
+func (s *server) TrySyncingSecrets(ctx context.Context) error {
 	clientConfig := s.Agent.GetClientSecret()
 	if err := os.MkdirAll(filepath.Dir(clientConfig.OutputPath), secretFolderPerm); err != nil {
 		return err
 	}
-	if err := os.WriteFile(clientConfig.OutputPath, []byte(clientConfig.Value), clientConfig.Mode|minimumAgentPermissions); err != nil {
+	if err := writeFileAtomically(clientConfig.OutputPath, []byte(clientConfig.Value), clientConfig.Mode|minimumAgentPermissions, keepOwner, keepOwner); err != nil {
 		return err
 	}
 
@@ -97,7 +102,7 @@ func (s *server) TrySyncingSecrets() error {
 	for _, name := range secrets {
 		log := s.Log.WithValues("secret_name", name)
 
-		secret, err := s.Agent.FetchSecret(name)
+		secret, err := s.Agent.FetchSecret(ctx, name)
 		if err != nil {
 			log.Info("failed to fetch secret")
 			return err
@@ -105,7 +110,7 @@ func (s *server) TrySyncingSecrets() error {
 
 		mode := secret.Mode | minimumAgentPermissions
 
-		log = log.WithValues("output_path", secret.OutputPath, "permissions", mode)
+		log = log.WithValues("output_path", secret.OutputPath, "permissions", mode, "uid", secret.UID, "gid", secret.GID)
 
 		secretNames = append(secretNames, secret.Name)
 
@@ -113,15 +118,26 @@ func (s *server) TrySyncingSecrets() error {
 			return err
 		}
 
-		switch currentPath := s.SyncedSecretMap[secret.Name]; {
+		currentPath := s.SyncedSecretMap[secret.Name]
+
+		// The previous content has to be read before writing the file, so
+		// reload actions are only performed if the content has changed.
+		previousPath := secret.OutputPath
+		if currentPath != "" {
+			previousPath = currentPath
+		}
+		previousValue, existed := readExistingFile(previousPath)
+		changed := existed && previousValue != secret.Value
+
+		switch {
 		case currentPath == "":
 			log.Info("Secret is new, writing it to the disk for the first time")
-			if err := os.WriteFile(secret.OutputPath, []byte(secret.Value), mode); err != nil {
+			if err := writeFileAtomically(secret.OutputPath, []byte(secret.Value), mode, secret.UID, secret.GID); err != nil {
 				return err
 			}
 		case currentPath == secret.OutputPath:
 			log.Info("Secret already exists on disk, updating it")
-			if err := os.WriteFile(secret.OutputPath, []byte(secret.Value), mode); err != nil {
+			if err := writeFileAtomically(secret.OutputPath, []byte(secret.Value), mode, secret.UID, secret.GID); err != nil {
 				return err
 			}
 		default:
@@ -129,12 +145,19 @@ func (s *server) TrySyncingSecrets() error {
 			if err := os.Remove(currentPath); err != nil {
 				return err
 			}
-			if err := os.WriteFile(secret.OutputPath, []byte(secret.Value), mode); err != nil {
+			if err := writeFileAtomically(secret.OutputPath, []byte(secret.Value), mode, secret.UID, secret.GID); err != nil {
 				return err
 			}
 		}
 
 		s.SyncedSecretMap[secret.Name] = secret.OutputPath
+
+		if changed && secret.Reload != nil {
+			log.Info("Secret content has changed, performing reload action")
+			if err := s.performReload(ctx, secret.Reload); err != nil {
+				log.Info("failed to perform reload action", "error", err)
+			}
+		}
 	}
 
 	for name, path := range s.SyncedSecretMap {
@@ -2,9 +2,11 @@ package agentserver
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 
+	"github.com/youniqx/heist/pkg/agent"
 	"github.com/youniqx/heist/pkg/erx"
 )
 
@@ -19,6 +21,11 @@ func (s *server) ListenAndServer(address string) error {
 	errorChannel := make(chan error)
 	defer close(errorChannel)
 
+	if err := s.writeAPIToken(); err != nil {
+		log.Info("failed to write agent API token", "error", err)
+		return ErrServerError.WithDetails("failed to write agent API token").WithCause(err)
+	}
+
 	for i := 0; ; i++ {
 		startupLog := log.WithValues("attempt", i)
 
@@ -130,3 +137,27 @@ func (s *server) ready(writer http.ResponseWriter, request *http.Request) {
 		writer.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// StatusResponse is returned by the status endpoint of the agent server.
+type StatusResponse struct {
+	Status       agent.StatusType           `json:"status"`
+	Reason       string                     `json:"reason"`
+	Synced       bool                       `json:"synced"`
+	Certificates []*agent.CertificateStatus `json:"certificates"`
+}
+
+func (s *server) status(writer http.ResponseWriter, request *http.Request) {
+	syncStatus := s.Agent.GetStatus()
+
+	response := &StatusResponse{
+		Status:       syncStatus.Status,
+		Reason:       syncStatus.Reason,
+		Synced:       s.IsSynced(),
+		Certificates: s.Agent.GetCertificateStatus(),
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(response); err != nil {
+		s.Log.Info("failed to encode status response", "error", err)
+	}
+}
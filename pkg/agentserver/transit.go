@@ -0,0 +1,126 @@
+package agentserver
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const maxTransitRequestSize = 1 << 20
+
+// TransitRequest is the request body of the transit endpoints. Binary values
+// are base64 encoded.
+type TransitRequest struct {
+	PlainText  []byte `json:"plaintext,omitempty"`
+	CipherText string `json:"ciphertext,omitempty"`
+	Input      []byte `json:"input,omitempty"`
+	Signature  string `json:"signature,omitempty"`
+}
+
+// TransitResponse is returned by the transit endpoints. Only the fields
+// relevant to the requested operation are set.
+type TransitResponse struct {
+	PlainText  []byte `json:"plaintext,omitempty"`
+	CipherText string `json:"ciphertext,omitempty"`
+	Signature  string `json:"signature,omitempty"`
+	HMAC       string `json:"hmac,omitempty"`
+	Valid      *bool  `json:"valid,omitempty"`
+}
+
+func (s *server) transitEncrypt(writer http.ResponseWriter, request *http.Request) {
+	body, ok := decodeTransitRequest(writer, request)
+	if !ok {
+		return
+	}
+
+	cipherText, err := s.Agent.TransitEncrypt(request.Context(), request.PathValue("key"), body.PlainText)
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &TransitResponse{CipherText: cipherText})
+}
+
+func (s *server) transitDecrypt(writer http.ResponseWriter, request *http.Request) {
+	body, ok := decodeTransitRequest(writer, request)
+	if !ok {
+		return
+	}
+
+	plainText, err := s.Agent.TransitDecrypt(request.Context(), request.PathValue("key"), body.CipherText)
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &TransitResponse{PlainText: plainText})
+}
+
+func (s *server) transitSign(writer http.ResponseWriter, request *http.Request) {
+	body, ok := decodeTransitRequest(writer, request)
+	if !ok {
+		return
+	}
+
+	signature, err := s.Agent.TransitSign(request.Context(), request.PathValue("key"), body.Input)
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &TransitResponse{Signature: signature})
+}
+
+func (s *server) transitVerify(writer http.ResponseWriter, request *http.Request) {
+	body, ok := decodeTransitRequest(writer, request)
+	if !ok {
+		return
+	}
+
+	valid, err := s.Agent.TransitVerify(request.Context(), request.PathValue("key"), body.Input, body.Signature)
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &TransitResponse{Valid: &valid})
+}
+
+func (s *server) transitHMAC(writer http.ResponseWriter, request *http.Request) {
+	body, ok := decodeTransitRequest(writer, request)
+	if !ok {
+		return
+	}
+
+	hmac, err := s.Agent.TransitHMAC(request.Context(), request.PathValue("key"), body.Input)
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &TransitResponse{HMAC: hmac})
+}
+
+func (s *server) transitRewrap(writer http.ResponseWriter, request *http.Request) {
+	body, ok := decodeTransitRequest(writer, request)
+	if !ok {
+		return
+	}
+
+	cipherText, err := s.Agent.TransitRewrap(request.Context(), request.PathValue("key"), body.CipherText)
+	if err != nil {
+		s.writeAgentError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &TransitResponse{CipherText: cipherText})
+}
+
+func decodeTransitRequest(writer http.ResponseWriter, request *http.Request) (*TransitRequest, bool) {
+	body := &TransitRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(writer, request.Body, maxTransitRequestSize)).Decode(body); err != nil {
+		writeError(writer, http.StatusBadRequest, "failed to decode request body: "+err.Error())
+		return nil, false
+	}
+	return body, true
+}
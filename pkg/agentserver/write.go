@@ -0,0 +1,63 @@
+package agentserver
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// keepOwner can be passed as uid or gid to writeFileAtomically to keep the current owner.
+const keepOwner = -1
+
+// writeFileAtomically writes data to a temporary file next to path and renames
+// it to path afterwards. Since the rename is atomic, readers of the file either
+// see the previous or the new content, but never a partially written file.
+func writeFileAtomically(path string, data []byte, mode os.FileMode, uid int, gid int) (err error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+
+	// CreateTemp always creates the file with mode 0600, so the desired mode
+	// has to be set explicitly. This also ensures the umask is not applied.
+	if err := file.Chmod(mode); err != nil {
+		return err
+	}
+
+	if uid != keepOwner || gid != keepOwner {
+		if err := file.Chown(uid, gid); err != nil {
+			return err
+		}
+	}
+
+	if err := file.Sync(); err != nil {
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// readExistingFile returns the content of the file at path and whether it
+// could be read.
+func readExistingFile(path string) (string, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+
+	return string(data), true
+}
@@ -27,6 +27,10 @@ func (c *component) Register(api vault.API, mgr manager.Manager) error {
 		c.Log.Error(err, "unable to create webhook", "webhook", "VaultKVSecretEngine")
 		return err
 	}
+	if err := (&VaultPasswordPolicy{}).SetupWebhookWithManager(mgr); err != nil {
+		c.Log.Error(err, "unable to create webhook", "webhook", "VaultPasswordPolicy")
+		return err
+	}
 	if err := (&VaultBinding{}).SetupWebhookWithManager(mgr); err != nil {
 		c.Log.Error(err, "unable to create webhook", "webhook", "VaultBinding")
 		return err
@@ -8,10 +8,22 @@ var Conditions = &ConditionsWrapper{
 		Initializing:    "initializing",
 		ErrorConfig:     "config_error",
 		ErrorKubernetes: "kubernetes_error",
+		Conflict:        "conflict",
+		Drifted:         "drifted",
+		InSync:          "in_sync",
+		Repaired:        "repaired",
+
+		ErrorVaultPermissionDenied: "vault_permission_denied",
+		ErrorVaultNotFound:         "vault_not_found",
+		ErrorVaultSealed:           "vault_sealed",
+		ErrorVaultRateLimited:      "vault_rate_limited",
+		ErrorVaultInvalidRequest:   "vault_invalid_request",
 	},
 	Types: &ConditionType{
 		Provisioned: "Provisioned",
 		Active:      "Active",
+		Conflict:    "Conflict",
+		Drifted:     "Drifted",
 	},
 }
 
@@ -22,11 +34,23 @@ type ConditionReason struct {
 	Initializing    string
 	ErrorConfig     string
 	ErrorKubernetes string
+	Conflict        string
+	Drifted         string
+	InSync          string
+	Repaired        string
+
+	ErrorVaultPermissionDenied string
+	ErrorVaultNotFound         string
+	ErrorVaultSealed           string
+	ErrorVaultRateLimited      string
+	ErrorVaultInvalidRequest   string
 }
 
 type ConditionType struct {
 	Provisioned string
 	Active      string
+	Conflict    string
+	Drifted     string
 }
 
 type ConditionsWrapper struct {
@@ -0,0 +1,45 @@
+package v1alpha1
+
+// DriftPolicy configures how Heist handles changes made to an object in
+// Vault outside of Heist.
+// +kubebuilder:validation:Enum:=Enforce;Report;Ignore
+type DriftPolicy string
+
+const (
+	// DriftPolicyEnforce overwrites changes made in Vault with the desired
+	// state.
+	DriftPolicyEnforce DriftPolicy = "Enforce"
+	// DriftPolicyReport reports the drift in the conditions of the object and
+	// leaves the object in Vault untouched until the drift has been resolved.
+	DriftPolicyReport DriftPolicy = "Report"
+	// DriftPolicyIgnore disables the comparison with the state in Vault.
+	DriftPolicyIgnore DriftPolicy = "Ignore"
+)
+
+// GetDriftPolicy returns the drift policy of the secret, defaulting to Report
+// so secrets modified in Vault are never overwritten without being asked to.
+func (r *VaultKVSecret) GetDriftPolicy() DriftPolicy {
+	if r.Spec.DriftPolicy == "" {
+		return DriftPolicyReport
+	}
+
+	return r.Spec.DriftPolicy
+}
+
+// GetDriftPolicy returns the drift policy of the key, defaulting to Enforce.
+func (r *VaultTransitKey) GetDriftPolicy() DriftPolicy {
+	if r.Spec.DriftPolicy == "" {
+		return DriftPolicyEnforce
+	}
+
+	return r.Spec.DriftPolicy
+}
+
+// GetDriftPolicy returns the drift policy of the role, defaulting to Enforce.
+func (r *VaultCertificateRole) GetDriftPolicy() DriftPolicy {
+	if r.Spec.DriftPolicy == "" {
+		return DriftPolicyEnforce
+	}
+
+	return r.Spec.DriftPolicy
+}
@@ -72,6 +72,22 @@ type VaultBindingValueTemplate struct {
 	// +kubebuilder:validation:Pattern:=`^[0][0-7]{3}$`
 	Mode string `json:"mode,omitempty"`
 
+	// OwnerUID is the desired owner of the output file. The agent is the owner
+	// by default. Changing the owner requires the agent to run with the
+	// CAP_CHOWN capability.
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	OwnerUID *int64 `json:"ownerUID,omitempty"`
+
+	// OwnerGID is the desired group of the output file. The group of the agent
+	// is used by default. Without the CAP_CHOWN capability the agent can only
+	// change the group to one it is a member of, e.g. the fsGroup of the Pod.
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	OwnerGID *int64 `json:"ownerGID,omitempty"`
+
 	// Template is the template for this value.
 	// The template supports [sprig](https://masterminds.github.io/sprig/)
 	// template functions and can access all bound secrets and associated
@@ -87,6 +103,94 @@ type VaultBindingValueTemplate struct {
 	// +required
 	// +kubebuilder:validation:Required
 	Template string `json:"template,omitempty"`
+
+	// Reload is an action the agent performs after the rendered content of
+	// this template has changed, e.g. to make the application pick up a
+	// renewed certificate. The action is not performed when the file is
+	// written for the first time or when the content stays the same.
+	// +optional
+	// +kubebuilder:validation:Optional
+	Reload *VaultBindingReloadAction `json:"reload,omitempty"`
+}
+
+// VaultBindingReloadAction configures how the application is notified about a
+// changed template. Exactly one of Signal, HTTP or Exec must be configured.
+type VaultBindingReloadAction struct {
+	// Signal sends a signal to a process running in the pod. This requires
+	// shareProcessNamespace to be enabled on the pod, so the agent is able to
+	// see the processes of other containers.
+	// +optional
+	// +kubebuilder:validation:Optional
+	Signal *VaultBindingSignalReload `json:"signal,omitempty"`
+
+	// HTTP sends an HTTP request to an endpoint, e.g. a reload endpoint of
+	// the application.
+	// +optional
+	// +kubebuilder:validation:Optional
+	HTTP *VaultBindingHTTPReload `json:"http,omitempty"`
+
+	// Exec runs a command in the agent container.
+	// +optional
+	// +kubebuilder:validation:Optional
+	Exec *VaultBindingExecReload `json:"exec,omitempty"`
+}
+
+// VaultBindingReloadSignal is a signal which can be sent to a process when a
+// template has changed.
+// +kubebuilder:validation:Enum:=SIGHUP;SIGINT;SIGQUIT;SIGTERM;SIGUSR1;SIGUSR2
+type VaultBindingReloadSignal string
+
+const (
+	VaultBindingReloadSignalHUP  VaultBindingReloadSignal = "SIGHUP"
+	VaultBindingReloadSignalINT  VaultBindingReloadSignal = "SIGINT"
+	VaultBindingReloadSignalQUIT VaultBindingReloadSignal = "SIGQUIT"
+	VaultBindingReloadSignalTERM VaultBindingReloadSignal = "SIGTERM"
+	VaultBindingReloadSignalUSR1 VaultBindingReloadSignal = "SIGUSR1"
+	VaultBindingReloadSignalUSR2 VaultBindingReloadSignal = "SIGUSR2"
+)
+
+type VaultBindingSignalReload struct {
+	// ProcessName is the name of the process which should receive the
+	// signal, usually the main process of the application container. It is
+	// matched against the process name as reported in /proc/<pid>/comm, which
+	// is truncated to 15 characters by the kernel. The container a process
+	// belongs to is not taken into account, so all matching processes in the
+	// pod receive the signal, except for processes in the agent container.
+	// +required
+	// +kubebuilder:validation:Required
+	ProcessName string `json:"processName"`
+
+	// Signal is the signal sent to the process. SIGHUP is the default.
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=SIGHUP
+	Signal VaultBindingReloadSignal `json:"signal,omitempty"`
+}
+
+type VaultBindingHTTPReload struct {
+	// URL is the URL of the endpoint the request is sent to, e.g.
+	// http://localhost:8080/-/reload. Any response with a status code other
+	// than 2xx is treated as a failure.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^https?://`
+	URL string `json:"url"`
+
+	// Method is the HTTP method used for the request. POST is the default.
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:=GET;POST;PUT
+	// +kubebuilder:default:=POST
+	Method string `json:"method,omitempty"`
+}
+
+type VaultBindingExecReload struct {
+	// Command is the command to execute, followed by its arguments. It is
+	// not run in a shell, so use e.g. ["sh", "-c", "..."] if you need one.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	Command []string `json:"command"`
 }
 
 type VaultBindingAgentConfig struct {
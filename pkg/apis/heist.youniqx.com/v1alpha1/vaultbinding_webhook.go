@@ -82,5 +82,47 @@ func (r *VaultBinding) ValidateDelete() (warnings admission.Warnings, err error)
 }
 
 func (r *VaultBinding) validate(log logr.Logger) (warnings admission.Warnings, err error) {
+	for _, template := range r.Spec.Agent.Templates {
+		if template.Reload == nil {
+			continue
+		}
+
+		if err := validateReloadAction(template.Reload); err != nil {
+			log.Info("template has an invalid reload action", "path", template.Path, "error", err)
+			return nil, fmt.Errorf("template %s has an invalid reload action: %w", template.Path, err)
+		}
+	}
+
 	return nil, nil
 }
+
+func validateReloadAction(action *VaultBindingReloadAction) error {
+	configured := 0
+
+	if action.Signal != nil {
+		configured++
+		if action.Signal.ProcessName == "" {
+			return fmt.Errorf("signal reload requires a process name")
+		}
+	}
+
+	if action.HTTP != nil {
+		configured++
+		if action.HTTP.URL == "" {
+			return fmt.Errorf("http reload requires a url")
+		}
+	}
+
+	if action.Exec != nil {
+		configured++
+		if len(action.Exec.Command) == 0 {
+			return fmt.Errorf("exec reload requires a command")
+		}
+	}
+
+	if configured != 1 {
+		return fmt.Errorf("exactly one of signal, http or exec must be configured")
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VaultBinding Webhooks", func() {
+	Context("Creating VaultBindings with reload actions", func() {
+		var binding *VaultBinding
+
+		BeforeEach(func() {
+			binding = &VaultBinding{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "VaultBinding",
+					APIVersion: "heist.youniqx.com/v1alpha1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "some-binding",
+					Namespace: "default",
+				},
+				Spec: VaultBindingSpec{
+					Subject: VaultBindingSubject{
+						Name: "some-service-account",
+					},
+					Agent: VaultBindingAgentConfig{
+						Templates: []VaultBindingValueTemplate{
+							{
+								Path:     "some-file",
+								Template: "some-value",
+							},
+						},
+					},
+				},
+			}
+		})
+
+		When("Configuring a single reload action", func() {
+			BeforeEach(func() {
+				binding.Spec.Agent.Templates[0].Reload = &VaultBindingReloadAction{
+					Signal: &VaultBindingSignalReload{
+						ProcessName: "nginx",
+						Signal:      VaultBindingReloadSignalHUP,
+					},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(K8sClient.Delete(ctx, binding)).To(Succeed())
+			})
+
+			It("Should be able to create the binding", func() {
+				Expect(K8sClient.Create(ctx, binding)).To(Succeed())
+			})
+		})
+
+		When("Configuring multiple reload actions for the same template", func() {
+			BeforeEach(func() {
+				binding.Spec.Agent.Templates[0].Reload = &VaultBindingReloadAction{
+					Signal: &VaultBindingSignalReload{
+						ProcessName: "nginx",
+					},
+					Exec: &VaultBindingExecReload{
+						Command: []string{"nginx", "-s", "reload"},
+					},
+				}
+			})
+
+			It("Should throw an error", func() {
+				Expect(K8sClient.Create(ctx, binding)).NotTo(Succeed())
+			})
+		})
+
+		When("Configuring a reload without an action", func() {
+			BeforeEach(func() {
+				binding.Spec.Agent.Templates[0].Reload = &VaultBindingReloadAction{}
+			})
+
+			It("Should throw an error", func() {
+				Expect(K8sClient.Create(ctx, binding)).NotTo(Succeed())
+			})
+		})
+	})
+})
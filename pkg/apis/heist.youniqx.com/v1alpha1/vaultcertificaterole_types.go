@@ -28,6 +28,14 @@ type VaultCertificateRoleSpec struct {
 
 	// Settings configures the settings of the certificate.
 	Settings VaultCertificateRoleSettings `json:"settings,omitempty"`
+
+	// DriftPolicy configures how changes made to the role in Vault outside of
+	// Heist are handled. Enforce overwrites them, Report sets the Drifted
+	// condition and leaves the role untouched, Ignore disables the
+	// comparison. Defaults to Enforce.
+	// +optional
+	// +kubebuilder:validation:Optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 type VaultCertificateRoleSubject struct {
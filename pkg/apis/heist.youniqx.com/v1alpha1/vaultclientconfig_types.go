@@ -25,10 +25,33 @@ import (
 
 // VaultClientConfigSpec defines the desired state of VaultClientConfig.
 type VaultClientConfigSpec struct {
-	Address                string                          `json:"address,omitempty"`
-	Role                   string                          `json:"role,omitempty"`
-	CACerts                []string                        `json:"caCerts,omitempty"`
-	AuthMountPath          string                          `json:"authMountPath,omitempty"`
+	Address string `json:"address,omitempty"`
+	// FailoverAddresses are the addresses of further nodes of the same Vault cluster. The agent
+	// picks the active node using the sys/health endpoint of every node and fails over to another
+	// node as soon as the current one becomes unavailable.
+	FailoverAddresses []string `json:"failoverAddresses,omitempty"`
+	Role              string   `json:"role,omitempty"`
+	CACerts           []string `json:"caCerts,omitempty"`
+	AuthMountPath     string   `json:"authMountPath,omitempty"`
+	// AuthMethod is the Vault auth method the agent uses to log in. Defaults to kubernetes.
+	// +kubebuilder:validation:Enum=kubernetes;jwt;approle;cert
+	AuthMethod VaultClientConfigAuthMethod `json:"authMethod,omitempty"`
+	// JWTPath is the path to the JWT the agent uses to log in with the jwt auth method, e.g. a
+	// projected service account token with a custom audience.
+	JWTPath string `json:"jwtPath,omitempty"`
+	// AppRoleRoleID is the role ID the agent uses to log in with the approle auth method.
+	AppRoleRoleID string `json:"appRoleRoleID,omitempty"`
+	// AppRoleSecretIDPath is the path to the file containing the secret ID the agent uses to log in
+	// with the approle auth method.
+	AppRoleSecretIDPath string `json:"appRoleSecretIDPath,omitempty"`
+	// ClientCertPath is the path to the TLS client certificate the agent presents to Vault, e.g.
+	// if the Vault listener requires mutual TLS. It is required for the cert auth method. Rotated
+	// certificates are reloaded without restarting the agent.
+	ClientCertPath string `json:"clientCertPath,omitempty"`
+	// ClientKeyPath is the path to the private key of the TLS client certificate.
+	ClientKeyPath string `json:"clientKeyPath,omitempty"`
+	// Namespace is the Vault Enterprise namespace the agent logs in to and reads secrets from.
+	Namespace              string                          `json:"namespace,omitempty"`
 	CertificateAuthorities []*VaultCertificateAuthorityRef `json:"certificateAuthorities,omitempty"`
 	KvSecrets              []*VaultKVSecretRef             `json:"kvSecrets,omitempty"`
 	Certificates           []*VaultCertificateRef          `json:"certificates,omitempty"`
@@ -36,6 +59,20 @@ type VaultClientConfigSpec struct {
 	Templates              VaultBindingAgentConfig         `json:"templates,omitempty"`
 }
 
+// VaultClientConfigAuthMethod is the Vault auth method used by the agent.
+type VaultClientConfigAuthMethod string
+
+const (
+	// VaultClientConfigAuthMethodKubernetes logs in using the service account token of the agent.
+	VaultClientConfigAuthMethodKubernetes VaultClientConfigAuthMethod = "kubernetes"
+	// VaultClientConfigAuthMethodJWT logs in using the JWT configured in jwtPath.
+	VaultClientConfigAuthMethodJWT VaultClientConfigAuthMethod = "jwt"
+	// VaultClientConfigAuthMethodAppRole logs in using the configured role ID and secret ID.
+	VaultClientConfigAuthMethodAppRole VaultClientConfigAuthMethod = "approle"
+	// VaultClientConfigAuthMethodCert logs in using the configured TLS client certificate.
+	VaultClientConfigAuthMethodCert VaultClientConfigAuthMethod = "cert"
+)
+
 type VaultCertificateAuthorityRef struct {
 	Name         string                                       `json:"name,omitempty"`
 	EnginePath   string                                       `json:"enginePath,omitempty"`
@@ -50,9 +87,11 @@ type VaultCertificateAuthorityKVSecretRef struct {
 }
 
 type VaultKVSecretRef struct {
-	Name         string                     `json:"name,omitempty"`
-	EnginePath   string                     `json:"enginePath,omitempty"`
-	SecretPath   string                     `json:"secretPath,omitempty"`
+	Name       string `json:"name,omitempty"`
+	EnginePath string `json:"enginePath,omitempty"`
+	SecretPath string `json:"secretPath,omitempty"`
+	// Version is the pinned version of the secret, the latest version is used if it is not set.
+	Version      int                        `json:"version,omitempty"`
 	Capabilities []VaultBindingKVCapability `json:"capabilities,omitempty"`
 }
 
@@ -17,18 +17,84 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+// AnnotationOverwriteConflicts is an annotation used to let Heist overwrite a
+// secret which has been modified in Vault outside of Heist. It is removed
+// once the secret has been written.
+const AnnotationOverwriteConflicts = "heist.youniqx.com/overwrite-conflicts"
+
+// AnnotationRotateFields is an annotation used to let Heist regenerate the
+// values of auto generated fields immediately. It contains a comma separated
+// list of field names, or * to rotate all auto generated fields. It is removed
+// once the new values have been written.
+const AnnotationRotateFields = "heist.youniqx.com/rotate-fields"
+
+// DefaultAutoGeneratedLength is the length of auto generated values if
+// AutoGeneratedLength is not set.
+const DefaultAutoGeneratedLength = 64
+
+// FieldGenerator configures the kind of value which is generated for an auto
+// generated field.
+// +kubebuilder:validation:Enum:=string;uuid;rsa;ecdsa;ed25519;ssh;bcrypt;htpasswd
+type FieldGenerator string
+
+const (
+	// FieldGeneratorString generates a random string. This is the default.
+	FieldGeneratorString FieldGenerator = "string"
+	// FieldGeneratorUUID generates a random version 4 UUID.
+	FieldGeneratorUUID FieldGenerator = "uuid"
+	// FieldGeneratorRSA generates a PEM encoded PKCS #8 RSA private key.
+	FieldGeneratorRSA FieldGenerator = "rsa"
+	// FieldGeneratorECDSA generates a PEM encoded PKCS #8 ECDSA private key.
+	FieldGeneratorECDSA FieldGenerator = "ecdsa"
+	// FieldGeneratorEd25519 generates a PEM encoded PKCS #8 Ed25519 private key.
+	FieldGeneratorEd25519 FieldGenerator = "ed25519"
+	// FieldGeneratorSSH generates an Ed25519 private key in the OpenSSH format.
+	FieldGeneratorSSH FieldGenerator = "ssh"
+	// FieldGeneratorBcrypt generates a bcrypt hash of the source field.
+	FieldGeneratorBcrypt FieldGenerator = "bcrypt"
+	// FieldGeneratorHtpasswd generates a htpasswd entry with a bcrypt hash of
+	// the source field.
+	FieldGeneratorHtpasswd FieldGenerator = "htpasswd"
+)
+
+// MaxHashSourceLength is the maximum length in bytes of values hashed by the
+// bcrypt and htpasswd generators, as bcrypt doesn't support longer passwords.
+const MaxHashSourceLength = 72
+
+// DefaultRSAKeyBits is the size of generated RSA keys if KeyBits is not set.
+const DefaultRSAKeyBits = 2048
+
+// DefaultECDSAKeyBits is the curve size of generated ECDSA keys if KeyBits is
+// not set.
+const DefaultECDSAKeyBits = 256
+
+// PublicKeyFieldSuffix is appended to the name of a key pair field to get the
+// name of the field storing the public key if PublicKeyField is not set.
+const PublicKeyFieldSuffix = ".pub"
+
 // EncryptedValue represents a value that has been encrypted by Heists managed Transit Engine.
 // +optional
 // +kubebuilder:validation:Optional
 // +kubebuilder:validation:Pattern:=`^vault:([a-z0-9]+):(.+)$`
 type EncryptedValue string
 
+// VaultKVSecretFieldSource configures where the value of a field is read from.
+type VaultKVSecretFieldSource struct {
+	// SecretKeyRef selects a key of a Kubernetes Secret in the namespace of
+	// the VaultKVSecret. Changes to the Secret are written to Vault.
+	// +required
+	// +kubebuilder:validation:Required
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef"`
+}
+
 // VaultKVSecretField defines the desired state of a field in a VaultKVSecret.
 type VaultKVSecretField struct {
 	// CipherText represents a value which has been encrypted by Heists managed
@@ -37,6 +103,13 @@ type VaultKVSecretField struct {
 	// +kubebuilder:validation:Optional
 	CipherText EncryptedValue `json:"ciphertext,omitempty"`
 
+	// ValueFrom configures that the value of the field is read from a
+	// Kubernetes Secret. Must not be used in combination with CipherText or
+	// AutoGenerated.
+	// +optional
+	// +kubebuilder:validation:Optional
+	ValueFrom *VaultKVSecretFieldSource `json:"valueFrom,omitempty"`
+
 	// AutoGenerated configures that the secret should have an autogenerated value.
 	// Must be set to false when using a custom stringValue or custom cipherText.
 	// Defaults to true.
@@ -50,6 +123,68 @@ type VaultKVSecretField struct {
 	// +optional
 	// +kubebuilder:validation:Optional
 	AutoGeneratedLength int `json:"autoGeneratedLength,omitempty"`
+
+	// RotationPeriod can be used in combination with AutoGenerated.
+	// It configures how often a new value is generated for the field, e.g.
+	// 2160h for 90 days. When stored in a v2 engine the previous value stays
+	// available as the previous version of the secret. Values are not rotated
+	// by default.
+	// +optional
+	// +kubebuilder:validation:Optional
+	RotationPeriod metav1.Duration `json:"rotationPeriod,omitempty"`
+
+	// PasswordPolicy can be used in combination with AutoGenerated.
+	// It references a VaultPasswordPolicy in the same namespace which is used
+	// to generate the value through Vaults password generator. The length of
+	// the value is configured by the policy.
+	// +optional
+	// +kubebuilder:validation:Optional
+	PasswordPolicy string `json:"passwordPolicy,omitempty"`
+
+	// CharsetRules can be used in combination with AutoGenerated.
+	// It configures the charsets the value is generated from using Vaults
+	// password generator. The length of the value is configured by
+	// AutoGeneratedLength.
+	// +optional
+	// +kubebuilder:validation:Optional
+	CharsetRules []VaultPasswordPolicyRule `json:"charsetRules,omitempty"`
+
+	// Generator can be used in combination with AutoGenerated.
+	// It configures the kind of value which is generated for the field. Key
+	// pair generators (rsa, ecdsa, ed25519, ssh) store the private key in the
+	// field and the matching public key in the field configured by
+	// PublicKeyField. Hash generators (bcrypt, htpasswd) store a hash of the
+	// field configured by Source and are regenerated when its value changes.
+	// Defaults to string.
+	// +optional
+	// +kubebuilder:validation:Optional
+	Generator FieldGenerator `json:"generator,omitempty"`
+
+	// KeyBits can be used in combination with the rsa and ecdsa generators.
+	// It configures the size of RSA keys (2048, 3072 or 4096, default 2048)
+	// or the curve of ECDSA keys (256, 384 or 521, default 256).
+	// +optional
+	// +kubebuilder:validation:Optional
+	KeyBits int `json:"keyBits,omitempty"`
+
+	// PublicKeyField can be used in combination with key pair generators.
+	// It configures the name of the field the public key is stored in. The
+	// default is the name of this field with a .pub suffix.
+	// +optional
+	// +kubebuilder:validation:Optional
+	PublicKeyField string `json:"publicKeyField,omitempty"`
+
+	// Source is required by hash generators. It configures the name of the
+	// field in this secret whose value is hashed.
+	// +optional
+	// +kubebuilder:validation:Optional
+	Source string `json:"source,omitempty"`
+
+	// Username is required by the htpasswd generator. It configures the user
+	// name of the generated htpasswd entry.
+	// +optional
+	// +kubebuilder:validation:Optional
+	Username string `json:"username,omitempty"`
 }
 
 // VaultKVSecretSpec defines the desired secret's fields and the secret's config.
@@ -78,6 +213,22 @@ type VaultKVSecretSpec struct {
 	// +optional
 	// +kubebuilder:validation:Optional
 	DeleteProtection bool `json:"deleteProtection,omitempty"`
+
+	// PinnedVersion configures the version of the secret which is handed out
+	// by agents and VaultSyncSecrets instead of the latest one. This can be
+	// used to roll back to a previous version. Only supported by v2 engines.
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	PinnedVersion int `json:"pinnedVersion,omitempty"`
+
+	// DriftPolicy configures how changes made to the secret in Vault outside
+	// of Heist are handled. Enforce overwrites them, Report sets the Conflict
+	// condition and stops writing the secret until the overwrite-conflicts
+	// annotation is set, Ignore disables the comparison. Defaults to Report.
+	// +optional
+	// +kubebuilder:validation:Optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // VaultKVSecretStatus defines the observed state of VaultKVSecret.
@@ -93,6 +244,12 @@ type VaultKVSecretStatus struct {
 	// +optional
 	Engine string `json:"engine,omitempty"`
 
+	// EngineVersion is the version of the engine used to store this secret.
+	// Secrets provisioned before the version was recorded are treated as
+	// stored in a v2 engine.
+	// +optional
+	EngineVersion kvengine.Version `json:"engineVersion,omitempty"`
+
 	// Path is the relative path this secret inside its engine.
 	// +optional
 	Path string `json:"path,omitempty"`
@@ -101,6 +258,27 @@ type VaultKVSecretStatus struct {
 	// stored in Vault
 	// +optional
 	Fields map[string]string `json:"fields,omitempty"`
+
+	// CurrentVersion is the latest version of this secret stored in Vault.
+	// Only set for secrets stored in v2 engines.
+	// +optional
+	CurrentVersion int `json:"currentVersion,omitempty"`
+
+	// PreviousVersions lists all previous versions of this secret which are
+	// still available in Vault.
+	// +optional
+	PreviousVersions []int `json:"previousVersions,omitempty"`
+
+	// WrittenVersion is the version of this secret Heist has last written to
+	// Vault. It is used for check-and-set writes to detect changes made to
+	// the secret outside of Heist.
+	// +optional
+	WrittenVersion int `json:"writtenVersion,omitempty"`
+
+	// LastRotated is a map of field names to the time the value of an auto
+	// generated field has last been generated.
+	// +optional
+	LastRotated map[string]metav1.Time `json:"lastRotated,omitempty"`
 }
 
 // +kubebuilder:resource:shortName=kvs,categories=heist;youniqx
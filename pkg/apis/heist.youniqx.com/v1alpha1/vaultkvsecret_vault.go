@@ -5,3 +5,44 @@ import "path/filepath"
 func (r *VaultKVSecret) GetSecretPath() (string, error) {
 	return filepath.Join(r.Spec.Path, r.Name), nil
 }
+
+// GetGenerator returns the generator used for the field, defaulting to
+// FieldGeneratorString.
+func (f *VaultKVSecretField) GetGenerator() FieldGenerator {
+	if f.Generator == "" {
+		return FieldGeneratorString
+	}
+
+	return f.Generator
+}
+
+// IsKeyPair returns true if the field is generated by a key pair generator.
+func (f *VaultKVSecretField) IsKeyPair() bool {
+	switch f.GetGenerator() {
+	case FieldGeneratorRSA, FieldGeneratorECDSA, FieldGeneratorEd25519, FieldGeneratorSSH:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDerived returns true if the value of the field is derived from the value
+// of its source field.
+func (f *VaultKVSecretField) IsDerived() bool {
+	switch f.GetGenerator() {
+	case FieldGeneratorBcrypt, FieldGeneratorHtpasswd:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetPublicKeyField returns the name of the field the public key of a key
+// pair field called name is stored in.
+func (f *VaultKVSecretField) GetPublicKeyField(name string) string {
+	if f.PublicKeyField != "" {
+		return f.PublicKeyField
+	}
+
+	return name + PublicKeyFieldSuffix
+}
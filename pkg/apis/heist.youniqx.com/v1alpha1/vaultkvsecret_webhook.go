@@ -111,6 +111,11 @@ func (r *VaultKVSecret) validate(log logr.Logger) (warnings admission.Warnings,
 		return nil, fmt.Errorf("the Path parameter must not start with a slash")
 	}
 
+	if r.Spec.PinnedVersion < 0 {
+		log.Info("rejecting change: PinnedVersion is set to a negative value")
+		return nil, fmt.Errorf("the PinnedVersion parameter must not be negative")
+	}
+
 	for key, config := range r.Spec.Fields {
 		warnings, err = r.validateField(log, config, key)
 		if err != nil {
@@ -122,6 +127,10 @@ func (r *VaultKVSecret) validate(log logr.Logger) (warnings admission.Warnings,
 }
 
 func (r *VaultKVSecret) validateField(log logr.Logger, config *VaultKVSecretField, key string) (warnings admission.Warnings, err error) {
+	if config.ValueFrom != nil {
+		return r.validateValueFrom(log, config, key)
+	}
+
 	if config.AutoGenerated && config.CipherText != "" {
 		log.Info("rejecting change: field has both a cipher text and the AutoGenerated flag set.", "field", key)
 		return nil, fmt.Errorf("field %s has both a cipher text value and the AutoGenerated flag set. That is not possible. Pick either an auto generated value or a fixed value", key)
@@ -137,6 +146,21 @@ func (r *VaultKVSecret) validateField(log logr.Logger, config *VaultKVSecretFiel
 			log.Info("rejecting change: AutoGeneratedLength parameter is set but AutoGenerated flag is set to false", "field", key)
 			return nil, fmt.Errorf("field %s has the AutoGeneratedLength parameter set even though the AutoGenerated flag is set to false", key)
 		}
+
+		if config.RotationPeriod.Duration != 0 {
+			log.Info("rejecting change: RotationPeriod parameter is set but AutoGenerated flag is set to false", "field", key)
+			return nil, fmt.Errorf("field %s has the RotationPeriod parameter set even though the AutoGenerated flag is set to false", key)
+		}
+
+		if config.PasswordPolicy != "" || len(config.CharsetRules) > 0 {
+			log.Info("rejecting change: password generation parameters are set but AutoGenerated flag is set to false", "field", key)
+			return nil, fmt.Errorf("field %s has the PasswordPolicy or CharsetRules parameter set even though the AutoGenerated flag is set to false", key)
+		}
+
+		if config.Generator != "" || config.KeyBits != 0 || config.PublicKeyField != "" || config.Source != "" || config.Username != "" {
+			log.Info("rejecting change: generator parameters are set but AutoGenerated flag is set to false", "field", key)
+			return nil, fmt.Errorf("field %s has generator parameters set even though the AutoGenerated flag is set to false", key)
+		}
 		return nil, nil
 	}
 
@@ -146,9 +170,182 @@ func (r *VaultKVSecret) validateField(log logr.Logger, config *VaultKVSecretFiel
 			return nil, fmt.Errorf("field %s has a negative value for auto generated length", key)
 		}
 
+		if config.RotationPeriod.Duration < 0 {
+			log.Info("rejecting change: RotationPeriod parameter is set to a negative value", "field", key)
+			return nil, fmt.Errorf("field %s has a negative rotation period", key)
+		}
+
+		return r.validateGenerator(log, config, key)
+	}
+
+	log.Info("rejecting change: Neither the AutoGenerated parameter nor the CipherText or ValueFrom field is set", "field", key)
+	return nil, fmt.Errorf("field %s has neither the AutoGenerated flag, a CipherText or a ValueFrom set - you have to specify at least one", key)
+}
+
+func (r *VaultKVSecret) validateValueFrom(log logr.Logger, config *VaultKVSecretField, key string) (warnings admission.Warnings, err error) {
+	if config.AutoGenerated || config.CipherText != "" {
+		log.Info("rejecting change: field has ValueFrom set in combination with a cipher text or the AutoGenerated flag", "field", key)
+		return nil, fmt.Errorf("field %s has ValueFrom set in combination with a cipher text value or the AutoGenerated flag. Pick either a value from a secret, an auto generated value or a fixed value", key)
+	}
+
+	if config.AutoGeneratedLength != 0 || config.RotationPeriod.Duration != 0 || config.PasswordPolicy != "" || len(config.CharsetRules) > 0 ||
+		config.Generator != "" || config.KeyBits != 0 || config.PublicKeyField != "" || config.Source != "" || config.Username != "" {
+		log.Info("rejecting change: generator parameters are set but the value is read from a secret", "field", key)
+		return nil, fmt.Errorf("field %s has generator parameters set even though its value is read from a secret", key)
+	}
+
+	ref := config.ValueFrom.SecretKeyRef
+	if ref == nil || ref.Name == "" || ref.Key == "" {
+		log.Info("rejecting change: ValueFrom does not reference a key of a secret", "field", key)
+		return nil, fmt.Errorf("field %s requires ValueFrom to reference the name and key of a secret", key)
+	}
+
+	if ref.Optional != nil && *ref.Optional {
+		log.Info("rejecting change: ValueFrom references an optional secret key", "field", key)
+		return nil, fmt.Errorf("field %s references an optional secret key, which is not supported", key)
+	}
+
+	return nil, nil
+}
+
+func (r *VaultKVSecret) validateGenerator(log logr.Logger, config *VaultKVSecretField, key string) (warnings admission.Warnings, err error) {
+	generator := config.GetGenerator()
+	if generator == FieldGeneratorString {
+		if config.KeyBits != 0 || config.PublicKeyField != "" || config.Source != "" || config.Username != "" {
+			log.Info("rejecting change: generator parameters are set which are not supported by the string generator", "field", key)
+			return nil, fmt.Errorf("field %s has the KeyBits, PublicKeyField, Source or Username parameter set which are not supported by the string generator", key)
+		}
+
+		return r.validatePasswordGeneration(log, config, key)
+	}
+
+	if config.AutoGeneratedLength != 0 || config.PasswordPolicy != "" || len(config.CharsetRules) > 0 {
+		log.Info("rejecting change: password generation parameters are set which are not supported by the generator", "field", key, "generator", generator)
+		return nil, fmt.Errorf("field %s has the AutoGeneratedLength, PasswordPolicy or CharsetRules parameter set which are not supported by the %s generator", key, generator)
+	}
+
+	if err := validateKeyBits(generator, config.KeyBits); err != nil {
+		log.Info("rejecting change: KeyBits parameter is invalid", "field", key, "error", err)
+		return nil, fmt.Errorf("field %s has an invalid KeyBits parameter: %w", key, err)
+	}
+
+	if config.IsKeyPair() {
+		publicKeyField := config.GetPublicKeyField(key)
+		if _, ok := r.Spec.Fields[publicKeyField]; ok {
+			log.Info("rejecting change: public key field collides with another field", "field", key, "publicKeyField", publicKeyField)
+			return nil, fmt.Errorf("field %s stores its public key in field %s which is already configured", key, publicKeyField)
+		}
+
+		for name, other := range r.Spec.Fields {
+			if name != key && other.AutoGenerated && other.IsKeyPair() && other.GetPublicKeyField(name) == publicKeyField {
+				log.Info("rejecting change: public key field is used by multiple fields", "field", key, "publicKeyField", publicKeyField)
+				return nil, fmt.Errorf("fields %s and %s both store their public key in field %s", key, name, publicKeyField)
+			}
+		}
+	} else if config.PublicKeyField != "" {
+		log.Info("rejecting change: PublicKeyField parameter is set but the generator does not generate a key pair", "field", key)
+		return nil, fmt.Errorf("field %s has the PublicKeyField parameter set even though the %s generator does not generate a key pair", key, generator)
+	}
+
+	if !config.IsDerived() {
+		if config.Source != "" || config.Username != "" {
+			log.Info("rejecting change: Source or Username parameter is set but the generator does not derive values", "field", key)
+			return nil, fmt.Errorf("field %s has the Source or Username parameter set even though the %s generator does not derive its value from another field", key, generator)
+		}
+
 		return nil, nil
 	}
 
-	log.Info("rejecting change: Neither the AutoGenerated parameter nor the CipherText field is set", "field", key)
-	return nil, fmt.Errorf("field %s has neither the AutoGenerated flag or a CipherText set - you have to specify at least one", key)
+	return r.validateDerivedField(log, config, key)
+}
+
+func (r *VaultKVSecret) validateDerivedField(log logr.Logger, config *VaultKVSecretField, key string) (warnings admission.Warnings, err error) {
+	if config.RotationPeriod.Duration != 0 {
+		log.Info("rejecting change: RotationPeriod parameter is set on a derived field", "field", key)
+		return nil, fmt.Errorf("field %s has the RotationPeriod parameter set even though its value is derived from field %s", key, config.Source)
+	}
+
+	source, ok := r.Spec.Fields[config.Source]
+	switch {
+	case config.Source == "":
+		log.Info("rejecting change: Source parameter is not set", "field", key)
+		return nil, fmt.Errorf("field %s requires the Source parameter to be set", key)
+	case config.Source == key:
+		log.Info("rejecting change: field is derived from itself", "field", key)
+		return nil, fmt.Errorf("field %s must not be derived from itself", key)
+	case !ok:
+		log.Info("rejecting change: source field does not exist", "field", key, "source", config.Source)
+		return nil, fmt.Errorf("field %s is derived from field %s which does not exist", key, config.Source)
+	case source.AutoGenerated && source.IsDerived():
+		log.Info("rejecting change: source field is derived itself", "field", key, "source", config.Source)
+		return nil, fmt.Errorf("field %s is derived from field %s which is derived from another field itself", key, config.Source)
+	case source.AutoGenerated && source.IsKeyPair():
+		log.Info("rejecting change: source field contains a key pair", "field", key, "source", config.Source)
+		return nil, fmt.Errorf("field %s is derived from field %s which contains a key longer than the %d bytes supported by bcrypt", key, config.Source, MaxHashSourceLength)
+	case source.AutoGenerated && source.GetGenerator() == FieldGeneratorString && source.AutoGeneratedLength > MaxHashSourceLength:
+		log.Info("rejecting change: source field is too long to be hashed", "field", key, "source", config.Source)
+		return nil, fmt.Errorf("field %s is derived from field %s which is longer than the %d bytes supported by bcrypt", key, config.Source, MaxHashSourceLength)
+	}
+
+	if config.GetGenerator() != FieldGeneratorHtpasswd {
+		if config.Username != "" {
+			log.Info("rejecting change: Username parameter is set but the generator is not htpasswd", "field", key)
+			return nil, fmt.Errorf("field %s has the Username parameter set even though it is only supported by the htpasswd generator", key)
+		}
+
+		return nil, nil
+	}
+
+	if config.Username == "" || strings.Contains(config.Username, ":") {
+		log.Info("rejecting change: Username parameter is missing or contains a colon", "field", key)
+		return nil, fmt.Errorf("field %s requires a Username parameter which does not contain a colon", key)
+	}
+
+	return nil, nil
+}
+
+func validateKeyBits(generator FieldGenerator, keyBits int) error {
+	if keyBits == 0 {
+		return nil
+	}
+
+	switch generator {
+	case FieldGeneratorRSA:
+		if keyBits != 2048 && keyBits != 3072 && keyBits != 4096 {
+			return fmt.Errorf("RSA keys must have 2048, 3072 or 4096 bits, got %d", keyBits)
+		}
+	case FieldGeneratorECDSA:
+		if keyBits != 256 && keyBits != 384 && keyBits != 521 {
+			return fmt.Errorf("ECDSA keys must use a 256, 384 or 521 bit curve, got %d", keyBits)
+		}
+	default:
+		return fmt.Errorf("the %s generator does not support configuring the key size", generator)
+	}
+
+	return nil
+}
+
+func (r *VaultKVSecret) validatePasswordGeneration(log logr.Logger, config *VaultKVSecretField, key string) (warnings admission.Warnings, err error) {
+	switch {
+	case config.PasswordPolicy != "" && len(config.CharsetRules) > 0:
+		log.Info("rejecting change: field has both a password policy and charset rules set", "field", key)
+		return nil, fmt.Errorf("field %s has both the PasswordPolicy and CharsetRules parameter set. Pick either a password policy or charset rules", key)
+	case config.PasswordPolicy != "":
+		if config.AutoGeneratedLength != 0 {
+			log.Info("rejecting change: AutoGeneratedLength parameter is set but the length is configured by the password policy", "field", key)
+			return nil, fmt.Errorf("field %s has the AutoGeneratedLength parameter set even though the length is configured by the password policy", key)
+		}
+	case len(config.CharsetRules) > 0:
+		length := config.AutoGeneratedLength
+		if length == 0 {
+			length = DefaultAutoGeneratedLength
+		}
+
+		if err := validatePasswordRules(length, config.CharsetRules); err != nil {
+			log.Info("rejecting change: charset rules of field are invalid", "field", key, "error", err)
+			return nil, fmt.Errorf("field %s has invalid charset rules: %w", key, err)
+		}
+	}
+
+	return nil, nil
 }
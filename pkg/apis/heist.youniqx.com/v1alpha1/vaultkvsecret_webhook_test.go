@@ -17,8 +17,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -305,6 +308,288 @@ var _ = Describe("VaultKVSecret Webhooks", func() {
 				Expect(K8sClient.Create(ctx, secret)).NotTo(Succeed())
 			})
 		})
+
+		When("Creating a VaultKVSecret with a rotation period on a static field", func() {
+			var secret *VaultKVSecret
+
+			BeforeEach(func() {
+				secret = &VaultKVSecret{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "VaultKVSecret",
+						APIVersion: "heist.youniqx.com/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-secret",
+						Namespace: "default",
+					},
+					Spec: VaultKVSecretSpec{
+						Engine: "some-engine",
+						Path:   "",
+						Fields: map[string]*VaultKVSecretField{
+							"some-field": {
+								CipherText:     EncryptedValue(defaultCipherText),
+								RotationPeriod: metav1.Duration{Duration: time.Hour},
+							},
+						},
+						DeleteProtection: false,
+					},
+					Status: VaultKVSecretStatus{},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(K8sClient.Delete(ctx, secret)).NotTo(Succeed())
+			})
+
+			It("Should throw an error", func() {
+				Expect(K8sClient.Create(ctx, secret)).NotTo(Succeed())
+			})
+		})
+
+		When("Creating a VaultKVSecret with both a password policy and charset rules", func() {
+			var secret *VaultKVSecret
+
+			BeforeEach(func() {
+				secret = &VaultKVSecret{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "VaultKVSecret",
+						APIVersion: "heist.youniqx.com/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-secret",
+						Namespace: "default",
+					},
+					Spec: VaultKVSecretSpec{
+						Engine: "some-engine",
+						Path:   "",
+						Fields: map[string]*VaultKVSecretField{
+							"some-field": {
+								AutoGenerated:  true,
+								PasswordPolicy: "some-policy",
+								CharsetRules: []VaultPasswordPolicyRule{
+									{Charset: "0123456789"},
+								},
+							},
+						},
+						DeleteProtection: false,
+					},
+					Status: VaultKVSecretStatus{},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(K8sClient.Delete(ctx, secret)).NotTo(Succeed())
+			})
+
+			It("Should throw an error", func() {
+				Expect(K8sClient.Create(ctx, secret)).NotTo(Succeed())
+			})
+		})
+
+		When("Creating a VaultKVSecret with a hash field without an existing source field", func() {
+			var secret *VaultKVSecret
+
+			BeforeEach(func() {
+				secret = &VaultKVSecret{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "VaultKVSecret",
+						APIVersion: "heist.youniqx.com/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-secret",
+						Namespace: "default",
+					},
+					Spec: VaultKVSecretSpec{
+						Engine: "some-engine",
+						Path:   "",
+						Fields: map[string]*VaultKVSecretField{
+							"some-field": {
+								AutoGenerated: true,
+								Generator:     FieldGeneratorBcrypt,
+								Source:        "some-other-field",
+							},
+						},
+						DeleteProtection: false,
+					},
+					Status: VaultKVSecretStatus{},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(K8sClient.Delete(ctx, secret)).NotTo(Succeed())
+			})
+
+			It("Should throw an error", func() {
+				Expect(K8sClient.Create(ctx, secret)).NotTo(Succeed())
+			})
+		})
+
+		When("Creating a VaultKVSecret with a hash field derived from a key pair field", func() {
+			var secret *VaultKVSecret
+
+			BeforeEach(func() {
+				secret = &VaultKVSecret{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "VaultKVSecret",
+						APIVersion: "heist.youniqx.com/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-secret",
+						Namespace: "default",
+					},
+					Spec: VaultKVSecretSpec{
+						Engine: "some-engine",
+						Path:   "",
+						Fields: map[string]*VaultKVSecretField{
+							"some-field": {
+								AutoGenerated: true,
+								Generator:     FieldGeneratorHtpasswd,
+								Source:        "some-key",
+								Username:      "admin",
+							},
+							"some-key": {
+								AutoGenerated: true,
+								Generator:     FieldGeneratorEd25519,
+							},
+						},
+						DeleteProtection: false,
+					},
+					Status: VaultKVSecretStatus{},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(K8sClient.Delete(ctx, secret)).NotTo(Succeed())
+			})
+
+			It("Should throw an error", func() {
+				Expect(K8sClient.Create(ctx, secret)).NotTo(Succeed())
+			})
+		})
+
+		When("Creating a VaultKVSecret with a hash field derived from a field longer than bcrypt supports", func() {
+			var secret *VaultKVSecret
+
+			BeforeEach(func() {
+				secret = &VaultKVSecret{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "VaultKVSecret",
+						APIVersion: "heist.youniqx.com/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-secret",
+						Namespace: "default",
+					},
+					Spec: VaultKVSecretSpec{
+						Engine: "some-engine",
+						Path:   "",
+						Fields: map[string]*VaultKVSecretField{
+							"some-field": {
+								AutoGenerated: true,
+								Generator:     FieldGeneratorBcrypt,
+								Source:        "some-password",
+							},
+							"some-password": {
+								AutoGenerated:       true,
+								AutoGeneratedLength: MaxHashSourceLength + 1,
+							},
+						},
+						DeleteProtection: false,
+					},
+					Status: VaultKVSecretStatus{},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(K8sClient.Delete(ctx, secret)).NotTo(Succeed())
+			})
+
+			It("Should throw an error", func() {
+				Expect(K8sClient.Create(ctx, secret)).NotTo(Succeed())
+			})
+		})
+
+		When("Creating a VaultKVSecret with a public key field colliding with another field", func() {
+			var secret *VaultKVSecret
+
+			BeforeEach(func() {
+				secret = &VaultKVSecret{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "VaultKVSecret",
+						APIVersion: "heist.youniqx.com/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-secret",
+						Namespace: "default",
+					},
+					Spec: VaultKVSecretSpec{
+						Engine: "some-engine",
+						Path:   "",
+						Fields: map[string]*VaultKVSecretField{
+							"some-field": {
+								AutoGenerated: true,
+								Generator:     FieldGeneratorSSH,
+							},
+							"some-field.pub": {
+								AutoGenerated: true,
+							},
+						},
+						DeleteProtection: false,
+					},
+					Status: VaultKVSecretStatus{},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(K8sClient.Delete(ctx, secret)).NotTo(Succeed())
+			})
+
+			It("Should throw an error", func() {
+				Expect(K8sClient.Create(ctx, secret)).NotTo(Succeed())
+			})
+		})
+
+		When("Creating a VaultKVSecret with a field read from a secret and the AutoGenerated flag", func() {
+			var secret *VaultKVSecret
+
+			BeforeEach(func() {
+				secret = &VaultKVSecret{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "VaultKVSecret",
+						APIVersion: "heist.youniqx.com/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "some-secret",
+						Namespace: "default",
+					},
+					Spec: VaultKVSecretSpec{
+						Engine: "some-engine",
+						Path:   "",
+						Fields: map[string]*VaultKVSecretField{
+							"some-field": {
+								AutoGenerated: true,
+								ValueFrom: &VaultKVSecretFieldSource{
+									SecretKeyRef: &corev1.SecretKeySelector{
+										LocalObjectReference: corev1.LocalObjectReference{Name: "some-k8s-secret"},
+										Key:                  "some-key",
+									},
+								},
+							},
+						},
+						DeleteProtection: false,
+					},
+					Status: VaultKVSecretStatus{},
+				}
+			})
+
+			AfterEach(func() {
+				Expect(K8sClient.Delete(ctx, secret)).NotTo(Succeed())
+			})
+
+			It("Should throw an error", func() {
+				Expect(K8sClient.Create(ctx, secret)).NotTo(Succeed())
+			})
+		})
 	})
 
 	Context("Deleting VaultKVSecrets", func() {
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/youniqx/heist/pkg/vault/kvengine"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,7 +29,16 @@ type VaultKVSecretEngineSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	// MaxVersions configures the maximum number of secret versions to keep
+	// Version configures which version of the KV secret engine should be used.
+	// Can be either v1 or v2 and cannot be changed after the engine has been
+	// created. Defaults to v2.
+	// +optional
+	// +kubebuilder:validation:Enum:=v1;v2
+	// +kubebuilder:default:=v2
+	Version kvengine.Version `json:"version,omitempty"`
+
+	// MaxVersions configures the maximum number of secret versions to keep.
+	// Only applies to v2 engines.
 	MaxVersions int `json:"maxVersions"`
 
 	// DeleteProtection configures that the secret engine should not be able to be deleted.
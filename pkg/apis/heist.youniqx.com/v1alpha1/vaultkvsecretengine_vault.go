@@ -10,6 +10,14 @@ func (r *VaultKVSecretEngine) GetMountPath() (string, error) {
 	return fmt.Sprintf("managed/kv/%s/%s", r.Namespace, r.Name), nil
 }
 
+func (r *VaultKVSecretEngine) GetKvEngineVersion() (kvengine.Version, error) {
+	if r.Spec.Version == "" {
+		return kvengine.VersionV2, nil
+	}
+
+	return r.Spec.Version, nil
+}
+
 func (r *VaultKVSecretEngine) GetKvEngineConfig() (*kvengine.Config, error) {
 	var maxVersions int
 	if r.Spec.MaxVersions != 0 {
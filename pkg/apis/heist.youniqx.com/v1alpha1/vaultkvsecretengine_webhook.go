@@ -62,6 +62,21 @@ func (r *VaultKVSecretEngine) ValidateUpdate(old runtime.Object) (warnings admis
 		"namespace", r.Namespace,
 	)
 	log.Info("update validation started")
+
+	oldEngine, ok := old.(*VaultKVSecretEngine)
+	if !ok {
+		log.Info("rejecting change: old object is not a VaultKVSecretEngine")
+		return nil, errors.New("old object is not a VaultKVSecretEngine")
+	}
+
+	oldVersion, _ := oldEngine.GetKvEngineVersion()
+	newVersion, _ := r.GetKvEngineVersion()
+
+	if oldVersion != newVersion {
+		log.Info("rejecting change: engine version cannot be changed.", "oldVersion", oldVersion, "newVersion", newVersion)
+		return nil, errors.New("the version of a VaultKVSecretEngine cannot be changed")
+	}
+
 	return r.validate(log)
 }
 
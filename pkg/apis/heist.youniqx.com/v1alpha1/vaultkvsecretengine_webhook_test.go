@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -126,5 +127,46 @@ var _ = Describe("VaultKVSecretEngine Webhooks", func() {
 				return K8sClient.Delete(ctx, engine)
 			}).Should(Succeed())
 		})
+		By("Allowing v1 engines to be created", func() {
+			engine := &VaultKVSecretEngine{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "VaultKVSecretEngine",
+					APIVersion: "heist.youniqx.com/v1alpha1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "v1-engine",
+					Namespace: "default",
+				},
+				Spec: VaultKVSecretEngineSpec{
+					Version: kvengine.VersionV1,
+				},
+				Status: VaultKVSecretEngineStatus{},
+			}
+			Expect(K8sClient.Create(ctx, engine)).To(Succeed())
+		})
+		By("Preventing the engine version from being changed", func() {
+			engine := &VaultKVSecretEngine{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "VaultKVSecretEngine",
+					APIVersion: "heist.youniqx.com/v1alpha1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "v2-engine",
+					Namespace: "default",
+				},
+				Spec: VaultKVSecretEngineSpec{
+					Version: kvengine.VersionV2,
+				},
+				Status: VaultKVSecretEngineStatus{},
+			}
+			Expect(K8sClient.Create(ctx, engine)).To(Succeed())
+			Eventually(func() error {
+				result := &VaultKVSecretEngine{}
+				return K8sClient.Get(ctx, client.ObjectKeyFromObject(engine), result)
+			}).ShouldNot(HaveOccurred())
+
+			engine.Spec.Version = kvengine.VersionV1
+			Expect(K8sClient.Update(ctx, engine)).NotTo(Succeed())
+		})
 	})
 })
@@ -0,0 +1,92 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VaultPasswordPolicyRule configures a set of characters generated values
+// are picked from.
+type VaultPasswordPolicyRule struct {
+	// Charset is the set of characters values are generated from.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	Charset string `json:"charset"`
+
+	// MinChars configures how many characters of the charset a generated
+	// value has to contain at least. Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	MinChars int `json:"minChars,omitempty"`
+}
+
+// VaultPasswordPolicySpec defines the desired state of VaultPasswordPolicy.
+type VaultPasswordPolicySpec struct {
+	// Length configures the length of generated values.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=4
+	// +kubebuilder:validation:Maximum:=100
+	Length int `json:"length"`
+
+	// Rules configures the charsets generated values are picked from.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	Rules []VaultPasswordPolicyRule `json:"rules"`
+}
+
+// VaultPasswordPolicyStatus defines the observed state of VaultPasswordPolicy.
+type VaultPasswordPolicyStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// PolicyName is the name of the password policy in Vault.
+	// +optional
+	PolicyName string `json:"policyName,omitempty"`
+}
+
+// +kubebuilder:resource:shortName=vpp,categories=heist;youniqx
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Provisioned",type="string",JSONPath=".status.conditions[?(@.type=='Provisioned')].status",description="The status of this VaultPasswordPolicy"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Creation Timestamp of the VaultPasswordPolicy"
+// +genclient
+
+// VaultPasswordPolicy is the Schema for the vaultpasswordpolicies API.
+type VaultPasswordPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VaultPasswordPolicySpec   `json:"spec,omitempty"`
+	Status VaultPasswordPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultPasswordPolicyList contains a list of VaultPasswordPolicy.
+type VaultPasswordPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultPasswordPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VaultPasswordPolicy{}, &VaultPasswordPolicyList{})
+}
@@ -0,0 +1,41 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/vault/passwordpolicy"
+)
+
+var _ passwordpolicy.Entity = &VaultPasswordPolicy{}
+
+func (r *VaultPasswordPolicy) GetPolicyName() (string, error) {
+	return fmt.Sprintf("managed.password.%s.%s", r.Namespace, r.Name), nil
+}
+
+func (r *VaultPasswordPolicy) GetPasswordPolicy() (*passwordpolicy.PasswordPolicy, error) {
+	name, err := r.GetPolicyName()
+	if err != nil {
+		return nil, err
+	}
+
+	return ToPasswordPolicy(name, r.Spec.Length, r.Spec.Rules), nil
+}
+
+// ToPasswordPolicy converts charset rules to a Vault password policy.
+func ToPasswordPolicy(name string, length int, rules []VaultPasswordPolicyRule) *passwordpolicy.PasswordPolicy {
+	result := &passwordpolicy.PasswordPolicy{
+		Name:   name,
+		Length: length,
+		Rules:  make([]*passwordpolicy.CharsetRule, 0, len(rules)),
+	}
+
+	for _, rule := range rules {
+		result.Rules = append(result.Rules, &passwordpolicy.CharsetRule{
+			Type:     passwordpolicy.CharsetRuleType,
+			Charset:  rule.Charset,
+			MinChars: rule.MinChars,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// MinPasswordLength is the minimum length of values generated by Vault's password generator.
+	MinPasswordLength = 4
+	// MaxPasswordLength is the maximum length of values generated by Vault's password generator.
+	MaxPasswordLength = 100
+)
+
+// log is for logging in this package.
+var vaultpasswordpolicylog = logf.Log.WithName("vaultpasswordpolicy-resource")
+
+func (r *VaultPasswordPolicy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-heist-youniqx-com-v1alpha1-vaultpasswordpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=heist.youniqx.com,resources=vaultpasswordpolicies,verbs=create;update;delete,versions=v1alpha1,name=vvaultpasswordpolicy.heist.youniqx.com,admissionReviewVersions={v1,v1beta1}
+
+var _ webhook.Validator = &VaultPasswordPolicy{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *VaultPasswordPolicy) ValidateCreate() (warnings admission.Warnings, err error) {
+	log := vaultpasswordpolicylog.WithName("validate").WithValues(
+		"action", "create",
+		"name", r.Name,
+		"namespace", r.Namespace,
+	)
+	log.Info("create validation started")
+	return r.validate(log)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *VaultPasswordPolicy) ValidateUpdate(old runtime.Object) (warnings admission.Warnings, err error) {
+	log := vaultpasswordpolicylog.WithName("validate").WithValues(
+		"action", "update",
+		"name", r.Name,
+		"namespace", r.Namespace,
+	)
+	log.Info("update validation started")
+	return r.validate(log)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *VaultPasswordPolicy) ValidateDelete() (warnings admission.Warnings, err error) {
+	log := vaultpasswordpolicylog.WithName("validate").WithValues(
+		"action", "delete",
+		"name", r.Name,
+		"namespace", r.Namespace,
+	)
+	log.Info("delete validation started")
+
+	return nil, nil
+}
+
+func (r *VaultPasswordPolicy) validate(log logr.Logger) (warnings admission.Warnings, err error) {
+	if err := validatePasswordRules(r.Spec.Length, r.Spec.Rules); err != nil {
+		log.Info("rejecting change: password policy is invalid", "error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// validatePasswordRules checks that Vault is able to generate values of the
+// given length from the charset rules.
+func validatePasswordRules(length int, rules []VaultPasswordPolicyRule) error {
+	if length < MinPasswordLength || length > MaxPasswordLength {
+		return fmt.Errorf("the length of generated passwords must be between %d and %d", MinPasswordLength, MaxPasswordLength)
+	}
+
+	if len(rules) == 0 {
+		return fmt.Errorf("at least one charset rule must be configured")
+	}
+
+	minChars := 0
+	for index, rule := range rules {
+		if rule.Charset == "" {
+			return fmt.Errorf("charset rule %d has an empty charset", index)
+		}
+
+		if !utf8.ValidString(rule.Charset) {
+			return fmt.Errorf("charset rule %d contains invalid characters", index)
+		}
+
+		if rule.MinChars < 0 {
+			return fmt.Errorf("charset rule %d has a negative value for min chars", index)
+		}
+
+		minChars += rule.MinChars
+	}
+
+	if minChars > length {
+		return fmt.Errorf("the charset rules require %d characters but the length of generated passwords is %d", minChars, length)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VaultPasswordPolicy Webhooks", func() {
+	It("Should validate VaultPasswordPolicy fields", func() {
+		By("Allowing valid crds", func() {
+			policy := &VaultPasswordPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "VaultPasswordPolicy",
+					APIVersion: "heist.youniqx.com/v1alpha1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "some-policy",
+					Namespace: "default",
+				},
+				Spec: VaultPasswordPolicySpec{
+					Length: 20,
+					Rules: []VaultPasswordPolicyRule{
+						{Charset: "abcdefghijklmnopqrstuvwxyz"},
+						{Charset: "0123456789", MinChars: 1},
+					},
+				},
+			}
+			Expect(K8sClient.Create(ctx, policy)).To(Succeed())
+		})
+
+		By("Rejecting rules which require more characters than the length", func() {
+			policy := &VaultPasswordPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "VaultPasswordPolicy",
+					APIVersion: "heist.youniqx.com/v1alpha1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "some-invalid-policy",
+					Namespace: "default",
+				},
+				Spec: VaultPasswordPolicySpec{
+					Length: 4,
+					Rules: []VaultPasswordPolicyRule{
+						{Charset: "0123456789", MinChars: 5},
+					},
+				},
+			}
+			Expect(K8sClient.Create(ctx, policy)).NotTo(Succeed())
+		})
+	})
+})
@@ -68,6 +68,14 @@ type VaultTransitKeySpec struct {
 	// +optional
 	// +kubebuilder:validation:Optional
 	DeleteProtection bool `json:"deleteProtection,omitempty"`
+
+	// DriftPolicy configures how changes made to the key configuration in
+	// Vault outside of Heist are handled. Enforce overwrites them, Report sets
+	// the Drifted condition and leaves the key untouched, Ignore disables the
+	// comparison. Defaults to Enforce.
+	// +optional
+	// +kubebuilder:validation:Optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // VaultTransitKeyStatus defines the observed state of VaultTransitKey.
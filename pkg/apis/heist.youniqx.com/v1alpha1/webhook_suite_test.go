@@ -98,7 +98,7 @@ var _ = BeforeSuite(func() {
 	Expect(RootAPI).NotTo(BeNil())
 
 	By("setup Vault for Operator")
-	err = operator.SetupOperator(&operator.SetupConfig{
+	err = operator.SetupOperator(context.TODO(), &operator.SetupConfig{
 		VaultURL:             VaultEnv.GetAddress(),
 		VaultToken:           "root",
 		PolicyName:           "heist",
@@ -150,11 +150,11 @@ var _ = BeforeSuite(func() {
 			},
 		}).
 		Register(Component()).
-		Complete()
+		Complete(context.TODO())
 	Expect(err).ToNot(HaveOccurred())
 	Expect(mgr).ToNot(BeNil())
 
-	defaultCipherText, err = RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
+	defaultCipherText, err = RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
 	Expect(err).NotTo(HaveOccurred())
 	Expect(defaultCipherText).NotTo(BeEmpty())
 
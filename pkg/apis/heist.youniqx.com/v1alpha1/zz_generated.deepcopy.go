@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/youniqx/heist/pkg/vault/pki"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -122,7 +123,9 @@ func (in *VaultBindingAgentConfig) DeepCopyInto(out *VaultBindingAgentConfig) {
 	if in.Templates != nil {
 		in, out := &in.Templates, &out.Templates
 		*out = make([]VaultBindingValueTemplate, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -176,6 +179,41 @@ func (in *VaultBindingCertificateAuthority) DeepCopy() *VaultBindingCertificateA
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultBindingExecReload) DeepCopyInto(out *VaultBindingExecReload) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultBindingExecReload.
+func (in *VaultBindingExecReload) DeepCopy() *VaultBindingExecReload {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultBindingExecReload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultBindingHTTPReload) DeepCopyInto(out *VaultBindingHTTPReload) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultBindingHTTPReload.
+func (in *VaultBindingHTTPReload) DeepCopy() *VaultBindingHTTPReload {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultBindingHTTPReload)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultBindingKV) DeepCopyInto(out *VaultBindingKV) {
 	*out = *in
@@ -228,6 +266,51 @@ func (in *VaultBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultBindingReloadAction) DeepCopyInto(out *VaultBindingReloadAction) {
+	*out = *in
+	if in.Signal != nil {
+		in, out := &in.Signal, &out.Signal
+		*out = new(VaultBindingSignalReload)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(VaultBindingHTTPReload)
+		**out = **in
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(VaultBindingExecReload)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultBindingReloadAction.
+func (in *VaultBindingReloadAction) DeepCopy() *VaultBindingReloadAction {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultBindingReloadAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultBindingSignalReload) DeepCopyInto(out *VaultBindingSignalReload) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultBindingSignalReload.
+func (in *VaultBindingSignalReload) DeepCopy() *VaultBindingSignalReload {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultBindingSignalReload)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultBindingSpec) DeepCopyInto(out *VaultBindingSpec) {
 	*out = *in
@@ -339,6 +422,21 @@ func (in *VaultBindingTransitKey) DeepCopy() *VaultBindingTransitKey {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultBindingValueTemplate) DeepCopyInto(out *VaultBindingValueTemplate) {
 	*out = *in
+	if in.OwnerUID != nil {
+		in, out := &in.OwnerUID, &out.OwnerUID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OwnerGID != nil {
+		in, out := &in.OwnerGID, &out.OwnerGID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Reload != nil {
+		in, out := &in.Reload, &out.Reload
+		*out = new(VaultBindingReloadAction)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultBindingValueTemplate.
@@ -933,6 +1031,11 @@ func (in *VaultClientConfigList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultClientConfigSpec) DeepCopyInto(out *VaultClientConfigSpec) {
 	*out = *in
+	if in.FailoverAddresses != nil {
+		in, out := &in.FailoverAddresses, &out.FailoverAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.CACerts != nil {
 		in, out := &in.CACerts, &out.CACerts
 		*out = make([]string, len(*in))
@@ -1143,6 +1246,17 @@ func (in *VaultKVSecretEngineStatus) DeepCopy() *VaultKVSecretEngineStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultKVSecretField) DeepCopyInto(out *VaultKVSecretField) {
 	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(VaultKVSecretFieldSource)
+		(*in).DeepCopyInto(*out)
+	}
+	out.RotationPeriod = in.RotationPeriod
+	if in.CharsetRules != nil {
+		in, out := &in.CharsetRules, &out.CharsetRules
+		*out = make([]VaultPasswordPolicyRule, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultKVSecretField.
@@ -1155,6 +1269,26 @@ func (in *VaultKVSecretField) DeepCopy() *VaultKVSecretField {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultKVSecretFieldSource) DeepCopyInto(out *VaultKVSecretFieldSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultKVSecretFieldSource.
+func (in *VaultKVSecretFieldSource) DeepCopy() *VaultKVSecretFieldSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultKVSecretFieldSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultKVSecretList) DeepCopyInto(out *VaultKVSecretList) {
 	*out = *in
@@ -1220,7 +1354,7 @@ func (in *VaultKVSecretSpec) DeepCopyInto(out *VaultKVSecretSpec) {
 			} else {
 				in, out := &val, &outVal
 				*out = new(VaultKVSecretField)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 			(*out)[key] = outVal
 		}
@@ -1254,6 +1388,18 @@ func (in *VaultKVSecretStatus) DeepCopyInto(out *VaultKVSecretStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.PreviousVersions != nil {
+		in, out := &in.PreviousVersions, &out.PreviousVersions
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastRotated != nil {
+		in, out := &in.LastRotated, &out.LastRotated
+		*out = make(map[string]v1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultKVSecretStatus.
@@ -1386,6 +1532,122 @@ func (in *VaultSyncKvSecret) DeepCopy() *VaultSyncKvSecret {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPasswordPolicy) DeepCopyInto(out *VaultPasswordPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPasswordPolicy.
+func (in *VaultPasswordPolicy) DeepCopy() *VaultPasswordPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPasswordPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultPasswordPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPasswordPolicyList) DeepCopyInto(out *VaultPasswordPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VaultPasswordPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPasswordPolicyList.
+func (in *VaultPasswordPolicyList) DeepCopy() *VaultPasswordPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPasswordPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultPasswordPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPasswordPolicyRule) DeepCopyInto(out *VaultPasswordPolicyRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPasswordPolicyRule.
+func (in *VaultPasswordPolicyRule) DeepCopy() *VaultPasswordPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPasswordPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPasswordPolicySpec) DeepCopyInto(out *VaultPasswordPolicySpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]VaultPasswordPolicyRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPasswordPolicySpec.
+func (in *VaultPasswordPolicySpec) DeepCopy() *VaultPasswordPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPasswordPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPasswordPolicyStatus) DeepCopyInto(out *VaultPasswordPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPasswordPolicyStatus.
+func (in *VaultPasswordPolicyStatus) DeepCopy() *VaultPasswordPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPasswordPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultSyncSecret) DeepCopyInto(out *VaultSyncSecret) {
 	*out = *in
@@ -52,6 +52,10 @@ func (c *FakeHeistV1alpha1) VaultKVSecretEngines(namespace string) v1alpha1.Vaul
 	return &FakeVaultKVSecretEngines{c, namespace}
 }
 
+func (c *FakeHeistV1alpha1) VaultPasswordPolicies(namespace string) v1alpha1.VaultPasswordPolicyInterface {
+	return &FakeVaultPasswordPolicies{c, namespace}
+}
+
 func (c *FakeHeistV1alpha1) VaultSyncSecrets(namespace string) v1alpha1.VaultSyncSecretInterface {
 	return &FakeVaultSyncSecrets{c, namespace}
 }
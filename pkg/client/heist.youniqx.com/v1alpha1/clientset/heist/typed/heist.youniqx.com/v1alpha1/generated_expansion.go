@@ -30,6 +30,8 @@ type VaultKVSecretExpansion interface{}
 
 type VaultKVSecretEngineExpansion interface{}
 
+type VaultPasswordPolicyExpansion interface{}
+
 type VaultSyncSecretExpansion interface{}
 
 type VaultTransitEngineExpansion interface{}
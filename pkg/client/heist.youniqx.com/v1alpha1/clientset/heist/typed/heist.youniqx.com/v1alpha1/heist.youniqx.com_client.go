@@ -34,6 +34,7 @@ type HeistV1alpha1Interface interface {
 	VaultClientConfigsGetter
 	VaultKVSecretsGetter
 	VaultKVSecretEnginesGetter
+	VaultPasswordPoliciesGetter
 	VaultSyncSecretsGetter
 	VaultTransitEnginesGetter
 	VaultTransitKeysGetter
@@ -68,6 +69,10 @@ func (c *HeistV1alpha1Client) VaultKVSecretEngines(namespace string) VaultKVSecr
 	return newVaultKVSecretEngines(c, namespace)
 }
 
+func (c *HeistV1alpha1Client) VaultPasswordPolicies(namespace string) VaultPasswordPolicyInterface {
+	return newVaultPasswordPolicies(c, namespace)
+}
+
 func (c *HeistV1alpha1Client) VaultSyncSecrets(namespace string) VaultSyncSecretInterface {
 	return newVaultSyncSecrets(c, namespace)
 }
@@ -66,6 +66,14 @@ type VaultKVSecretEngineListerExpansion interface{}
 // VaultKVSecretEngineNamespaceLister.
 type VaultKVSecretEngineNamespaceListerExpansion interface{}
 
+// VaultPasswordPolicyListerExpansion allows custom methods to be added to
+// VaultPasswordPolicyLister.
+type VaultPasswordPolicyListerExpansion interface{}
+
+// VaultPasswordPolicyNamespaceListerExpansion allows custom methods to be added to
+// VaultPasswordPolicyNamespaceLister.
+type VaultPasswordPolicyNamespaceListerExpansion interface{}
+
 // VaultSyncSecretListerExpansion allows custom methods to be added to
 // VaultSyncSecretLister.
 type VaultSyncSecretListerExpansion interface{}
@@ -0,0 +1,29 @@
+package common
+
+import (
+	"errors"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+// VaultErrorReason returns the condition reason describing why a request to
+// Vault failed. Errors which can't be classified are reported as ErrorVault.
+func VaultErrorReason(err error) string {
+	switch {
+	case errors.Is(err, core.ErrPermissionDenied):
+		return heistv1alpha1.Conditions.Reasons.ErrorVaultPermissionDenied
+	case errors.Is(err, core.ErrDoesNotExist), errors.Is(err, core.ErrNotFound):
+		return heistv1alpha1.Conditions.Reasons.ErrorVaultNotFound
+	case errors.Is(err, core.ErrSealed):
+		return heistv1alpha1.Conditions.Reasons.ErrorVaultSealed
+	case errors.Is(err, core.ErrRateLimited):
+		return heistv1alpha1.Conditions.Reasons.ErrorVaultRateLimited
+	case errors.Is(err, core.ErrCASConflict):
+		return heistv1alpha1.Conditions.Reasons.Conflict
+	case errors.Is(err, core.ErrInvalidRequest):
+		return heistv1alpha1.Conditions.Reasons.ErrorVaultInvalidRequest
+	default:
+		return heistv1alpha1.Conditions.Reasons.ErrorVault
+	}
+}
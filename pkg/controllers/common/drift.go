@@ -0,0 +1,167 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// DefaultDriftCheckInterval is the interval in which objects are compared
+// with their state in Vault if no other interval has been configured.
+const DefaultDriftCheckInterval = 5 * time.Minute
+
+// GetDriftCheckInterval returns interval, or DefaultDriftCheckInterval if
+// interval is not set.
+func GetDriftCheckInterval(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return DefaultDriftCheckInterval
+	}
+
+	return interval
+}
+
+// RequeueForDriftCheck returns result changed to requeue the object once the
+// next comparison with Vault is due, unless it is requeued earlier anyway.
+func RequeueForDriftCheck(result ctrl.Result, policy heistv1alpha1.DriftPolicy, interval time.Duration) ctrl.Result {
+	if policy == heistv1alpha1.DriftPolicyIgnore {
+		return result
+	}
+
+	interval = GetDriftCheckInterval(interval)
+	if result.RequeueAfter <= 0 || interval < result.RequeueAfter {
+		result.RequeueAfter = interval
+	}
+
+	return result
+}
+
+// DiffFields compares two structs of the same type field by field and returns
+// the JSON names of all fields which differ. Fields which are empty in desired
+// are skipped unless they are booleans, as Vault replaces empty values with
+// its defaults.
+func DiffFields(desired interface{}, actual interface{}) []string {
+	desiredValue := reflect.Indirect(reflect.ValueOf(desired))
+	actualValue := reflect.Indirect(reflect.ValueOf(actual))
+
+	if !desiredValue.IsValid() {
+		return nil
+	}
+
+	if !actualValue.IsValid() {
+		actualValue = reflect.Zero(desiredValue.Type())
+	}
+
+	var diff []string
+	for i := 0; i < desiredValue.NumField(); i++ {
+		field := desiredValue.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		want := reflect.Indirect(desiredValue.Field(i))
+		got := reflect.Indirect(actualValue.Field(i))
+
+		if !want.IsValid() || (want.Kind() != reflect.Bool && want.IsZero()) {
+			continue
+		}
+
+		if want.Kind() == reflect.Slice && got.IsValid() && want.Len() == 0 && got.Len() == 0 {
+			continue
+		}
+
+		if !got.IsValid() || !reflect.DeepEqual(want.Interface(), got.Interface()) {
+			diff = append(diff, getJSONName(field))
+		}
+	}
+
+	return diff
+}
+
+func getJSONName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
+
+// UpdateDriftCondition sets the Drifted condition of an object after it has
+// been compared with its state in Vault. drift lists the differences that
+// have been found. It returns true if the differences have to be overwritten,
+// which is the case for the Enforce policy.
+func UpdateDriftCondition(recorder record.EventRecorder, object runtime.Object, conditions *[]metav1.Condition, policy heistv1alpha1.DriftPolicy, drift []string) bool {
+	if len(drift) == 0 {
+		if meta.IsStatusConditionTrue(*conditions, heistv1alpha1.Conditions.Types.Drifted) {
+			recorder.Event(object, "Normal", "DriftResolved", "The object in Vault matches the desired state again")
+		}
+
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Drifted,
+			Status:  metav1.ConditionFalse,
+			Reason:  heistv1alpha1.Conditions.Reasons.InSync,
+			Message: "The object in Vault matches the desired state",
+		})
+		return false
+	}
+
+	sort.Strings(drift)
+	summary := strings.Join(drift, ", ")
+
+	if policy == heistv1alpha1.DriftPolicyEnforce {
+		recorder.Eventf(object, "Warning", "DriftRepaired", "The object has been modified in Vault outside of Heist, overwriting changes to %s", summary)
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Drifted,
+			Status:  metav1.ConditionFalse,
+			Reason:  heistv1alpha1.Conditions.Reasons.Repaired,
+			Message: fmt.Sprintf("Changes made in Vault outside of Heist have been overwritten: %s", summary),
+		})
+		return true
+	}
+
+	message := fmt.Sprintf("The object has been modified in Vault outside of Heist: %s", summary)
+	if condition := meta.FindStatusCondition(*conditions, heistv1alpha1.Conditions.Types.Drifted); condition == nil || condition.Message != message {
+		recorder.Event(object, "Warning", "DriftDetected", message)
+	}
+
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:    heistv1alpha1.Conditions.Types.Drifted,
+		Status:  metav1.ConditionTrue,
+		Reason:  heistv1alpha1.Conditions.Reasons.Drifted,
+		Message: message,
+	})
+	return false
+}
+
+// SetDriftBlockedCondition marks an object as not provisioned because it has
+// drifted in Vault and the Report policy prevents it from being written.
+func SetDriftBlockedCondition(conditions *[]metav1.Condition, generation int64, message string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               heistv1alpha1.Conditions.Types.Provisioned,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             heistv1alpha1.Conditions.Reasons.Drifted,
+		Message:            message,
+	})
+}
+
+// IsDriftCheckDue returns true if the given generation of an object has been
+// applied to Vault, or has only been held back by a reported drift. Only then
+// differences to Vault are caused by changes made outside of Heist.
+func IsDriftCheckDue(conditions []metav1.Condition, generation int64) bool {
+	condition := meta.FindStatusCondition(conditions, heistv1alpha1.Conditions.Types.Provisioned)
+	if condition == nil || condition.ObservedGeneration != generation {
+		return false
+	}
+
+	return condition.Status == metav1.ConditionTrue || condition.Reason == heistv1alpha1.Conditions.Reasons.Drifted
+}
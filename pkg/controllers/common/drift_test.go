@@ -0,0 +1,264 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type driftTestConfig struct {
+	Name     string   `json:"name,omitempty"`
+	TTL      int      `json:"ttl,omitempty"`
+	Enabled  bool     `json:"enabled"`
+	Domains  []string `json:"domains"`
+	NoTag    string
+	internal string
+}
+
+func TestDiffFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired *driftTestConfig
+		actual  *driftTestConfig
+		want    []string
+	}{
+		{
+			name:    "should not detect drift of equal structs",
+			desired: &driftTestConfig{Name: "name", TTL: 10, Enabled: true, Domains: []string{"example.com"}},
+			actual:  &driftTestConfig{Name: "name", TTL: 10, Enabled: true, Domains: []string{"example.com"}},
+			want:    nil,
+		},
+		{
+			name:    "should detect changed fields by their json name",
+			desired: &driftTestConfig{Name: "name", TTL: 10, Domains: []string{"example.com"}, NoTag: "value"},
+			actual:  &driftTestConfig{Name: "other", TTL: 10, Domains: []string{"example.org"}},
+			want:    []string{"name", "domains", "NoTag"},
+		},
+		{
+			name:    "should skip fields left empty in the desired state",
+			desired: &driftTestConfig{},
+			actual:  &driftTestConfig{Name: "name", TTL: 10},
+			want:    nil,
+		},
+		{
+			name:    "should detect changed booleans",
+			desired: &driftTestConfig{Enabled: false},
+			actual:  &driftTestConfig{Enabled: true},
+			want:    []string{"enabled"},
+		},
+		{
+			name:    "should treat nil and empty slices as equal",
+			desired: &driftTestConfig{Domains: []string{}},
+			actual:  &driftTestConfig{},
+			want:    nil,
+		},
+		{
+			name:    "should ignore unexported fields",
+			desired: &driftTestConfig{internal: "value"},
+			actual:  &driftTestConfig{},
+			want:    nil,
+		},
+		{
+			name:    "should detect drift if the actual state is missing",
+			desired: &driftTestConfig{Name: "name"},
+			actual:  nil,
+			want:    []string{"name"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual interface{}
+			if tt.actual != nil {
+				actual = tt.actual
+			}
+
+			if got := DiffFields(tt.desired, actual); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequeueForDriftCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   ctrl.Result
+		policy   heistv1alpha1.DriftPolicy
+		interval time.Duration
+		want     ctrl.Result
+	}{
+		{
+			name:   "should requeue after the default interval",
+			result: ctrl.Result{},
+			policy: heistv1alpha1.DriftPolicyReport,
+			want:   ctrl.Result{RequeueAfter: DefaultDriftCheckInterval},
+		},
+		{
+			name:     "should requeue after the configured interval",
+			result:   ctrl.Result{},
+			policy:   heistv1alpha1.DriftPolicyEnforce,
+			interval: time.Minute,
+			want:     ctrl.Result{RequeueAfter: time.Minute},
+		},
+		{
+			name:     "should keep earlier requeues",
+			result:   ctrl.Result{RequeueAfter: time.Second},
+			policy:   heistv1alpha1.DriftPolicyEnforce,
+			interval: time.Minute,
+			want:     ctrl.Result{RequeueAfter: time.Second},
+		},
+		{
+			name:     "should shorten later requeues",
+			result:   ctrl.Result{RequeueAfter: time.Hour},
+			policy:   heistv1alpha1.DriftPolicyEnforce,
+			interval: time.Minute,
+			want:     ctrl.Result{RequeueAfter: time.Minute},
+		},
+		{
+			name:     "should not requeue if drift is ignored",
+			result:   ctrl.Result{},
+			policy:   heistv1alpha1.DriftPolicyIgnore,
+			interval: time.Minute,
+			want:     ctrl.Result{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RequeueForDriftCheck(tt.result, tt.policy, tt.interval); got != tt.want {
+				t.Errorf("RequeueForDriftCheck() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateDriftCondition(t *testing.T) {
+	drifted := metav1.Condition{
+		Type:    heistv1alpha1.Conditions.Types.Drifted,
+		Status:  metav1.ConditionTrue,
+		Reason:  heistv1alpha1.Conditions.Reasons.Drifted,
+		Message: "The object has been modified in Vault outside of Heist: a, b",
+	}
+
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		policy     heistv1alpha1.DriftPolicy
+		drift      []string
+		want       bool
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantEvents int
+	}{
+		{
+			name:       "should mark object as in sync",
+			policy:     heistv1alpha1.DriftPolicyReport,
+			want:       false,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: heistv1alpha1.Conditions.Reasons.InSync,
+			wantEvents: 0,
+		},
+		{
+			name:       "should report resolved drift",
+			conditions: []metav1.Condition{drifted},
+			policy:     heistv1alpha1.DriftPolicyReport,
+			want:       false,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: heistv1alpha1.Conditions.Reasons.InSync,
+			wantEvents: 1,
+		},
+		{
+			name:       "should report drift",
+			policy:     heistv1alpha1.DriftPolicyReport,
+			drift:      []string{"b", "a"},
+			want:       false,
+			wantStatus: metav1.ConditionTrue,
+			wantReason: heistv1alpha1.Conditions.Reasons.Drifted,
+			wantEvents: 1,
+		},
+		{
+			name:       "should not report the same drift twice",
+			conditions: []metav1.Condition{drifted},
+			policy:     heistv1alpha1.DriftPolicyReport,
+			drift:      []string{"b", "a"},
+			want:       false,
+			wantStatus: metav1.ConditionTrue,
+			wantReason: heistv1alpha1.Conditions.Reasons.Drifted,
+			wantEvents: 0,
+		},
+		{
+			name:       "should repair drift",
+			policy:     heistv1alpha1.DriftPolicyEnforce,
+			drift:      []string{"a"},
+			want:       true,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: heistv1alpha1.Conditions.Reasons.Repaired,
+			wantEvents: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			object := &heistv1alpha1.VaultKVSecret{}
+
+			if got := UpdateDriftCondition(recorder, object, &tt.conditions, tt.policy, tt.drift); got != tt.want {
+				t.Errorf("UpdateDriftCondition() = %v, want %v", got, tt.want)
+			}
+
+			condition := meta.FindStatusCondition(tt.conditions, heistv1alpha1.Conditions.Types.Drifted)
+			if condition == nil {
+				t.Fatalf("UpdateDriftCondition() did not set the %s condition", heistv1alpha1.Conditions.Types.Drifted)
+			}
+
+			if condition.Status != tt.wantStatus || condition.Reason != tt.wantReason {
+				t.Errorf("UpdateDriftCondition() condition = %s/%s, want %s/%s", condition.Status, condition.Reason, tt.wantStatus, tt.wantReason)
+			}
+
+			if got := len(recorder.Events); got != tt.wantEvents {
+				t.Errorf("UpdateDriftCondition() emitted %d events, want %d", got, tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestIsDriftCheckDue(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition metav1.Condition
+		want      bool
+	}{
+		{
+			name:      "should check applied generation",
+			condition: metav1.Condition{Status: metav1.ConditionTrue, Reason: heistv1alpha1.Conditions.Reasons.Provisioned, ObservedGeneration: 2},
+			want:      true,
+		},
+		{
+			name:      "should check generation held back by reported drift",
+			condition: metav1.Condition{Status: metav1.ConditionFalse, Reason: heistv1alpha1.Conditions.Reasons.Drifted, ObservedGeneration: 2},
+			want:      true,
+		},
+		{
+			name:      "should not check generation which has not been applied yet",
+			condition: metav1.Condition{Status: metav1.ConditionTrue, Reason: heistv1alpha1.Conditions.Reasons.Provisioned, ObservedGeneration: 1},
+			want:      false,
+		},
+		{
+			name:      "should not check generation which failed to be applied",
+			condition: metav1.Condition{Status: metav1.ConditionFalse, Reason: heistv1alpha1.Conditions.Reasons.ErrorVault, ObservedGeneration: 2},
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.condition.Type = heistv1alpha1.Conditions.Types.Provisioned
+			if got := IsDriftCheckDue([]metav1.Condition{tt.condition}, 2); got != tt.want {
+				t.Errorf("IsDriftCheckDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
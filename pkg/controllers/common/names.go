@@ -10,6 +10,12 @@ func GetPolicyNameForSecret(secret *heistv1alpha1.VaultKVSecret) string {
 	return fmt.Sprintf("managed.kv.%s.%s", secret.Namespace, secret.Name)
 }
 
+// GetPasswordPolicyNameForSecret returns the name of the password policy which
+// is temporarily created to generate values from the charset rules of a field.
+func GetPasswordPolicyNameForSecret(secret *heistv1alpha1.VaultKVSecret) string {
+	return fmt.Sprintf("managed.kv.password.%s.%s", secret.Namespace, secret.Name)
+}
+
 func GetPolicyNameForCertificateIssuing(cert *heistv1alpha1.VaultCertificateRole) string {
 	return fmt.Sprintf("managed.pki.cert.issue.%s.%s", cert.Namespace, cert.Name)
 }
@@ -0,0 +1,20 @@
+package common
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// StartReconcileSpan starts the span covering a single reconciliation of an
+// object of the given kind. All Vault requests sent during the reconciliation
+// are recorded as children of this span.
+func StartReconcileSpan(ctx context.Context, kind string, req ctrl.Request) (context.Context, trace.Span) {
+	return tracing.Start(ctx, kind+".Reconcile",
+		attribute.String("k8s.namespace.name", req.Namespace),
+		attribute.String("k8s.object.name", req.Name),
+	)
+}
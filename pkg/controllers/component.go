@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/go-logr/logr"
 	"github.com/youniqx/heist/pkg/controllers/vaultbinding"
 	"github.com/youniqx/heist/pkg/controllers/vaultcertificateauthority"
@@ -8,6 +10,7 @@ import (
 	"github.com/youniqx/heist/pkg/controllers/vaultclientconfig"
 	"github.com/youniqx/heist/pkg/controllers/vaultkvsecret"
 	"github.com/youniqx/heist/pkg/controllers/vaultkvsecretengine"
+	"github.com/youniqx/heist/pkg/controllers/vaultpasswordpolicy"
 	"github.com/youniqx/heist/pkg/controllers/vaultsyncsecret"
 	"github.com/youniqx/heist/pkg/controllers/vaulttransitengine"
 	"github.com/youniqx/heist/pkg/controllers/vaulttransitkey"
@@ -20,16 +23,19 @@ import (
 type component struct {
 	Log                          logr.Logger
 	SyncSecretNamespaceAllowList []string
+	DriftCheckInterval           time.Duration
 }
 
 type Config struct {
 	SyncSecretNamespaceAllowList []string
+	DriftCheckInterval           time.Duration
 }
 
 func Component(config *Config) operator.Component {
 	return &component{
 		Log:                          controllerruntime.Log.WithName("setup-controller"),
 		SyncSecretNamespaceAllowList: config.SyncSecretNamespaceAllowList,
+		DriftCheckInterval:           config.DriftCheckInterval,
 	}
 }
 
@@ -37,12 +43,13 @@ func (c *component) Register(api vault.API, mgr manager.Manager) error {
 	filter := operator.NewFilter()
 
 	if err := (&vaultkvsecret.Reconciler{
-		Client:      mgr.GetClient(),
-		Log:         controllerruntime.Log.WithName("controllers").WithName("VaultKVSecret"),
-		Scheme:      mgr.GetScheme(),
-		VaultAPI:    api,
-		Recorder:    mgr.GetEventRecorderFor("vaultkvsecret-controller"),
-		EventFilter: filter,
+		Client:             mgr.GetClient(),
+		Log:                controllerruntime.Log.WithName("controllers").WithName("VaultKVSecret"),
+		Scheme:             mgr.GetScheme(),
+		VaultAPI:           api,
+		Recorder:           mgr.GetEventRecorderFor("vaultkvsecret-controller"),
+		EventFilter:        filter,
+		DriftCheckInterval: c.DriftCheckInterval,
 	}).SetupWithManager(mgr); err != nil {
 		c.Log.Error(err, "unable to create controller", "controller", "VaultKVSecret")
 		return err
@@ -58,6 +65,17 @@ func (c *component) Register(api vault.API, mgr manager.Manager) error {
 		c.Log.Error(err, "unable to create controller", "controller", "VaultKVSecretEngine")
 		return err
 	}
+	if err := (&vaultpasswordpolicy.Reconciler{
+		Client:      mgr.GetClient(),
+		Log:         controllerruntime.Log.WithName("controllers").WithName("VaultPasswordPolicy"),
+		Scheme:      mgr.GetScheme(),
+		VaultAPI:    api,
+		Recorder:    mgr.GetEventRecorderFor("vaultpasswordpolicy-controller"),
+		EventFilter: filter,
+	}).SetupWithManager(mgr); err != nil {
+		c.Log.Error(err, "unable to create controller", "controller", "VaultPasswordPolicy")
+		return err
+	}
 	if err := (&vaultbinding.Reconciler{
 		Client:      mgr.GetClient(),
 		Log:         controllerruntime.Log.WithName("controllers").WithName("VaultBinding"),
@@ -81,12 +99,13 @@ func (c *component) Register(api vault.API, mgr manager.Manager) error {
 		return err
 	}
 	if err := (&vaultcertificaterole.Reconciler{
-		Client:      mgr.GetClient(),
-		Log:         controllerruntime.Log.WithName("controllers").WithName("VaultCertificateRole"),
-		Scheme:      mgr.GetScheme(),
-		VaultAPI:    api,
-		Recorder:    mgr.GetEventRecorderFor("vaultcertificaterole-controller"),
-		EventFilter: filter,
+		Client:             mgr.GetClient(),
+		Log:                controllerruntime.Log.WithName("controllers").WithName("VaultCertificateRole"),
+		Scheme:             mgr.GetScheme(),
+		VaultAPI:           api,
+		Recorder:           mgr.GetEventRecorderFor("vaultcertificaterole-controller"),
+		EventFilter:        filter,
+		DriftCheckInterval: c.DriftCheckInterval,
 	}).SetupWithManager(mgr); err != nil {
 		c.Log.Error(err, "unable to create controller", "controller", "VaultCertificateRole")
 		return err
@@ -124,12 +143,13 @@ func (c *component) Register(api vault.API, mgr manager.Manager) error {
 		return err
 	}
 	if err := (&vaulttransitkey.Reconciler{
-		Client:      mgr.GetClient(),
-		Log:         controllerruntime.Log.WithName("controllers").WithName("VaultTransitKey"),
-		Scheme:      mgr.GetScheme(),
-		VaultAPI:    api,
-		Recorder:    mgr.GetEventRecorderFor("vaulttransitkey-controller"),
-		EventFilter: filter,
+		Client:             mgr.GetClient(),
+		Log:                controllerruntime.Log.WithName("controllers").WithName("VaultTransitKey"),
+		Scheme:             mgr.GetScheme(),
+		VaultAPI:           api,
+		Recorder:           mgr.GetEventRecorderFor("vaulttransitkey-controller"),
+		EventFilter:        filter,
+		DriftCheckInterval: c.DriftCheckInterval,
 	}).SetupWithManager(mgr); err != nil {
 		c.Log.Error(err, "unable to create controller", "controller", "VaultTransitKey")
 		return err
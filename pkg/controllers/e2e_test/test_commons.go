@@ -1,6 +1,7 @@
 package e2e_test
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"time"
@@ -107,7 +108,7 @@ func (c *ControllerTest) BeforeSuiteSetup() {
 	Expect(c.VaultEnv).NotTo(BeNil())
 
 	By("setup Vault for Operator")
-	err = operator.SetupOperator(&operator.SetupConfig{
+	err = operator.SetupOperator(context.TODO(), &operator.SetupConfig{
 		VaultURL:             c.VaultEnv.GetAddress(),
 		VaultToken:           "root",
 		PolicyName:           "heist",
@@ -149,7 +150,7 @@ func (c *ControllerTest) BeforeSuiteSetup() {
 			Scheme: scheme.Scheme,
 		}).
 		Register(controllers.Component(&controllers.Config{})).
-		Complete()
+		Complete(context.TODO())
 	Expect(err).ToNot(HaveOccurred())
 	Expect(mgr).ToNot(BeNil())
 
@@ -158,23 +159,23 @@ func (c *ControllerTest) BeforeSuiteSetup() {
 
 	c.K8sEnv = testhelper.New(cfg, c.K8sClient)
 
-	c.DefaultCipherText, err = c.RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
+	c.DefaultCipherText, err = c.RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
 	Expect(err).NotTo(HaveOccurred())
 	Expect(c.DefaultCipherText).NotTo(BeEmpty())
 
-	c.RootPrivateKeyCipherText, err = c.RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte(RootPrivateKey))
+	c.RootPrivateKeyCipherText, err = c.RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte(RootPrivateKey))
 	Expect(err).NotTo(HaveOccurred())
 	Expect(c.RootPrivateKeyCipherText).NotTo(BeEmpty())
 
-	c.RootCertificateCipherText, err = c.RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte(RootCertificate))
+	c.RootCertificateCipherText, err = c.RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte(RootCertificate))
 	Expect(err).NotTo(HaveOccurred())
 	Expect(c.RootCertificateCipherText).NotTo(BeEmpty())
 
-	c.IntermediatePrivateKeyCipherText, err = c.RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte(IntermediatePrivateKey))
+	c.IntermediatePrivateKeyCipherText, err = c.RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte(IntermediatePrivateKey))
 	Expect(err).NotTo(HaveOccurred())
 	Expect(c.IntermediatePrivateKeyCipherText).NotTo(BeEmpty())
 
-	c.IntermediateCertificateCipherText, err = c.RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte(IntermediateCertificate))
+	c.IntermediateCertificateCipherText, err = c.RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte(IntermediateCertificate))
 	Expect(err).NotTo(HaveOccurred())
 	Expect(c.IntermediateCertificateCipherText).NotTo(BeEmpty())
 
@@ -179,6 +179,71 @@ var _ = Describe("VaultBinding Controller", func() {
 			Expect(k8sRoleBinding.Subjects[0].Name).To(Equal("some-sa-0"))
 			Expect(k8sRoleBinding.Subjects[0].Namespace).To(Equal("default"))
 		})
+
+		It("Should keep auth settings configured on the VaultClientConfig", func() {
+			saName := fmt.Sprintf("some-sa-%d", counter)
+			binding = &heistv1alpha1.VaultBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("binding-%d", counter),
+					Namespace: "default",
+				},
+				Spec: heistv1alpha1.VaultBindingSpec{
+					Subject: heistv1alpha1.VaultBindingSubject{
+						Name: saName,
+					},
+					KVSecrets: []heistv1alpha1.VaultBindingKV{
+						{
+							Name: secret.Name,
+						},
+					},
+				},
+			}
+			Expect(Test.K8sClient.Create(context.TODO(), binding)).To(Succeed())
+
+			config := &heistv1alpha1.VaultClientConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      saName,
+					Namespace: "default",
+				},
+			}
+			Eventually(func() error {
+				if err := Test.K8sClient.Get(context.TODO(), client.ObjectKeyFromObject(config), config); err != nil {
+					return err
+				}
+				config.Spec.AuthMethod = heistv1alpha1.VaultClientConfigAuthMethodJWT
+				config.Spec.AuthMountPath = "jwt"
+				config.Spec.Role = "some-jwt-role"
+				config.Spec.JWTPath = "/var/run/secrets/vault/token"
+				return Test.K8sClient.Update(context.TODO(), config)
+			}, 30*time.Second, 250*time.Millisecond).Should(Succeed())
+
+			Eventually(func() error {
+				if err := Test.K8sClient.Get(context.TODO(), client.ObjectKeyFromObject(binding), binding); err != nil {
+					return err
+				}
+				binding.Spec.Agent.Templates = []heistv1alpha1.VaultBindingValueTemplate{
+					{
+						Path:     "TestName",
+						Template: fmt.Sprintf("{{ kvSecret \"%s\" \"some-field\" }}", secret.Name),
+					},
+				}
+				return Test.K8sClient.Update(context.TODO(), binding)
+			}, 30*time.Second, 250*time.Millisecond).Should(Succeed())
+
+			Eventually(func() *heistv1alpha1.VaultClientConfigSpec {
+				result := &heistv1alpha1.VaultClientConfig{}
+				Expect(Test.K8sClient.Get(context.TODO(), client.ObjectKeyFromObject(config), result)).To(Succeed())
+				if len(result.Spec.Templates.Templates) == 0 {
+					return nil
+				}
+				return &result.Spec
+			}, 30*time.Second, 250*time.Millisecond).Should(And(
+				HaveField("AuthMethod", heistv1alpha1.VaultClientConfigAuthMethodJWT),
+				HaveField("AuthMountPath", "jwt"),
+				HaveField("Role", "some-jwt-role"),
+				HaveField("JWTPath", "/var/run/secrets/vault/token"),
+			))
+		})
 	})
 
 	It("Should correctly update VaultKubernetesAuthRole CRDs", func() {
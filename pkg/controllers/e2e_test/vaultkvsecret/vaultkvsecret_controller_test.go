@@ -27,6 +27,7 @@ import (
 	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
 	. "github.com/youniqx/heist/pkg/testhelper"
 	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvsecret"
 	. "github.com/youniqx/heist/pkg/vault/matchers"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -270,7 +271,7 @@ var _ = Describe("VaultKVSecret Controller", func() {
 				}
 
 				vault, _ := Test.VaultEnv.GetAPI()
-				vaultEngineExists, err := vault.HasEngine(engine)
+				vaultEngineExists, err := vault.HasEngine(context.TODO(), engine)
 				if err != nil {
 					fmt.Printf(":::: test debug output :::: couldn't check if vault still has engine: %v\n", err)
 				}
@@ -608,7 +609,7 @@ var _ = Describe("VaultKVSecret Controller", func() {
 		})
 
 		It("Should delete the secret in the previous engine and create it in the new engine but keep the same values", func() {
-			oldSecret, err := Test.RootAPI.ReadKvSecret(engineA, secret)
+			oldSecret, err := Test.RootAPI.ReadKvSecret(context.TODO(), engineA, secret)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(oldSecret.Fields["some-field"]).To(HaveLen(64))
 
@@ -700,7 +701,7 @@ var _ = Describe("VaultKVSecret Controller", func() {
 		})
 
 		It("Should delete the secret in the previous location and create it in the new one but keep the same values", func() {
-			oldSecret, err := Test.RootAPI.ReadKvSecret(engine, secret)
+			oldSecret, err := Test.RootAPI.ReadKvSecret(context.TODO(), engine, secret)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(oldSecret.Fields["some-field"]).To(HaveLen(64))
 
@@ -728,6 +729,105 @@ var _ = Describe("VaultKVSecret Controller", func() {
 			Test.VaultEnv.KvSecret(engine, secret).Should(HaveKvSecretFieldWithValue("some-field", oldSecret.Fields["some-field"]))
 		})
 	})
+
+	When("A secret has been modified in Vault outside of Heist", func() {
+		var engine *heistv1alpha1.VaultKVSecretEngine
+		var secret *heistv1alpha1.VaultKVSecret
+
+		BeforeEach(func() {
+			engine = &heistv1alpha1.VaultKVSecretEngine{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "VaultKVSecretEngine",
+					APIVersion: "heist.youniqx.com/v1alpha1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "conflict-engine",
+					Namespace: "default",
+				},
+				Spec:   heistv1alpha1.VaultKVSecretEngineSpec{},
+				Status: heistv1alpha1.VaultKVSecretEngineStatus{},
+			}
+
+			secret = &heistv1alpha1.VaultKVSecret{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "VaultKVSecret",
+					APIVersion: "heist.youniqx.com/v1alpha1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "some-secret",
+					Namespace: "default",
+				},
+				Spec: heistv1alpha1.VaultKVSecretSpec{
+					Engine: engine.Name,
+					Fields: map[string]*heistv1alpha1.VaultKVSecretField{
+						"some-field": {
+							AutoGenerated: true,
+						},
+					},
+				},
+				Status: heistv1alpha1.VaultKVSecretStatus{},
+			}
+
+			Test.K8sEnv.Create(engine)
+			Test.K8sEnv.Create(secret)
+			Test.K8sEnv.Object(secret).Should(HaveCondition(
+				heistv1alpha1.Conditions.Types.Provisioned,
+				metav1.ConditionTrue,
+				heistv1alpha1.Conditions.Reasons.Provisioned,
+				"Secret has been provisioned",
+			))
+			Test.VaultEnv.KvSecret(engine, secret).Should(BeStableFor(4.0 * time.Second))
+
+			Expect(Test.RootAPI.UpdateKvSecret(context.TODO(), engine, &kvsecret.KvSecret{
+				Path: secret.Name,
+				Fields: map[string]string{
+					"some-field": "modified-outside-of-heist",
+				},
+			})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			afterEachCleanup(engine, secret)
+		})
+
+		It("Should report a conflict instead of overwriting the secret", func() {
+			Expect(Test.K8sClient.Get(context.TODO(), client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			secret.Spec.Fields["another-field"] = &heistv1alpha1.VaultKVSecretField{AutoGenerated: true}
+			Expect(Test.K8sClient.Update(context.TODO(), secret)).To(Succeed())
+
+			Test.K8sEnv.Object(secret).Should(HaveCondition(
+				heistv1alpha1.Conditions.Types.Provisioned,
+				metav1.ConditionFalse,
+				heistv1alpha1.Conditions.Reasons.Conflict,
+				"Secret has been modified in Vault outside of Heist",
+			))
+			Test.K8sEnv.Object(secret).Should(HaveCondition(
+				heistv1alpha1.Conditions.Types.Conflict,
+				metav1.ConditionTrue,
+				heistv1alpha1.Conditions.Reasons.Conflict,
+				"Secret has been modified in Vault outside of Heist, set the heist.youniqx.com/overwrite-conflicts annotation to true to overwrite it: fields some-field differ",
+			))
+			Test.VaultEnv.KvSecret(engine, secret).Should(HaveKvSecretFieldWithValue("some-field", "modified-outside-of-heist"))
+			Test.VaultEnv.KvSecret(engine, secret).Should(BeStableFor(4.0 * time.Second))
+		})
+
+		It("Should overwrite the secret when the overwrite annotation is set", func() {
+			Expect(Test.K8sClient.Get(context.TODO(), client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			secret.Annotations = map[string]string{
+				heistv1alpha1.AnnotationOverwriteConflicts: "true",
+			}
+			secret.Spec.Fields["another-field"] = &heistv1alpha1.VaultKVSecretField{AutoGenerated: true}
+			Expect(Test.K8sClient.Update(context.TODO(), secret)).To(Succeed())
+
+			Test.VaultEnv.KvSecret(engine, secret).Should(HaveKvSecretFieldFieldWithLength("another-field", 64))
+			Test.VaultEnv.KvSecret(engine, secret).Should(HaveKvSecretFieldFieldWithLength("some-field", 64))
+			Eventually(func() map[string]string {
+				result := &heistv1alpha1.VaultKVSecret{}
+				Expect(Test.K8sClient.Get(context.TODO(), client.ObjectKeyFromObject(secret), result)).To(Succeed())
+				return result.Annotations
+			}).ShouldNot(HaveKey(heistv1alpha1.AnnotationOverwriteConflicts))
+		})
+	})
 })
 
 func afterEachCleanup(engine *heistv1alpha1.VaultKVSecretEngine, secret *heistv1alpha1.VaultKVSecret) {
@@ -736,7 +836,7 @@ func afterEachCleanup(engine *heistv1alpha1.VaultKVSecretEngine, secret *heistv1
 		Test.K8sEnv.DeleteIfPresent(engine, secret)
 
 		vault, _ := Test.VaultEnv.GetAPI()
-		vaultEngineExists, err := vault.HasEngine(engine)
+		vaultEngineExists, err := vault.HasEngine(context.TODO(), engine)
 		if err != nil {
 			fmt.Printf(":::: test debug output cleanup :::: couldn't check if vault still has engine: %v\n", err)
 		}
@@ -174,7 +174,7 @@ var _ = Describe("VaultSyncSecret Controller", func() {
 			Expect(secret.Data).To(HaveLen(1))
 			Expect(secret.Data["full_chain"]).NotTo(BeEmpty())
 
-			kvSecret, err := Test.RootAPI.ReadKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(intermediateCA)))
+			kvSecret, err := Test.RootAPI.ReadKvSecret(context.TODO(), common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(intermediateCA)))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(kvSecret).NotTo(BeNil())
 			Expect(kvSecret.Fields["full_certificate_chain"]).To(Equal(string(secret.Data["full_chain"])))
@@ -222,7 +222,7 @@ var _ = Describe("VaultSyncSecret Controller", func() {
 			Expect(secret.Data).To(HaveLen(1))
 			Expect(secret.Data["cert_chain"]).NotTo(BeEmpty())
 
-			kvSecret, err := Test.RootAPI.ReadKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(intermediateCA)))
+			kvSecret, err := Test.RootAPI.ReadKvSecret(context.TODO(), common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(intermediateCA)))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(kvSecret).NotTo(BeNil())
 			Expect(kvSecret.Fields["certificate_chain"]).To(Equal(string(secret.Data["cert_chain"])))
@@ -270,7 +270,7 @@ var _ = Describe("VaultSyncSecret Controller", func() {
 			Expect(secret.Data).To(HaveLen(1))
 			Expect(secret.Data["cert"]).NotTo(BeEmpty())
 
-			kvSecret, err := Test.RootAPI.ReadKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(intermediateCA)))
+			kvSecret, err := Test.RootAPI.ReadKvSecret(context.TODO(), common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(intermediateCA)))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(kvSecret).NotTo(BeNil())
 			Expect(kvSecret.Fields["certificate"]).To(Equal(string(secret.Data["cert"])))
@@ -318,7 +318,7 @@ var _ = Describe("VaultSyncSecret Controller", func() {
 			Expect(secret.Data).To(HaveLen(1))
 			Expect(secret.Data["key"]).NotTo(BeEmpty())
 
-			kvSecret, err := Test.RootAPI.ReadKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAPrivateKeySecretPath(intermediateCA)))
+			kvSecret, err := Test.RootAPI.ReadKvSecret(context.TODO(), common.InternalKvEngine, core.SecretPath(common.GetCAPrivateKeySecretPath(intermediateCA)))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(kvSecret).NotTo(BeNil())
 			Expect(kvSecret.Fields["private_key"]).To(Equal(string(secret.Data["key"])))
@@ -850,10 +850,10 @@ var _ = Describe("VaultSyncSecret Controller", func() {
 			Expect(secret.Data["tls.key"]).NotTo(BeEmpty())
 			Expect(secret.Data["some_key"]).To(Equal([]byte("ASDF ASDF")))
 
-			publicInfo, err := Test.RootAPI.ReadKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(intermediateCA)))
+			publicInfo, err := Test.RootAPI.ReadKvSecret(context.TODO(), common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(intermediateCA)))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(publicInfo).NotTo(BeNil())
-			privateInfo, err := Test.RootAPI.ReadKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAPrivateKeySecretPath(intermediateCA)))
+			privateInfo, err := Test.RootAPI.ReadKvSecret(context.TODO(), common.InternalKvEngine, core.SecretPath(common.GetCAPrivateKeySecretPath(intermediateCA)))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(privateInfo).NotTo(BeNil())
 
@@ -17,6 +17,7 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -93,7 +94,7 @@ func BeforeSuiteSetup() func() {
 		Expect(VaultEnv).NotTo(BeNil())
 
 		By("setup Vault for Operator")
-		err = operator.SetupOperator(&operator.SetupConfig{
+		err = operator.SetupOperator(context.TODO(), &operator.SetupConfig{
 			VaultURL:             VaultEnv.GetAddress(),
 			VaultToken:           "root",
 			PolicyName:           "heist",
@@ -135,7 +136,7 @@ func BeforeSuiteSetup() func() {
 				Scheme: scheme.Scheme,
 			}).
 			Register(Component(&Config{})).
-			Complete()
+			Complete(context.TODO())
 		Expect(err).ToNot(HaveOccurred())
 		Expect(mgr).ToNot(BeNil())
 
@@ -144,23 +145,23 @@ func BeforeSuiteSetup() func() {
 
 		K8sEnv = testhelper.New(cfg, K8sClient)
 
-		DefaultCipherText, err = RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
+		DefaultCipherText, err = RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
 		Expect(err).NotTo(HaveOccurred())
 		Expect(DefaultCipherText).NotTo(BeEmpty())
 
-		RootPrivateKeyCipherText, err = RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte(rootPrivateKey))
+		RootPrivateKeyCipherText, err = RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte(rootPrivateKey))
 		Expect(err).NotTo(HaveOccurred())
 		Expect(RootPrivateKeyCipherText).NotTo(BeEmpty())
 
-		RootCertificateCipherText, err = RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte(rootCertificate))
+		RootCertificateCipherText, err = RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte(rootCertificate))
 		Expect(err).NotTo(HaveOccurred())
 		Expect(RootCertificateCipherText).NotTo(BeEmpty())
 
-		IntermediatePrivateKeyCipherText, err = RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte(intermediatePrivateKey))
+		IntermediatePrivateKeyCipherText, err = RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte(intermediatePrivateKey))
 		Expect(err).NotTo(HaveOccurred())
 		Expect(IntermediatePrivateKeyCipherText).NotTo(BeEmpty())
 
-		IntermediateCertificateCipherText, err = RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte(intermediateCertificate))
+		IntermediateCertificateCipherText, err = RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte(intermediateCertificate))
 		Expect(err).NotTo(HaveOccurred())
 		Expect(IntermediateCertificateCipherText).NotTo(BeEmpty())
 
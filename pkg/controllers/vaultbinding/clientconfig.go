@@ -42,8 +42,10 @@ func (r *Reconciler) updateClientConfig(ctx context.Context, info *BindingInfo)
 	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, config, func() error {
 		_ = controllerutil.SetControllerReference(info.Binding, config, r.Scheme)
 
-		config.Spec = v1alpha1.VaultClientConfigSpec{
+		spec := v1alpha1.VaultClientConfigSpec{
 			Address:                r.VaultAPI.GetAddress(),
+			FailoverAddresses:      r.VaultAPI.GetAddresses()[1:],
+			Namespace:              r.VaultAPI.GetNamespace(),
 			Role:                   info.VaultRoleName,
 			CACerts:                r.VaultAPI.GetCACerts(),
 			AuthMountPath:          managed.KubernetesAuthPath,
@@ -53,6 +55,8 @@ func (r *Reconciler) updateClientConfig(ctx context.Context, info *BindingInfo)
 			TransitKeys:            transitKeys,
 			Templates:              info.Spec.Agent,
 		}
+		keepAuthSettings(&spec, &config.Spec)
+		config.Spec = spec
 
 		return nil
 	})
@@ -73,6 +77,26 @@ func (r *Reconciler) updateClientConfig(ctx context.Context, info *BindingInfo)
 	return nil
 }
 
+// keepAuthSettings copies the auth settings which have been configured on an
+// existing VaultClientConfig into the generated spec. VaultBindings only set up
+// Kubernetes Auth, so other auth methods and client certificates are configured
+// on the VaultClientConfig itself and must not be reset by the binding.
+func keepAuthSettings(spec *v1alpha1.VaultClientConfigSpec, existing *v1alpha1.VaultClientConfigSpec) {
+	spec.JWTPath = existing.JWTPath
+	spec.AppRoleRoleID = existing.AppRoleRoleID
+	spec.AppRoleSecretIDPath = existing.AppRoleSecretIDPath
+	spec.ClientCertPath = existing.ClientCertPath
+	spec.ClientKeyPath = existing.ClientKeyPath
+
+	if existing.AuthMethod == "" || existing.AuthMethod == v1alpha1.VaultClientConfigAuthMethodKubernetes {
+		return
+	}
+
+	spec.AuthMethod = existing.AuthMethod
+	spec.AuthMountPath = existing.AuthMountPath
+	spec.Role = existing.Role
+}
+
 func (r *Reconciler) getKvSecretsForConfig(ctx context.Context, info *BindingInfo) ([]*v1alpha1.VaultKVSecretRef, error) {
 	secrets := make([]*v1alpha1.VaultKVSecretRef, 0, len(info.Spec.KVSecrets))
 	for _, kv := range info.Spec.KVSecrets {
@@ -112,6 +136,7 @@ func (r *Reconciler) getKvSecretsForConfig(ctx context.Context, info *BindingInf
 			Name:         secret.Name,
 			EnginePath:   enginePath,
 			SecretPath:   secretPath,
+			Version:      secret.Spec.PinnedVersion,
 			Capabilities: kv.Capabilities,
 		})
 	}
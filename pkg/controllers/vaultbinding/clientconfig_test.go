@@ -0,0 +1,87 @@
+package vaultbinding
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/managed"
+)
+
+func Test_keepAuthSettings(t *testing.T) {
+	generated := v1alpha1.VaultClientConfigSpec{
+		Address:       "https://vault:8200",
+		Role:          "managed.k8s.default.app",
+		AuthMountPath: managed.KubernetesAuthPath,
+	}
+
+	tests := []struct {
+		name     string
+		existing v1alpha1.VaultClientConfigSpec
+		want     v1alpha1.VaultClientConfigSpec
+	}{
+		{
+			name:     "should use kubernetes auth for new configs",
+			existing: v1alpha1.VaultClientConfigSpec{},
+			want:     generated,
+		},
+		{
+			name: "should keep client certificate with kubernetes auth",
+			existing: v1alpha1.VaultClientConfigSpec{
+				Role:           "outdated",
+				AuthMountPath:  "outdated",
+				AuthMethod:     v1alpha1.VaultClientConfigAuthMethodKubernetes,
+				ClientCertPath: "/etc/tls/tls.crt",
+				ClientKeyPath:  "/etc/tls/tls.key",
+			},
+			want: v1alpha1.VaultClientConfigSpec{
+				Address:        "https://vault:8200",
+				Role:           "managed.k8s.default.app",
+				AuthMountPath:  managed.KubernetesAuthPath,
+				ClientCertPath: "/etc/tls/tls.crt",
+				ClientKeyPath:  "/etc/tls/tls.key",
+			},
+		},
+		{
+			name: "should keep jwt auth settings",
+			existing: v1alpha1.VaultClientConfigSpec{
+				Role:          "app",
+				AuthMountPath: "jwt",
+				AuthMethod:    v1alpha1.VaultClientConfigAuthMethodJWT,
+				JWTPath:       "/var/run/secrets/vault/token",
+			},
+			want: v1alpha1.VaultClientConfigSpec{
+				Address:       "https://vault:8200",
+				Role:          "app",
+				AuthMountPath: "jwt",
+				AuthMethod:    v1alpha1.VaultClientConfigAuthMethodJWT,
+				JWTPath:       "/var/run/secrets/vault/token",
+			},
+		},
+		{
+			name: "should keep approle auth settings",
+			existing: v1alpha1.VaultClientConfigSpec{
+				AuthMountPath:       "approle",
+				AuthMethod:          v1alpha1.VaultClientConfigAuthMethodAppRole,
+				AppRoleRoleID:       "role-id",
+				AppRoleSecretIDPath: "/etc/vault/secret-id",
+			},
+			want: v1alpha1.VaultClientConfigSpec{
+				Address:             "https://vault:8200",
+				AuthMountPath:       "approle",
+				AuthMethod:          v1alpha1.VaultClientConfigAuthMethodAppRole,
+				AppRoleRoleID:       "role-id",
+				AppRoleSecretIDPath: "/etc/vault/secret-id",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := generated
+			keepAuthSettings(&spec, &tt.existing)
+			if !reflect.DeepEqual(spec, tt.want) {
+				t.Errorf("keepAuthSettings() = %+v, want %+v", spec, tt.want)
+			}
+		})
+	}
+}
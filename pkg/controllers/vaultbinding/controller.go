@@ -62,6 +62,9 @@ type Reconciler struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.7.0/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := common.StartReconcileSpan(ctx, "VaultBinding", req)
+	defer span.End()
+
 	log := r.Log.WithValues("vaultbinding", req.NamespacedName)
 	log.Info("reconciling for binding")
 
@@ -115,7 +118,7 @@ func setDefaultConditions(binding *heistv1alpha1.VaultBinding) {
 
 func (r *Reconciler) performReconciliation(ctx context.Context, binding *heistv1alpha1.VaultBinding, spec *heistv1alpha1.VaultBindingSpec) (ctrl.Result, error) {
 	if binding.GetDeletionTimestamp() != nil {
-		return r.finalizeBinding(binding, spec)
+		return r.finalizeBinding(ctx, binding, spec)
 	}
 
 	binding.Status.AppliedSpec = *spec
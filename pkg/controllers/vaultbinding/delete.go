@@ -17,6 +17,8 @@ limitations under the License.
 package vaultbinding
 
 import (
+	"context"
+
 	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
 	"github.com/youniqx/heist/pkg/controllers/common"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -25,7 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-func (r *Reconciler) finalizeBinding(binding *heistv1alpha1.VaultBinding, spec *heistv1alpha1.VaultBindingSpec) (ctrl.Result, error) {
+func (r *Reconciler) finalizeBinding(ctx context.Context, binding *heistv1alpha1.VaultBinding, spec *heistv1alpha1.VaultBindingSpec) (ctrl.Result, error) {
 	meta.SetStatusCondition(&binding.Status.Conditions, metav1.Condition{
 		Type:    heistv1alpha1.Conditions.Types.Provisioned,
 		Status:  metav1.ConditionFalse,
@@ -39,7 +41,7 @@ func (r *Reconciler) finalizeBinding(binding *heistv1alpha1.VaultBinding, spec *
 		Message: "Binding is being deleted",
 	})
 
-	if err := r.deleteVaultRole(binding, spec); err != nil {
+	if err := r.deleteVaultRole(ctx, binding, spec); err != nil {
 		return common.Requeue, err
 	}
 
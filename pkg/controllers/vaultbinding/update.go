@@ -71,13 +71,13 @@ func (r *Reconciler) updateBinding(ctx context.Context, binding *heistv1alpha1.V
 		meta.SetStatusCondition(&binding.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to collect policy information: %v", err),
 		})
 		return common.Requeue, client.IgnoreNotFound(err)
 	}
 
-	if err := r.updateVaultRole(bindingInfo); err != nil {
+	if err := r.updateVaultRole(ctx, bindingInfo); err != nil {
 		r.Recorder.Eventf(binding, "Normal", "ConfiguringRoleFailed", "Could not create role based on binding %s: %v", binding.Name, err)
 		meta.SetStatusCondition(&binding.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
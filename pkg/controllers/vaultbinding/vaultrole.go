@@ -17,12 +17,14 @@ limitations under the License.
 package vaultbinding
 
 import (
+	"context"
+
 	"github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
 	"github.com/youniqx/heist/pkg/managed"
 	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
 )
 
-func (r *Reconciler) updateVaultRole(info *BindingInfo) error {
+func (r *Reconciler) updateVaultRole(ctx context.Context, info *BindingInfo) error {
 	role := &kubernetesauth.Role{
 		Name:                 info.VaultRoleName,
 		BoundServiceAccounts: []string{info.Spec.Subject.Name},
@@ -31,11 +33,11 @@ func (r *Reconciler) updateVaultRole(info *BindingInfo) error {
 	}
 
 	if len(info.Policies) == 0 {
-		if err := r.VaultAPI.DeleteKubernetesAuthRole(managed.KubernetesAuth, role); err != nil {
+		if err := r.VaultAPI.DeleteKubernetesAuthRole(ctx, managed.KubernetesAuth, role); err != nil {
 			return err
 		}
 	} else {
-		if err := r.VaultAPI.UpdateKubernetesAuthRole(managed.KubernetesAuth, role); err != nil {
+		if err := r.VaultAPI.UpdateKubernetesAuthRole(ctx, managed.KubernetesAuth, role); err != nil {
 			return err
 		}
 	}
@@ -43,8 +45,8 @@ func (r *Reconciler) updateVaultRole(info *BindingInfo) error {
 	return nil
 }
 
-func (r *Reconciler) deleteVaultRole(binding *v1alpha1.VaultBinding, spec *v1alpha1.VaultBindingSpec) error {
-	return r.VaultAPI.DeleteKubernetesAuthRole(managed.KubernetesAuth, &kubernetesauth.Role{
+func (r *Reconciler) deleteVaultRole(ctx context.Context, binding *v1alpha1.VaultBinding, spec *v1alpha1.VaultBindingSpec) error {
+	return r.VaultAPI.DeleteKubernetesAuthRole(ctx, managed.KubernetesAuth, &kubernetesauth.Role{
 		Name: getVaultRoleName(binding, spec),
 	})
 }
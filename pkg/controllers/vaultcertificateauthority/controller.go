@@ -55,6 +55,9 @@ type Reconciler struct {
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := common.StartReconcileSpan(ctx, "VaultCertificateAuthority", req)
+	defer span.End()
+
 	log := r.Log.WithValues("vaultcertificateauthority", req.NamespacedName)
 	log.Info("reconciling for vault certificate authority")
 
@@ -33,56 +33,56 @@ func (r *Reconciler) finalizeCA(ctx context.Context, ca *heistv1alpha1.VaultCert
 }
 
 func (r *Reconciler) deleteCA(ctx context.Context, ca *heistv1alpha1.VaultCertificateAuthority) error {
-	if err := r.VaultAPI.DeleteEngine(ca); err != nil {
+	if err := r.VaultAPI.DeleteEngine(ctx, ca); err != nil {
 		r.Recorder.Event(ca, "Warning", "ErrorDuringCADeletion", "Failed to delete VaultCertificateAuthority from Vault.")
 		meta.SetStatusCondition(&ca.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to delete VaultCertificateAuthority from Vault: %v", err),
 		})
 		return err
 	}
 
-	if err := r.VaultAPI.DeleteKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(ca))); err != nil {
+	if err := r.VaultAPI.DeleteKvSecret(ctx, common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(ca))); err != nil {
 		r.Recorder.Event(ca, "Warning", "ErrorDuringCADeletion", "Failed to delete private key from Vault.")
 		meta.SetStatusCondition(&ca.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to delete private key from Vault: %v", err),
 		})
 		return err
 	}
 
-	if err := r.VaultAPI.DeleteKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAPrivateKeySecretPath(ca))); err != nil {
+	if err := r.VaultAPI.DeleteKvSecret(ctx, common.InternalKvEngine, core.SecretPath(common.GetCAPrivateKeySecretPath(ca))); err != nil {
 		r.Recorder.Event(ca, "Warning", "ErrorDuringCADeletion", "Failed to delete private key from Vault.")
 		meta.SetStatusCondition(&ca.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to delete private key from Vault: %v", err),
 		})
 		return err
 	}
 
-	if err := r.VaultAPI.DeletePolicy(core.PolicyName(common.GetPolicyNameForCertificateAuthorityPublicInfo(ca))); err != nil {
+	if err := r.VaultAPI.DeletePolicy(ctx, core.PolicyName(common.GetPolicyNameForCertificateAuthorityPublicInfo(ca))); err != nil {
 		r.Recorder.Event(ca, "Warning", "ErrorDuringCADeletion", "Failed to delete ca policy from Vault.")
 		meta.SetStatusCondition(&ca.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to delete ca policy from Vault: %v", err),
 		})
 		return err
 	}
 
-	if err := r.VaultAPI.DeletePolicy(core.PolicyName(common.GetPolicyNameForCertificateAuthorityPrivateInfo(ca))); err != nil {
+	if err := r.VaultAPI.DeletePolicy(ctx, core.PolicyName(common.GetPolicyNameForCertificateAuthorityPrivateInfo(ca))); err != nil {
 		r.Recorder.Event(ca, "Warning", "ErrorDuringCADeletion", "Failed to delete ca policy from Vault.")
 		meta.SetStatusCondition(&ca.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to delete ca policy from Vault: %v", err),
 		})
 		return err
@@ -1,6 +1,7 @@
 package vaultcertificateauthority
 
 import (
+	"context"
 	"time"
 
 	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
@@ -10,7 +11,7 @@ import (
 	"github.com/youniqx/heist/pkg/vault/pki"
 )
 
-func (r *Reconciler) toVaultCAEntity(ca *heistv1alpha1.VaultCertificateAuthority) (pki.CAEntity, error) {
+func (r *Reconciler) toVaultCAEntity(ctx context.Context, ca *heistv1alpha1.VaultCertificateAuthority) (pki.CAEntity, error) {
 	path, err := ca.GetMountPath()
 	if err != nil {
 		return nil, err
@@ -18,12 +19,12 @@ func (r *Reconciler) toVaultCAEntity(ca *heistv1alpha1.VaultCertificateAuthority
 
 	var importedCert *pki.ImportedCert
 	if ca.Spec.Import != nil {
-		certificateBytes, err := r.VaultAPI.TransitDecrypt(managed.TransitEngine, managed.TransitKey, ca.Spec.Import.Certificate)
+		certificateBytes, err := r.VaultAPI.TransitDecrypt(ctx, managed.TransitEngine, managed.TransitKey, ca.Spec.Import.Certificate)
 		if err != nil {
 			return nil, err
 		}
 
-		privateKeyBytes, err := r.VaultAPI.TransitDecrypt(managed.TransitEngine, managed.TransitKey, ca.Spec.Import.PrivateKey)
+		privateKeyBytes, err := r.VaultAPI.TransitDecrypt(ctx, managed.TransitEngine, managed.TransitKey, ca.Spec.Import.PrivateKey)
 		if err != nil {
 			return nil, err
 		}
@@ -36,7 +36,7 @@ func (r *Reconciler) performUpdate(ctx context.Context, ca *heistv1alpha1.VaultC
 		meta.SetStatusCondition(&ca.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to find root ca: %v", err),
 		})
 		return common.Requeue, client.IgnoreNotFound(err)
@@ -48,29 +48,29 @@ func (r *Reconciler) performUpdate(ctx context.Context, ca *heistv1alpha1.VaultC
 		meta.SetStatusCondition(&ca.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Could not update CA to desired config: %v", err),
 		})
 		return common.Requeue, err
 	}
 
-	if err := r.persistCAData(ca, rootCA, info); err != nil {
+	if err := r.persistCAData(ctx, ca, rootCA, info); err != nil {
 		r.Recorder.Eventf(ca, "Warning", "FailedCAUpdate", "Failed to persist ca data for %s", ca.Name)
 		meta.SetStatusCondition(&ca.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to persist ca data: %v", err),
 		})
 		return common.Requeue, err
 	}
 
-	if err := r.updatePolicy(ca); err != nil {
+	if err := r.updatePolicy(ctx, ca); err != nil {
 		r.Recorder.Eventf(ca, "Warning", "FailedCAUpdate", "Failed to update policies for %s", ca.Name)
 		meta.SetStatusCondition(&ca.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to update policies: %v", err),
 		})
 		return common.Requeue, err
@@ -92,13 +92,13 @@ func (r *Reconciler) performUpdate(ctx context.Context, ca *heistv1alpha1.VaultC
 
 func (r *Reconciler) updateCAs(ctx context.Context, ca *heistv1alpha1.VaultCertificateAuthority) (*pki.CAInfo, error) {
 	if ca.Spec.Issuer == "" {
-		return r.updateRootCA(ca)
+		return r.updateRootCA(ctx, ca)
 	}
 
 	return r.updateIntermediateCA(ctx, ca)
 }
 
-func (r *Reconciler) updatePolicy(ca *heistv1alpha1.VaultCertificateAuthority) error {
+func (r *Reconciler) updatePolicy(ctx context.Context, ca *heistv1alpha1.VaultCertificateAuthority) error {
 	publicPolicy := &policy.Policy{
 		Name: common.GetPolicyNameForCertificateAuthorityPublicInfo(ca),
 		Rules: []*policy.Rule{
@@ -111,7 +111,7 @@ func (r *Reconciler) updatePolicy(ca *heistv1alpha1.VaultCertificateAuthority) e
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(publicPolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, publicPolicy); err != nil {
 		return err
 	}
 
@@ -127,22 +127,22 @@ func (r *Reconciler) updatePolicy(ca *heistv1alpha1.VaultCertificateAuthority) e
 		},
 	}
 
-	return r.VaultAPI.UpdatePolicy(privatePolicy)
+	return r.VaultAPI.UpdatePolicy(ctx, privatePolicy)
 }
 
-func (r *Reconciler) updateRootCA(ca *heistv1alpha1.VaultCertificateAuthority) (*pki.CAInfo, error) {
-	initialized, err := r.VaultAPI.IsPKIEngineInitialized(ca)
+func (r *Reconciler) updateRootCA(ctx context.Context, ca *heistv1alpha1.VaultCertificateAuthority) (*pki.CAInfo, error) {
+	initialized, err := r.VaultAPI.IsPKIEngineInitialized(ctx, ca)
 	if err != nil {
 		return nil, err
 	}
 
-	entity, err := r.toVaultCAEntity(ca)
+	entity, err := r.toVaultCAEntity(ctx, ca)
 	if err != nil {
 		return nil, err
 	}
 
 	if initialized {
-		return nil, r.VaultAPI.UpdateRootCA(entity)
+		return nil, r.VaultAPI.UpdateRootCA(ctx, entity)
 	}
 
 	mode := pki.ModeInternal
@@ -150,7 +150,7 @@ func (r *Reconciler) updateRootCA(ca *heistv1alpha1.VaultCertificateAuthority) (
 		mode = pki.ModeExported
 	}
 
-	info, err := r.VaultAPI.CreateRootCA(mode, entity)
+	info, err := r.VaultAPI.CreateRootCA(ctx, mode, entity)
 	if err != nil {
 		return nil, err
 	}
@@ -159,12 +159,12 @@ func (r *Reconciler) updateRootCA(ca *heistv1alpha1.VaultCertificateAuthority) (
 }
 
 func (r *Reconciler) updateIntermediateCA(ctx context.Context, ca *heistv1alpha1.VaultCertificateAuthority) (*pki.CAInfo, error) {
-	initialized, err := r.VaultAPI.IsPKIEngineInitialized(ca)
+	initialized, err := r.VaultAPI.IsPKIEngineInitialized(ctx, ca)
 	if err != nil {
 		return nil, err
 	}
 
-	entity, err := r.toVaultCAEntity(ca)
+	entity, err := r.toVaultCAEntity(ctx, ca)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +181,7 @@ func (r *Reconciler) updateIntermediateCA(ctx context.Context, ca *heistv1alpha1
 	}
 
 	if initialized {
-		return nil, r.VaultAPI.UpdateIntermediateCA(issuer, entity)
+		return nil, r.VaultAPI.UpdateIntermediateCA(ctx, issuer, entity)
 	}
 
 	mode := pki.ModeInternal
@@ -189,7 +189,7 @@ func (r *Reconciler) updateIntermediateCA(ctx context.Context, ca *heistv1alpha1
 		mode = pki.ModeExported
 	}
 
-	info, err := r.VaultAPI.CreateIntermediateCA(mode, issuer, entity)
+	info, err := r.VaultAPI.CreateIntermediateCA(ctx, mode, issuer, entity)
 	if err != nil {
 		return nil, err
 	}
@@ -197,14 +197,14 @@ func (r *Reconciler) updateIntermediateCA(ctx context.Context, ca *heistv1alpha1
 	return info, nil
 }
 
-func (r *Reconciler) persistCAData(ca *heistv1alpha1.VaultCertificateAuthority, rootCA *heistv1alpha1.VaultCertificateAuthority, info *pki.CAInfo) error {
+func (r *Reconciler) persistCAData(ctx context.Context, ca *heistv1alpha1.VaultCertificateAuthority, rootCA *heistv1alpha1.VaultCertificateAuthority, info *pki.CAInfo) error {
 	if info == nil {
 		return nil
 	}
 
-	if err := r.tryPersistingCAData(ca, rootCA, info); err != nil {
+	if err := r.tryPersistingCAData(ctx, ca, rootCA, info); err != nil {
 		r.Log.Info("Failed to persist CA data, rolling back changes to certificate authority")
-		if err := r.VaultAPI.DeleteEngine(ca); err != nil {
+		if err := r.VaultAPI.DeleteEngine(ctx, ca); err != nil {
 			return err
 		}
 	}
@@ -212,15 +212,15 @@ func (r *Reconciler) persistCAData(ca *heistv1alpha1.VaultCertificateAuthority,
 	return nil
 }
 
-func (r *Reconciler) tryPersistingCAData(ca *heistv1alpha1.VaultCertificateAuthority, rootCA *heistv1alpha1.VaultCertificateAuthority, info *pki.CAInfo) error {
-	if err := r.VaultAPI.UpdateKvEngine(common.InternalKvEngine); err != nil {
+func (r *Reconciler) tryPersistingCAData(ctx context.Context, ca *heistv1alpha1.VaultCertificateAuthority, rootCA *heistv1alpha1.VaultCertificateAuthority, info *pki.CAInfo) error {
+	if err := r.VaultAPI.UpdateKvEngine(ctx, common.InternalKvEngine); err != nil {
 		return err
 	}
 
 	var certificateChainPEM, fullCertificateChainPEM string
 
 	if rootCA != ca {
-		rootPEM, err := r.VaultAPI.ReadCACertificatePEM(rootCA)
+		rootPEM, err := r.VaultAPI.ReadCACertificatePEM(ctx, rootCA)
 		if err != nil {
 			return err
 		}
@@ -242,7 +242,7 @@ func (r *Reconciler) tryPersistingCAData(ca *heistv1alpha1.VaultCertificateAutho
 		},
 	}
 
-	if err := r.VaultAPI.UpdateKvSecret(common.InternalKvEngine, publicSecret); err != nil {
+	if err := r.VaultAPI.UpdateKvSecret(ctx, common.InternalKvEngine, publicSecret); err != nil {
 		return err
 	}
 
@@ -254,7 +254,7 @@ func (r *Reconciler) tryPersistingCAData(ca *heistv1alpha1.VaultCertificateAutho
 		},
 	}
 
-	return r.VaultAPI.UpdateKvSecret(common.InternalKvEngine, privateSecret)
+	return r.VaultAPI.UpdateKvSecret(ctx, common.InternalKvEngine, privateSecret)
 }
 
 func (r *Reconciler) attachFinalizer(ctx context.Context, ca *heistv1alpha1.VaultCertificateAuthority) error {
@@ -41,11 +41,12 @@ import (
 // Reconciler reconciles a VaultCertificateRole object.
 type Reconciler struct {
 	client.Client
-	Log         logr.Logger
-	Scheme      *runtime.Scheme
-	VaultAPI    vault.API
-	Recorder    record.EventRecorder
-	EventFilter predicate.Predicate
+	Log                logr.Logger
+	Scheme             *runtime.Scheme
+	VaultAPI           vault.API
+	Recorder           record.EventRecorder
+	EventFilter        predicate.Predicate
+	DriftCheckInterval time.Duration
 }
 
 // +kubebuilder:rbac:groups=heist.youniqx.com,resources=vaultcertificateroles,verbs=get;list;watch;create;update;patch;delete
@@ -55,6 +56,9 @@ type Reconciler struct {
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := common.StartReconcileSpan(ctx, "VaultCertificateRole", req)
+	defer span.End()
+
 	log := r.Log.WithValues("vaultcertificaterole", req.NamespacedName)
 	log.Info("reconciling for vault certificate")
 
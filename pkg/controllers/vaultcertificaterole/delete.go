@@ -33,12 +33,12 @@ func (r *Reconciler) finalizeCertificate(ctx context.Context, certificate *heist
 }
 
 func (r *Reconciler) deleteCertificate(ctx context.Context, cert *heistv1alpha1.VaultCertificateRole) error {
-	if err := r.VaultAPI.DeletePolicy(core.PolicyName(common.GetPolicyNameForCertificateIssuing(cert))); err != nil {
+	if err := r.VaultAPI.DeletePolicy(ctx, core.PolicyName(common.GetPolicyNameForCertificateIssuing(cert))); err != nil {
 		r.Recorder.Event(cert, "Warning", "ErrorDuringCertDeletion", "Failed to delete certificate policies from Vault")
 		meta.SetStatusCondition(&cert.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: "Failed to delete policies",
 		})
 		return err
@@ -51,12 +51,12 @@ func (r *Reconciler) deleteCertificate(ctx context.Context, cert *heistv1alpha1.
 		},
 	}
 
-	if err := r.VaultAPI.DeleteCertificateRole(issuer, cert); err != nil {
+	if err := r.VaultAPI.DeleteCertificateRole(ctx, issuer, cert); err != nil {
 		r.Recorder.Event(cert, "Warning", "ErrorDuringCertDeletion", "Failed to delete certificate role from Vault")
 		meta.SetStatusCondition(&cert.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: "Failed to delete certificate role",
 		})
 		return err
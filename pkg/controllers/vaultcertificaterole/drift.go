@@ -0,0 +1,69 @@
+package vaultcertificaterole
+
+import (
+	"context"
+	"errors"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/pki"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// checkDrift compares the role in Vault with the desired state and updates the
+// Drifted condition. The comparison only takes place if the current spec has
+// already been applied or is held back by a reported drift. It returns false
+// if the role must not be updated because it has drifted and the drift is only
+// reported.
+func (r *Reconciler) checkDrift(ctx context.Context, issuer *heistv1alpha1.VaultCertificateAuthority, cert *heistv1alpha1.VaultCertificateRole) (bool, error) {
+	policy := cert.GetDriftPolicy()
+	if policy == heistv1alpha1.DriftPolicyIgnore {
+		meta.RemoveStatusCondition(&cert.Status.Conditions, heistv1alpha1.Conditions.Types.Drifted)
+		return true, nil
+	}
+
+	if !common.IsDriftCheckDue(cert.Status.Conditions, cert.Generation) {
+		return true, nil
+	}
+
+	drift, err := r.detectDrift(ctx, issuer, cert)
+	if err != nil {
+		return false, err
+	}
+
+	repair := common.UpdateDriftCondition(r.Recorder, cert, &cert.Status.Conditions, policy, drift)
+
+	return len(drift) == 0 || repair, nil
+}
+
+// detectDrift returns the names of all settings of the role which differ
+// between Vault and the desired state.
+func (r *Reconciler) detectDrift(ctx context.Context, issuer *heistv1alpha1.VaultCertificateAuthority, cert *heistv1alpha1.VaultCertificateRole) ([]string, error) {
+	actual, err := r.VaultAPI.ReadCertificateRole(ctx, issuer, cert)
+	switch {
+	case errors.Is(err, core.ErrDoesNotExist), errors.Is(err, core.ErrNotFound):
+		return []string{"role has been deleted"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return diffCertificateRole(cert, actual)
+}
+
+func diffCertificateRole(cert *heistv1alpha1.VaultCertificateRole, actual *pki.CertificateRole) ([]string, error) {
+	settings, err := cert.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := cert.GetSubject()
+	if err != nil {
+		return nil, err
+	}
+
+	drift := common.DiffFields(settings, actual.Settings)
+	drift = append(drift, common.DiffFields(subject, actual.Subject)...)
+
+	return drift, nil
+}
@@ -45,23 +45,38 @@ func (r *Reconciler) updateCertificate(ctx context.Context, cert *heistv1alpha1.
 		return common.Requeue, client.IgnoreNotFound(err)
 	}
 
-	if err := r.VaultAPI.UpdateCertificateRole(issuer, cert); err != nil {
+	switch update, err := r.checkDrift(ctx, issuer, cert); {
+	case err != nil:
+		r.Recorder.Eventf(cert, "Warning", "DriftDetectionFailed", "Failed to compare certificate role %s with its state in Vault", cert.Name)
+		meta.SetStatusCondition(&cert.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  common.VaultErrorReason(err),
+			Message: "CertificateRole could not be read from Vault",
+		})
+		return common.Requeue, err
+	case !update:
+		common.SetDriftBlockedCondition(&cert.Status.Conditions, cert.Generation, "CertificateRole has been modified in Vault outside of Heist and is not updated until the drift has been resolved")
+		return common.RequeueForDriftCheck(ctrl.Result{}, cert.GetDriftPolicy(), r.DriftCheckInterval), nil
+	}
+
+	if err := r.VaultAPI.UpdateCertificateRole(ctx, issuer, cert); err != nil {
 		r.Recorder.Eventf(cert, "Warning", "CertificateRoleError", "Failed to update certificate role %s", cert.Name)
 		meta.SetStatusCondition(&cert.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: "CertificateRole could not be created",
 		})
 		return common.Requeue, client.IgnoreNotFound(err)
 	}
 
-	if err := r.updatePolicy(issuer, cert); err != nil {
+	if err := r.updatePolicy(ctx, issuer, cert); err != nil {
 		r.Recorder.Eventf(cert, "Warning", "PolicyUpdateFailed", "Failed to creates policies for certificate role %s", cert.Name)
 		meta.SetStatusCondition(&cert.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: "Policies for certificate role could not be created",
 		})
 		return common.Requeue, client.IgnoreNotFound(err)
@@ -72,16 +87,17 @@ func (r *Reconciler) updateCertificate(ctx context.Context, cert *heistv1alpha1.
 	}
 
 	meta.SetStatusCondition(&cert.Status.Conditions, metav1.Condition{
-		Type:    heistv1alpha1.Conditions.Types.Provisioned,
-		Status:  metav1.ConditionTrue,
-		Reason:  heistv1alpha1.Conditions.Reasons.Provisioned,
-		Message: "CertificateRole has been provisioned",
+		Type:               heistv1alpha1.Conditions.Types.Provisioned,
+		Status:             metav1.ConditionTrue,
+		Reason:             heistv1alpha1.Conditions.Reasons.Provisioned,
+		Message:            "CertificateRole has been provisioned",
+		ObservedGeneration: cert.Generation,
 	})
 
-	return ctrl.Result{}, nil
+	return common.RequeueForDriftCheck(ctrl.Result{}, cert.GetDriftPolicy(), r.DriftCheckInterval), nil
 }
 
-func (r *Reconciler) updatePolicy(issuer *heistv1alpha1.VaultCertificateAuthority, cert *heistv1alpha1.VaultCertificateRole) error {
+func (r *Reconciler) updatePolicy(ctx context.Context, issuer *heistv1alpha1.VaultCertificateAuthority, cert *heistv1alpha1.VaultCertificateRole) error {
 	issuerPath, err := issuer.GetMountPath()
 	if err != nil {
 		return err
@@ -104,7 +120,7 @@ func (r *Reconciler) updatePolicy(issuer *heistv1alpha1.VaultCertificateAuthorit
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(issuePolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, issuePolicy); err != nil {
 		return err
 	}
 
@@ -120,7 +136,7 @@ func (r *Reconciler) updatePolicy(issuer *heistv1alpha1.VaultCertificateAuthorit
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(signCsrPolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, signCsrPolicy); err != nil {
 		return err
 	}
 
@@ -136,7 +152,7 @@ func (r *Reconciler) updatePolicy(issuer *heistv1alpha1.VaultCertificateAuthorit
 		},
 	}
 
-	return r.VaultAPI.UpdatePolicy(signVerbatimPolicy)
+	return r.VaultAPI.UpdatePolicy(ctx, signVerbatimPolicy)
 }
 
 func (r *Reconciler) attachFinalizer(ctx context.Context, certificate *heistv1alpha1.VaultCertificateRole) error {
@@ -25,6 +25,7 @@ import (
 	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
 	"github.com/youniqx/heist/pkg/controllers/common"
 	"github.com/youniqx/heist/pkg/vault"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,16 +42,19 @@ import (
 // Reconciler reconciles a VaultKVSecret object.
 type Reconciler struct {
 	client.Client
-	Log         logr.Logger
-	Scheme      *runtime.Scheme
-	VaultAPI    vault.API
-	Recorder    record.EventRecorder
-	EventFilter predicate.Predicate
+	Log                logr.Logger
+	Scheme             *runtime.Scheme
+	VaultAPI           vault.API
+	Recorder           record.EventRecorder
+	EventFilter        predicate.Predicate
+	DriftCheckInterval time.Duration
 }
 
 // +kubebuilder:rbac:groups=heist.youniqx.com,resources=vaultkvsecrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=heist.youniqx.com,resources=vaultkvsecrets/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=heist.youniqx.com,resources=vaultkvsecrets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=heist.youniqx.com,resources=vaultpasswordpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -62,6 +66,9 @@ type Reconciler struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.7.0/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := common.StartReconcileSpan(ctx, "VaultKVSecret", req)
+	defer span.End()
+
 	log := r.Log.WithValues("vaultkvsecret", req.NamespacedName)
 	log.Info("reconciling for vault secret")
 
@@ -85,7 +92,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
-	if deep.Equal(previous.Finalizers, secret.Finalizers) != nil {
+	if deep.Equal(previous.Finalizers, secret.Finalizers) != nil || deep.Equal(previous.Annotations, secret.Annotations) != nil {
 		if err := r.Update(ctx, secret); err != nil {
 			return common.Requeue, err
 		}
@@ -107,7 +114,7 @@ func setDefaultConditions(secret *heistv1alpha1.VaultKVSecret) {
 
 func (r *Reconciler) performReconciliation(ctx context.Context, secret *heistv1alpha1.VaultKVSecret) (ctrl.Result, error) {
 	if secret.GetDeletionTimestamp() != nil {
-		return r.finalizeSecret(secret)
+		return r.finalizeSecret(ctx, secret)
 	}
 
 	return r.updateSecret(ctx, secret)
@@ -153,9 +160,79 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 				return nil
 			}),
 		).
+		Watches(
+			&heistv1alpha1.VaultPasswordPolicy{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
+				policy, ok := object.(*heistv1alpha1.VaultPasswordPolicy)
+				if !ok {
+					return nil
+				}
+
+				secrets := heistv1alpha1.VaultKVSecretList{}
+				if err := mgr.GetClient().List(ctx, &secrets, &client.ListOptions{Namespace: policy.Namespace}); err != nil {
+					return nil
+				}
+
+				var requests []reconcile.Request
+				for i := range secrets.Items {
+					secret := &secrets.Items[i]
+					if !usesPasswordPolicy(secret, policy.Name) {
+						continue
+					}
+
+					requests = append(requests, reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Name:      secret.Name,
+							Namespace: secret.Namespace,
+						},
+					})
+				}
+				return requests
+			}),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
+				source, ok := object.(*corev1.Secret)
+				if !ok {
+					return nil
+				}
+
+				secrets := heistv1alpha1.VaultKVSecretList{}
+				if err := mgr.GetClient().List(ctx, &secrets, &client.ListOptions{Namespace: source.Namespace}); err != nil {
+					return nil
+				}
+
+				var requests []reconcile.Request
+				for i := range secrets.Items {
+					secret := &secrets.Items[i]
+					if !usesSecret(secret, source.Name) {
+						continue
+					}
+
+					requests = append(requests, reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Name:      secret.Name,
+							Namespace: secret.Namespace,
+						},
+					})
+				}
+				return requests
+			}),
+		).
 		Complete(r)
 }
 
+func usesPasswordPolicy(secret *heistv1alpha1.VaultKVSecret, name string) bool {
+	for _, field := range secret.Spec.Fields {
+		if field.AutoGenerated && field.PasswordPolicy == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r *Reconciler) getEngineForSecret(ctx context.Context, secret *heistv1alpha1.VaultKVSecret) (*heistv1alpha1.VaultKVSecretEngine, error) {
 	engine := &heistv1alpha1.VaultKVSecretEngine{
 		ObjectMeta: metav1.ObjectMeta{
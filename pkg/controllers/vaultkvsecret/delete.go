@@ -1,6 +1,7 @@
 package vaultkvsecret
 
 import (
+	"context"
 	"fmt"
 
 	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
@@ -11,7 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-func (r *Reconciler) finalizeSecret(secret *heistv1alpha1.VaultKVSecret) (ctrl.Result, error) {
+func (r *Reconciler) finalizeSecret(ctx context.Context, secret *heistv1alpha1.VaultKVSecret) (ctrl.Result, error) {
 	meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
 		Type:    heistv1alpha1.Conditions.Types.Provisioned,
 		Status:  metav1.ConditionFalse,
@@ -20,7 +21,7 @@ func (r *Reconciler) finalizeSecret(secret *heistv1alpha1.VaultKVSecret) (ctrl.R
 	})
 
 	if controllerutil.ContainsFinalizer(secret, common.YouniqxFinalizer) {
-		if err := r.applyFinalizerSecret(secret); err != nil {
+		if err := r.applyFinalizerSecret(ctx, secret); err != nil {
 			return common.Requeue, err
 		}
 	}
@@ -28,7 +29,7 @@ func (r *Reconciler) finalizeSecret(secret *heistv1alpha1.VaultKVSecret) (ctrl.R
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) applyFinalizerSecret(secret *heistv1alpha1.VaultKVSecret) error {
+func (r *Reconciler) applyFinalizerSecret(ctx context.Context, secret *heistv1alpha1.VaultKVSecret) error {
 	meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
 		Type:    heistv1alpha1.Conditions.Types.Provisioned,
 		Status:  metav1.ConditionFalse,
@@ -36,35 +37,35 @@ func (r *Reconciler) applyFinalizerSecret(secret *heistv1alpha1.VaultKVSecret) e
 		Message: "secret is being deleted",
 	})
 
-	currentState, err := r.determineCurrentState(secret)
+	currentState, err := r.determineCurrentState(ctx, secret)
 	if err != nil {
 		r.Recorder.Event(secret, "Warning", "CurrentStateResolutionFailed", "Failed to determine current state of secret")
 		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to determine current state of secret: %v", err),
 		})
 		return err
 	}
 
-	if err := r.VaultAPI.DeletePolicy(currentState.Policy); err != nil {
+	if err := r.VaultAPI.DeletePolicy(ctx, currentState.Policy); err != nil {
 		r.Recorder.Event(secret, "Warning", "ErrorDuringPolicyDeletion", "Failed to delete policy for secret from Vault.")
 		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to delete policy for secret from Vault: %v", err),
 		})
 		return err
 	}
 
-	if err := r.VaultAPI.DeleteKvSecret(currentState.Engine, currentState.Secret); err != nil {
+	if err := r.VaultAPI.DeleteKvSecret(ctx, currentState.Engine, currentState.Secret); err != nil {
 		r.Recorder.Eventf(secret, "Warning", "ErrorDuringDeletion", "Failed to delete the secret %s from engine %s", secret.Name, secret.Spec.Engine)
 		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to delete the secret: %v", err),
 		})
 
@@ -0,0 +1,75 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+// checkDrift compares the secret in Vault with the state Heist has last
+// written. Drift is overwritten with the Enforce policy or if the
+// overwrite-conflicts annotation is set, otherwise the names of the fields
+// which have been changed in Vault are returned and the secret must not be
+// written.
+func (r *Reconciler) checkDrift(ctx context.Context, secret *heistv1alpha1.VaultKVSecret, desired *deployedSecret, current *deployedSecret) ([]string, error) {
+	policy := secret.GetDriftPolicy()
+	if policy == heistv1alpha1.DriftPolicyIgnore {
+		return nil, nil
+	}
+
+	if !current.Provisioned || desired.Engine != current.Engine || desired.Secret.Path != current.Secret.Path {
+		return nil, nil
+	}
+
+	drift, err := r.detectDrift(ctx, current)
+	if err != nil || len(drift) == 0 {
+		return nil, err
+	}
+
+	sort.Strings(drift)
+
+	if value, _ := common.GetAnnotationValue(secret, heistv1alpha1.AnnotationOverwriteConflicts); value == "true" || policy == heistv1alpha1.DriftPolicyEnforce {
+		r.Recorder.Eventf(secret, "Warning", "DriftRepaired", "Secret %s has been modified in Vault outside of Heist, overwriting changes to %s", secret.Name, strings.Join(drift, ", "))
+		desired.Overwrite = true
+		return nil, nil
+	}
+
+	return drift, nil
+}
+
+// detectDrift returns the names of all fields whose values in Vault differ
+// from the values Heist has last written.
+func (r *Reconciler) detectDrift(ctx context.Context, current *deployedSecret) ([]string, error) {
+	actual, err := r.VaultAPI.ReadKvSecret(ctx, current.Engine, current.Secret)
+	switch {
+	case errors.Is(err, core.ErrDoesNotExist), errors.Is(err, core.ErrNotFound):
+		return []string{"secret has been deleted"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return diffSecretFields(current.Secret.Fields, actual.Fields), nil
+}
+
+func diffSecretFields(expected map[string]string, actual map[string]string) []string {
+	var drift []string
+
+	for name, value := range expected {
+		if actualValue, ok := actual[name]; !ok || actualValue != value {
+			drift = append(drift, name)
+		}
+	}
+
+	for name := range actual {
+		if _, ok := expected[name]; !ok {
+			drift = append(drift, name)
+		}
+	}
+
+	return drift
+}
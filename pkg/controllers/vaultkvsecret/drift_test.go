@@ -0,0 +1,132 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+	"github.com/youniqx/heist/pkg/vault/kvsecret"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+func Test_diffSecretFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected map[string]string
+		actual   map[string]string
+		want     []string
+	}{
+		{
+			name:     "should not detect drift of unchanged secret",
+			expected: map[string]string{"username": "admin", "password": "secret"},
+			actual:   map[string]string{"username": "admin", "password": "secret"},
+			want:     nil,
+		},
+		{
+			name:     "should detect changed fields",
+			expected: map[string]string{"username": "admin", "password": "secret"},
+			actual:   map[string]string{"username": "admin", "password": "changed"},
+			want:     []string{"password"},
+		},
+		{
+			name:     "should detect removed fields",
+			expected: map[string]string{"username": "admin", "password": "secret"},
+			actual:   map[string]string{"username": "admin"},
+			want:     []string{"password"},
+		},
+		{
+			name:     "should detect added fields",
+			expected: map[string]string{"username": "admin"},
+			actual:   map[string]string{"username": "admin", "token": "value"},
+			want:     []string{"token"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffSecretFields(tt.expected, tt.actual)
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffSecretFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconciler_updateSecret_drift(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       heistv1alpha1.DriftPolicy
+		annotations  map[string]string
+		modify       bool
+		wantValue    string
+		wantReason   string
+		wantConflict bool
+	}{
+		{
+			name:       "should roll out spec change if secret has not drifted",
+			wantValue:  "new-password",
+			wantReason: heistv1alpha1.Conditions.Reasons.Provisioned,
+		},
+		{
+			name:         "should report drift as conflict and block spec change by default",
+			modify:       true,
+			wantValue:    "changed-in-vault",
+			wantReason:   heistv1alpha1.Conditions.Reasons.Conflict,
+			wantConflict: true,
+		},
+		{
+			name:        "should overwrite reported drift if overwrite annotation is set",
+			annotations: map[string]string{heistv1alpha1.AnnotationOverwriteConflicts: "true"},
+			modify:      true,
+			wantValue:   "new-password",
+			wantReason:  heistv1alpha1.Conditions.Reasons.Provisioned,
+		},
+		{
+			name:       "should overwrite drift if drift is enforced",
+			policy:     heistv1alpha1.DriftPolicyEnforce,
+			modify:     true,
+			wantValue:  "new-password",
+			wantReason: heistv1alpha1.Conditions.Reasons.Provisioned,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r, api, engine := newTestReconciler(t, kvengine.VersionV2)
+			secret := newProvisionedTestSecret(t, api, engine, "old-password")
+			secret.Annotations = tt.annotations
+			secret.Spec.DriftPolicy = tt.policy
+			secret.Spec.Fields["password"].CipherText = heistv1alpha1.EncryptedValue(encryptTestValue(t, api, "new-password"))
+
+			if tt.modify {
+				if _, err := api.UpdateKvSecretCAS(ctx, engine, &kvsecret.KvSecret{Path: "app", Fields: map[string]string{"password": "changed-in-vault"}}, 1); err != nil {
+					t.Fatalf("UpdateKvSecretCAS() error = %v", err)
+				}
+			}
+
+			if _, err := r.updateSecret(ctx, secret); err != nil {
+				t.Fatalf("updateSecret() error = %v", err)
+			}
+
+			if fields, _ := api.KvSecret(secret.Status.Engine, "app"); fields["password"] != tt.wantValue {
+				t.Errorf("updateSecret() wrote %s to Vault, want %s", fields["password"], tt.wantValue)
+			}
+
+			provisioned := meta.FindStatusCondition(secret.Status.Conditions, heistv1alpha1.Conditions.Types.Provisioned)
+			if provisioned == nil || provisioned.Reason != tt.wantReason {
+				t.Errorf("updateSecret() Provisioned condition = %+v, want reason %s", provisioned, tt.wantReason)
+			}
+
+			if got := meta.IsStatusConditionTrue(secret.Status.Conditions, heistv1alpha1.Conditions.Types.Conflict); got != tt.wantConflict {
+				t.Errorf("updateSecret() Conflict condition = %v, want %v", got, tt.wantConflict)
+			}
+
+			if meta.FindStatusCondition(secret.Status.Conditions, heistv1alpha1.Conditions.Types.Drifted) != nil {
+				t.Errorf("updateSecret() set the Drifted condition, want drift to be reported as conflict")
+			}
+		})
+	}
+}
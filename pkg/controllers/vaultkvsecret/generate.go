@@ -0,0 +1,114 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	"github.com/youniqx/heist/pkg/erx"
+	"github.com/youniqx/heist/pkg/vault"
+	"github.com/youniqx/heist/pkg/vault/passwordpolicy"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var ErrPasswordPolicyUnavailable = erx.New("VaultKVSecret", "password policy unavailable")
+
+// stringGenerator generates random strings for auto generated fields. Values
+// are generated using the password generator of Policy if set, otherwise a
+// random string is generated.
+type stringGenerator struct {
+	API    vault.API
+	Length int
+	Policy passwordpolicy.Entity
+	// Temporary is set if Policy only exists in Vault while values are
+	// generated from it.
+	Temporary bool
+}
+
+func (r *Reconciler) getStringGenerator(ctx context.Context, secret *heistv1alpha1.VaultKVSecret, name string, field *heistv1alpha1.VaultKVSecretField) (*stringGenerator, error) {
+	length := field.AutoGeneratedLength
+	if length <= 0 {
+		length = heistv1alpha1.DefaultAutoGeneratedLength
+	}
+
+	switch {
+	case field.PasswordPolicy != "":
+		policy, err := r.getPasswordPolicyForField(ctx, secret, name, field)
+		if err != nil {
+			return nil, err
+		}
+
+		return &stringGenerator{
+			API:    r.VaultAPI,
+			Length: policy.Spec.Length,
+			Policy: policy,
+		}, nil
+	case len(field.CharsetRules) > 0:
+		return &stringGenerator{
+			API:       r.VaultAPI,
+			Length:    length,
+			Policy:    heistv1alpha1.ToPasswordPolicy(common.GetPasswordPolicyNameForSecret(secret), length, field.CharsetRules),
+			Temporary: true,
+		}, nil
+	default:
+		return &stringGenerator{
+			API:    r.VaultAPI,
+			Length: length,
+		}, nil
+	}
+}
+
+func (r *Reconciler) getPasswordPolicyForField(ctx context.Context, secret *heistv1alpha1.VaultKVSecret, name string, field *heistv1alpha1.VaultKVSecretField) (*heistv1alpha1.VaultPasswordPolicy, error) {
+	policy := &heistv1alpha1.VaultPasswordPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: secret.Namespace,
+			Name:      field.PasswordPolicy,
+		},
+	}
+
+	if err := r.Get(ctx, client.ObjectKeyFromObject(policy), policy); err != nil {
+		return nil, ErrPasswordPolicyUnavailable.
+			WithDetails(fmt.Sprintf("password policy %s referenced by field %s could not be fetched", field.PasswordPolicy, name)).
+			WithCause(err)
+	}
+
+	if !meta.IsStatusConditionTrue(policy.Status.Conditions, heistv1alpha1.Conditions.Types.Provisioned) {
+		return nil, ErrPasswordPolicyUnavailable.
+			WithDetails(fmt.Sprintf("password policy %s referenced by field %s has not been provisioned yet", field.PasswordPolicy, name))
+	}
+
+	return policy, nil
+}
+
+func (g *stringGenerator) IsValid(value string) bool {
+	return utf8.RuneCountInString(value) == g.Length
+}
+
+func (g *stringGenerator) Generate(ctx context.Context) (value string, err error) {
+	if g.Policy == nil {
+		return g.API.GenerateRandomString(ctx, g.Length)
+	}
+
+	if !g.Temporary {
+		return g.API.GeneratePassword(ctx, g.Policy)
+	}
+
+	if err := g.API.UpdatePasswordPolicy(ctx, g.Policy); err != nil {
+		return "", err
+	}
+
+	// The temporary policy has to be removed again even if generating the
+	// value failed, otherwise it is left behind in Vault.
+	defer func() {
+		if deleteErr := g.API.DeletePasswordPolicy(ctx, g.Policy); deleteErr != nil {
+			value, err = "", errors.Join(err, deleteErr)
+		}
+	}()
+
+	return g.API.GeneratePassword(ctx, g.Policy)
+}
@@ -0,0 +1,144 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	"github.com/youniqx/heist/pkg/vault/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStringGenerator_Generate(t *testing.T) {
+	secret := &heistv1alpha1.VaultKVSecret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"},
+	}
+
+	tests := []struct {
+		name    string
+		field   *heistv1alpha1.VaultKVSecretField
+		length  int
+		charset string
+	}{
+		{
+			name:   "should generate random string by default",
+			field:  &heistv1alpha1.VaultKVSecretField{AutoGenerated: true},
+			length: heistv1alpha1.DefaultAutoGeneratedLength,
+		},
+		{
+			name: "should generate value from charset rules",
+			field: &heistv1alpha1.VaultKVSecretField{
+				AutoGenerated:       true,
+				AutoGeneratedLength: 16,
+				CharsetRules: []heistv1alpha1.VaultPasswordPolicyRule{
+					{Charset: "abcdef"},
+					{Charset: "0123456789", MinChars: 2},
+				},
+			},
+			length:  16,
+			charset: "abcdef0123456789",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			r := &Reconciler{VaultAPI: api}
+
+			generator, err := r.getStringGenerator(context.Background(), secret, "password", tt.field)
+			if err != nil {
+				t.Fatalf("getStringGenerator() error = %v", err)
+			}
+
+			value, err := generator.Generate(context.Background())
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if len(value) != tt.length {
+				t.Errorf("Generate() = %s, want length %d", value, tt.length)
+			}
+
+			if tt.charset != "" && strings.Trim(value, tt.charset) != "" {
+				t.Errorf("Generate() = %s, want only characters of %s", value, tt.charset)
+			}
+
+			if !generator.IsValid(value) {
+				t.Errorf("IsValid() = false for generated value %s", value)
+			}
+
+			if _, ok := api.PasswordPolicy(common.GetPasswordPolicyNameForSecret(secret)); ok {
+				t.Errorf("temporary password policy has not been deleted")
+			}
+		})
+	}
+}
+
+func TestStringGenerator_Generate_deletesTemporaryPolicyOnError(t *testing.T) {
+	secret := &heistv1alpha1.VaultKVSecret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"},
+	}
+	field := &heistv1alpha1.VaultKVSecretField{
+		AutoGenerated: true,
+		CharsetRules:  []heistv1alpha1.VaultPasswordPolicyRule{{Charset: "abcdef"}},
+	}
+	generateError := errors.New("generate failed")
+	deleteError := errors.New("delete failed")
+
+	tests := []struct {
+		name       string
+		faults     map[string]error
+		wantErrors []error
+		wantPolicy bool
+	}{
+		{
+			name:       "should delete policy if generating the value fails",
+			faults:     map[string]error{"GeneratePassword": generateError},
+			wantErrors: []error{generateError},
+			wantPolicy: false,
+		},
+		{
+			name:       "should return error if deleting the policy fails",
+			faults:     map[string]error{"DeletePasswordPolicy": deleteError},
+			wantErrors: []error{deleteError},
+			wantPolicy: true,
+		},
+		{
+			name:       "should return both errors if generating the value and deleting the policy fail",
+			faults:     map[string]error{"GeneratePassword": generateError, "DeletePasswordPolicy": deleteError},
+			wantErrors: []error{generateError, deleteError},
+			wantPolicy: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			for method, err := range tt.faults {
+				api.InjectError(method, err)
+			}
+			r := &Reconciler{VaultAPI: api}
+
+			generator, err := r.getStringGenerator(context.Background(), secret, "password", field)
+			if err != nil {
+				t.Fatalf("getStringGenerator() error = %v", err)
+			}
+
+			value, err := generator.Generate(context.Background())
+			if value != "" {
+				t.Errorf("Generate() = %s, want empty value", value)
+			}
+
+			for _, want := range tt.wantErrors {
+				if !errors.Is(err, want) {
+					t.Errorf("Generate() error = %v, want %v", err, want)
+				}
+			}
+
+			if _, ok := api.PasswordPolicy(common.GetPasswordPolicyNameForSecret(secret)); ok != tt.wantPolicy {
+				t.Errorf("password policy exists = %v, want %v", ok, tt.wantPolicy)
+			}
+		})
+	}
+}
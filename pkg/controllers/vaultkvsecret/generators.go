@@ -0,0 +1,272 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/erx"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/ssh"
+)
+
+// ErrHashSourceTooLong is returned if the value of the source field of a hash
+// field is longer than bcrypt supports.
+var ErrHashSourceTooLong = erx.New("VaultKVSecret", "hash source too long")
+
+const (
+	pemTypePrivateKey = "PRIVATE KEY"
+	pemTypePublicKey  = "PUBLIC KEY"
+)
+
+// fieldGenerator generates the values of auto generated fields.
+type fieldGenerator interface {
+	// IsValid returns true if an existing value still matches the
+	// configuration of the field and can be kept.
+	IsValid(value string) bool
+	// Generate generates a new value for the field.
+	Generate(ctx context.Context) (string, error)
+}
+
+// getFieldGenerator returns the generator of an auto generated field. Derived
+// fields are generated from the value of their source field, which has to be
+// present in plainTextFields already.
+func (r *Reconciler) getFieldGenerator(ctx context.Context, secret *heistv1alpha1.VaultKVSecret, name string, field *heistv1alpha1.VaultKVSecretField, plainTextFields map[string]string) (fieldGenerator, error) {
+	switch generator := field.GetGenerator(); generator {
+	case heistv1alpha1.FieldGeneratorString:
+		return r.getStringGenerator(ctx, secret, name, field)
+	case heistv1alpha1.FieldGeneratorUUID:
+		return &uuidGenerator{}, nil
+	case heistv1alpha1.FieldGeneratorRSA, heistv1alpha1.FieldGeneratorECDSA, heistv1alpha1.FieldGeneratorEd25519, heistv1alpha1.FieldGeneratorSSH:
+		return &keyGenerator{
+			Generator: generator,
+			KeyBits:   getKeyBits(generator, field.KeyBits),
+		}, nil
+	case heistv1alpha1.FieldGeneratorBcrypt, heistv1alpha1.FieldGeneratorHtpasswd:
+		source, ok := plainTextFields[field.Source]
+		if !ok {
+			return nil, fmt.Errorf("field %s is derived from field %s which does not exist", name, field.Source)
+		}
+
+		if len(source) > heistv1alpha1.MaxHashSourceLength {
+			return nil, ErrHashSourceTooLong.WithDetails(fmt.Sprintf("field %s is derived from field %s whose value is longer than the %d bytes supported by bcrypt", name, field.Source, heistv1alpha1.MaxHashSourceLength))
+		}
+
+		hash := &hashGenerator{Source: source}
+		if generator == heistv1alpha1.FieldGeneratorHtpasswd {
+			hash.Username = field.Username
+		}
+
+		return hash, nil
+	default:
+		return nil, fmt.Errorf("field %s uses unknown generator %s", name, generator)
+	}
+}
+
+// uuidGenerator generates random version 4 UUIDs.
+type uuidGenerator struct{}
+
+func (g *uuidGenerator) IsValid(value string) bool {
+	_, err := uuid.Parse(value)
+	return err == nil
+}
+
+func (g *uuidGenerator) Generate(_ context.Context) (string, error) {
+	value, err := uuid.NewRandom()
+	if err != nil {
+		return "", err
+	}
+
+	return value.String(), nil
+}
+
+// keyGenerator generates private keys. The matching public key is derived
+// using getPublicKey.
+type keyGenerator struct {
+	Generator heistv1alpha1.FieldGenerator
+	KeyBits   int
+}
+
+func getKeyBits(generator heistv1alpha1.FieldGenerator, keyBits int) int {
+	switch {
+	case keyBits != 0:
+		return keyBits
+	case generator == heistv1alpha1.FieldGeneratorRSA:
+		return heistv1alpha1.DefaultRSAKeyBits
+	case generator == heistv1alpha1.FieldGeneratorECDSA:
+		return heistv1alpha1.DefaultECDSAKeyBits
+	default:
+		return 0
+	}
+}
+
+func (g *keyGenerator) IsValid(value string) bool {
+	key, err := parsePrivateKey(g.Generator, value)
+	if err != nil {
+		return false
+	}
+
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return g.Generator == heistv1alpha1.FieldGeneratorRSA && key.N.BitLen() == g.KeyBits
+	case *ecdsa.PrivateKey:
+		return g.Generator == heistv1alpha1.FieldGeneratorECDSA && key.Curve.Params().BitSize == g.KeyBits
+	case ed25519.PrivateKey:
+		return g.Generator == heistv1alpha1.FieldGeneratorEd25519 || g.Generator == heistv1alpha1.FieldGeneratorSSH
+	default:
+		return false
+	}
+}
+
+func (g *keyGenerator) Generate(_ context.Context) (string, error) {
+	var key crypto.Signer
+	var err error
+
+	switch g.Generator {
+	case heistv1alpha1.FieldGeneratorRSA:
+		key, err = rsa.GenerateKey(rand.Reader, g.KeyBits)
+	case heistv1alpha1.FieldGeneratorECDSA:
+		var curve elliptic.Curve
+		curve, err = getCurve(g.KeyBits)
+		if err == nil {
+			key, err = ecdsa.GenerateKey(curve, rand.Reader)
+		}
+	case heistv1alpha1.FieldGeneratorEd25519, heistv1alpha1.FieldGeneratorSSH:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return "", fmt.Errorf("generator %s does not generate keys", g.Generator)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if g.Generator == heistv1alpha1.FieldGeneratorSSH {
+		block, err := ssh.MarshalPrivateKey(key, "")
+		if err != nil {
+			return "", err
+		}
+		return string(pem.EncodeToMemory(block)), nil
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypePrivateKey, Bytes: der})), nil
+}
+
+func getCurve(keyBits int) (elliptic.Curve, error) {
+	switch keyBits {
+	case 256:
+		return elliptic.P256(), nil
+	case 384:
+		return elliptic.P384(), nil
+	case 521:
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unsupported ECDSA curve size %d", keyBits)
+	}
+}
+
+func parsePrivateKey(generator heistv1alpha1.FieldGenerator, value string) (crypto.Signer, error) {
+	if generator == heistv1alpha1.FieldGeneratorSSH {
+		key, err := ssh.ParseRawPrivateKey([]byte(value))
+		if err != nil {
+			return nil, err
+		}
+
+		switch key := key.(type) {
+		case *ed25519.PrivateKey:
+			return *key, nil
+		case crypto.Signer:
+			return key, nil
+		default:
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+	}
+
+	block, _ := pem.Decode([]byte(value))
+	if block == nil || block.Type != pemTypePrivateKey {
+		return nil, fmt.Errorf("value does not contain a PEM encoded private key")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
+
+// getPublicKey returns the public key matching a private key generated by a
+// key pair generator. SSH public keys are returned in the authorized_keys
+// format, all others as PEM encoded PKIX public keys.
+func getPublicKey(generator heistv1alpha1.FieldGenerator, privateKey string) (string, error) {
+	key, err := parsePrivateKey(generator, privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	if generator == heistv1alpha1.FieldGeneratorSSH {
+		publicKey, err := ssh.NewPublicKey(key.Public())
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(publicKey)), "\n"), nil
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypePublicKey, Bytes: der})), nil
+}
+
+// hashGenerator generates bcrypt hashes of the value of a source field,
+// prefixed by the user name for htpasswd entries.
+type hashGenerator struct {
+	Source   string
+	Username string
+}
+
+func (g *hashGenerator) IsValid(value string) bool {
+	if g.Username != "" {
+		username, hash, ok := strings.Cut(value, ":")
+		if !ok || username != g.Username {
+			return false
+		}
+		value = hash
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(value), []byte(g.Source)) == nil
+}
+
+func (g *hashGenerator) Generate(_ context.Context) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(g.Source), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	if g.Username != "" {
+		return fmt.Sprintf("%s:%s", g.Username, hash), nil
+	}
+
+	return string(hash), nil
+}
@@ -1,15 +1,23 @@
 package vaultkvsecret
 
-func (r *Reconciler) performVaultReconciliation(desired *deployedSecret, current *deployedSecret) error {
-	if err := r.performVaultPolicyReconciliation(desired, current); err != nil {
+import (
+	"context"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	"github.com/youniqx/heist/pkg/vault/kvsecret"
+)
+
+func (r *Reconciler) performVaultReconciliation(ctx context.Context, secret *heistv1alpha1.VaultKVSecret, desired *deployedSecret, current *deployedSecret) error {
+	if err := r.performVaultPolicyReconciliation(ctx, desired, current); err != nil {
 		return err
 	}
 
-	err := r.performVaultKVSecretReconciliation(desired, current)
+	err := r.performVaultKVSecretReconciliation(ctx, secret, desired, current)
 	return err
 }
 
-func (r *Reconciler) performVaultKVSecretReconciliation(desired *deployedSecret, current *deployedSecret) error {
+func (r *Reconciler) performVaultKVSecretReconciliation(ctx context.Context, secret *heistv1alpha1.VaultKVSecret, desired *deployedSecret, current *deployedSecret) error {
 	var deleteCurrent bool
 	if current.Provisioned {
 		if desired.Engine != current.Engine {
@@ -20,16 +28,39 @@ func (r *Reconciler) performVaultKVSecretReconciliation(desired *deployedSecret,
 	}
 
 	if deleteCurrent {
-		if err := r.VaultAPI.DeleteKvSecret(current.Engine, current.Secret); err != nil {
+		if err := r.VaultAPI.DeleteKvSecret(ctx, current.Engine, current.Secret); err != nil {
 			return err
 		}
 	}
 
-	err := r.VaultAPI.UpdateKvSecret(desired.Engine, desired.Secret)
-	return err
+	expectedVersion := getExpectedVersion(secret, desired, current, deleteCurrent)
+
+	version, err := r.VaultAPI.UpdateKvSecretCAS(ctx, desired.Engine, desired.Secret, expectedVersion)
+	if err != nil {
+		return err
+	}
+
+	desired.Version = version
+
+	return nil
+}
+
+// getExpectedVersion returns the version the secret should have in Vault. The
+// version is only checked once Heist has written the secret to its current
+// location, so secrets which are provisioned for the first time are adopted.
+func getExpectedVersion(secret *heistv1alpha1.VaultKVSecret, desired *deployedSecret, current *deployedSecret, deleteCurrent bool) int {
+	if value, _ := common.GetAnnotationValue(secret, heistv1alpha1.AnnotationOverwriteConflicts); value == "true" || desired.Overwrite {
+		return kvsecret.AnyVersion
+	}
+
+	if !current.Provisioned || deleteCurrent || secret.Status.WrittenVersion == 0 {
+		return kvsecret.AnyVersion
+	}
+
+	return secret.Status.WrittenVersion
 }
 
-func (r *Reconciler) performVaultPolicyReconciliation(desired *deployedSecret, current *deployedSecret) error {
+func (r *Reconciler) performVaultPolicyReconciliation(ctx context.Context, desired *deployedSecret, current *deployedSecret) error {
 	var deleteCurrent bool
 	if current.Provisioned {
 		if desired.Policy.Name != current.Policy.Name {
@@ -38,11 +69,11 @@ func (r *Reconciler) performVaultPolicyReconciliation(desired *deployedSecret, c
 	}
 
 	if deleteCurrent {
-		if err := r.VaultAPI.DeletePolicy(current.Policy); err != nil {
+		if err := r.VaultAPI.DeletePolicy(ctx, current.Policy); err != nil {
 			return err
 		}
 	}
 
-	err := r.VaultAPI.UpdatePolicy(desired.Policy)
+	err := r.VaultAPI.UpdatePolicy(ctx, desired.Policy)
 	return err
 }
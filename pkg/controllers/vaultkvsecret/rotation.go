@@ -0,0 +1,82 @@
+package vaultkvsecret
+
+import (
+	"strings"
+	"time"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const rotateAllFields = "*"
+
+// shouldRotate determines if a new value has to be generated for an auto
+// generated field, either because the rotation has been requested using the
+// rotate fields annotation or because its rotation period has elapsed.
+func shouldRotate(secret *heistv1alpha1.VaultKVSecret, name string, field *heistv1alpha1.VaultKVSecretField, now time.Time) bool {
+	return isRotationRequested(secret, name) || isRotationDue(field, secret.Status.LastRotated[name], now)
+}
+
+func isRotationRequested(secret *heistv1alpha1.VaultKVSecret, name string) bool {
+	value, ok := common.GetAnnotationValue(secret, heistv1alpha1.AnnotationRotateFields)
+	if !ok {
+		return false
+	}
+
+	for _, requested := range strings.Split(value, ",") {
+		if requested = strings.TrimSpace(requested); requested == rotateAllFields || requested == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isRotationDue(field *heistv1alpha1.VaultKVSecretField, lastRotated metav1.Time, now time.Time) bool {
+	if field.RotationPeriod.Duration <= 0 || lastRotated.IsZero() {
+		return false
+	}
+
+	return !now.Before(lastRotated.Add(field.RotationPeriod.Duration))
+}
+
+// getLastRotation returns the time the value of the field has last been
+// generated. Values generated before rotation times were recorded are
+// considered to have been generated now.
+func getLastRotation(secret *heistv1alpha1.VaultKVSecret, name string, now metav1.Time) metav1.Time {
+	if lastRotated, ok := secret.Status.LastRotated[name]; ok && !lastRotated.IsZero() {
+		return lastRotated
+	}
+
+	return now
+}
+
+// requeueForRotation returns a result which requeues the secret once the
+// rotation period of the next auto generated field elapses.
+func requeueForRotation(secret *heistv1alpha1.VaultKVSecret, now time.Time) ctrl.Result {
+	var next time.Duration
+
+	for name, field := range secret.Spec.Fields {
+		if !field.AutoGenerated || field.RotationPeriod.Duration <= 0 {
+			continue
+		}
+
+		lastRotated, ok := secret.Status.LastRotated[name]
+		if !ok {
+			continue
+		}
+
+		remaining := lastRotated.Add(field.RotationPeriod.Duration).Sub(now)
+		if remaining <= 0 {
+			remaining = time.Second
+		}
+
+		if next == 0 || remaining < next {
+			next = remaining
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: next}
+}
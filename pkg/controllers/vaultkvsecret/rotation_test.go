@@ -0,0 +1,104 @@
+package vaultkvsecret
+
+import (
+	"testing"
+	"time"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_isRotationRequested(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		field       string
+		want        bool
+	}{
+		{name: "should not rotate without annotation", annotations: nil, field: "password", want: false},
+		{name: "should rotate listed field", annotations: map[string]string{heistv1alpha1.AnnotationRotateFields: "username, password"}, field: "password", want: true},
+		{name: "should not rotate unlisted field", annotations: map[string]string{heistv1alpha1.AnnotationRotateFields: "username"}, field: "password", want: false},
+		{name: "should rotate all fields", annotations: map[string]string{heistv1alpha1.AnnotationRotateFields: "*"}, field: "password", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &heistv1alpha1.VaultKVSecret{ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations}}
+			if got := isRotationRequested(secret, tt.field); got != tt.want {
+				t.Errorf("isRotationRequested() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isRotationDue(t *testing.T) {
+	now := time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		period      time.Duration
+		lastRotated metav1.Time
+		want        bool
+	}{
+		{name: "should not rotate without period", period: 0, lastRotated: metav1.NewTime(now.Add(-1000 * time.Hour)), want: false},
+		{name: "should not rotate without previous rotation", period: time.Hour, lastRotated: metav1.Time{}, want: false},
+		{name: "should not rotate before period elapsed", period: time.Hour, lastRotated: metav1.NewTime(now.Add(-59 * time.Minute)), want: false},
+		{name: "should rotate once period elapsed", period: time.Hour, lastRotated: metav1.NewTime(now.Add(-time.Hour)), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := &heistv1alpha1.VaultKVSecretField{AutoGenerated: true, RotationPeriod: metav1.Duration{Duration: tt.period}}
+			if got := isRotationDue(field, tt.lastRotated, now); got != tt.want {
+				t.Errorf("isRotationDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requeueForRotation(t *testing.T) {
+	now := time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		fields map[string]*heistv1alpha1.VaultKVSecretField
+		want   time.Duration
+	}{
+		{
+			name: "should not requeue without rotated fields",
+			fields: map[string]*heistv1alpha1.VaultKVSecretField{
+				"a": {AutoGenerated: true},
+			},
+			want: 0,
+		},
+		{
+			name: "should requeue for the next rotation",
+			fields: map[string]*heistv1alpha1.VaultKVSecretField{
+				"a": {AutoGenerated: true, RotationPeriod: metav1.Duration{Duration: 3 * time.Hour}},
+				"b": {AutoGenerated: true, RotationPeriod: metav1.Duration{Duration: 2 * time.Hour}},
+			},
+			want: time.Hour,
+		},
+		{
+			name: "should requeue soon for overdue rotations",
+			fields: map[string]*heistv1alpha1.VaultKVSecretField{
+				"a": {AutoGenerated: true, RotationPeriod: metav1.Duration{Duration: 30 * time.Minute}},
+			},
+			want: time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &heistv1alpha1.VaultKVSecret{
+				Spec: heistv1alpha1.VaultKVSecretSpec{Fields: tt.fields},
+				Status: heistv1alpha1.VaultKVSecretStatus{
+					LastRotated: map[string]metav1.Time{
+						"a": metav1.NewTime(now.Add(-time.Hour)),
+						"b": metav1.NewTime(now.Add(-time.Hour)),
+					},
+				},
+			}
+			if got := requeueForRotation(secret, now); got.RequeueAfter != tt.want {
+				t.Errorf("requeueForRotation() = %v, want %v", got.RequeueAfter, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"fmt"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/erx"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var ErrSourceSecretUnavailable = erx.New("VaultKVSecret", "source secret unavailable")
+
+// getValueFromSecret reads the value of a field from the Kubernetes Secret
+// referenced by its ValueFrom configuration.
+func (r *Reconciler) getValueFromSecret(ctx context.Context, secret *heistv1alpha1.VaultKVSecret, name string, field *heistv1alpha1.VaultKVSecretField) (string, error) {
+	ref := field.ValueFrom.SecretKeyRef
+	if ref == nil {
+		return "", ErrSourceSecretUnavailable.WithDetails(fmt.Sprintf("field %s does not reference a secret", name))
+	}
+
+	source := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: secret.Namespace, Name: ref.Name}, source); err != nil {
+		return "", ErrSourceSecretUnavailable.
+			WithDetails(fmt.Sprintf("secret %s referenced by field %s could not be fetched", ref.Name, name)).
+			WithCause(err)
+	}
+
+	value, ok := source.Data[ref.Key]
+	if !ok {
+		return "", ErrSourceSecretUnavailable.
+			WithDetails(fmt.Sprintf("secret %s referenced by field %s does not contain key %s", ref.Name, name, ref.Key))
+	}
+
+	return string(value), nil
+}
+
+func usesSecret(secret *heistv1alpha1.VaultKVSecret, name string) bool {
+	for _, field := range secret.Spec.Fields {
+		if field.ValueFrom != nil && field.ValueFrom.SecretKeyRef != nil && field.ValueFrom.SecretKeyRef.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
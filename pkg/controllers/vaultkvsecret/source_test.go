@@ -0,0 +1,85 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconciler_getValueFromSecret(t *testing.T) {
+	secret := &heistv1alpha1.VaultKVSecret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "credentials"},
+		Data:       map[string][]byte{"password": []byte("some-password")},
+	}
+
+	tests := []struct {
+		name    string
+		ref     *corev1.SecretKeySelector
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "should read value of key",
+			ref:  &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "password"},
+			want: "some-password",
+		},
+		{
+			name:    "should fail for missing key",
+			ref:     &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "username"},
+			wantErr: true,
+		},
+		{
+			name:    "should fail for missing secret",
+			ref:     &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "password"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{Client: fakeclient.NewClientBuilder().WithObjects(source).Build()}
+			field := &heistv1alpha1.VaultKVSecretField{ValueFrom: &heistv1alpha1.VaultKVSecretFieldSource{SecretKeyRef: tt.ref}}
+
+			got, err := r.getValueFromSecret(context.Background(), secret, "password", field)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getValueFromSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, ErrSourceSecretUnavailable) {
+				t.Errorf("getValueFromSecret() error = %v, want %v", err, ErrSourceSecretUnavailable)
+			}
+
+			if got != tt.want {
+				t.Errorf("getValueFromSecret() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_usesSecret(t *testing.T) {
+	secret := &heistv1alpha1.VaultKVSecret{
+		Spec: heistv1alpha1.VaultKVSecretSpec{
+			Fields: map[string]*heistv1alpha1.VaultKVSecretField{
+				"generated": {AutoGenerated: true},
+				"password": {ValueFrom: &heistv1alpha1.VaultKVSecretFieldSource{
+					SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "credentials"}, Key: "password"},
+				}},
+			},
+		},
+	}
+
+	if !usesSecret(secret, "credentials") {
+		t.Errorf("usesSecret() = false for referenced secret")
+	}
+
+	if usesSecret(secret, "other") {
+		t.Errorf("usesSecret() = true for unreferenced secret")
+	}
+}
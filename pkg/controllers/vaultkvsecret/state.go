@@ -1,16 +1,18 @@
 package vaultkvsecret
 
 import (
+	"context"
 	"fmt"
-	"path/filepath"
 
 	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
 	"github.com/youniqx/heist/pkg/controllers/common"
 	"github.com/youniqx/heist/pkg/erx"
 	"github.com/youniqx/heist/pkg/managed"
 	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
 	"github.com/youniqx/heist/pkg/vault/kvsecret"
 	"github.com/youniqx/heist/pkg/vault/policy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var ErrDecryptFailed = erx.New("VaultKVSecret", "decrypt failed")
@@ -20,16 +22,23 @@ type deployedSecret struct {
 	Secret          *kvsecret.KvSecret
 	EncryptedFields map[string]string
 	Engine          core.MountPath
+	EngineVersion   kvengine.Version
 	Policy          *policy.Policy
+	Version         int
+	LastRotated     map[string]metav1.Time
+	RotatedFields   []string
+	// Overwrite is set if changes made in Vault outside of Heist are
+	// overwritten when writing the secret.
+	Overwrite bool
 }
 
-func (r *Reconciler) determineState(engine *heistv1alpha1.VaultKVSecretEngine, secret *heistv1alpha1.VaultKVSecret) (desired *deployedSecret, current *deployedSecret, err error) {
-	desired, err = r.determineDesiredState(engine, secret)
+func (r *Reconciler) determineState(ctx context.Context, engine *heistv1alpha1.VaultKVSecretEngine, secret *heistv1alpha1.VaultKVSecret) (desired *deployedSecret, current *deployedSecret, err error) {
+	desired, err = r.determineDesiredState(ctx, engine, secret)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	current, err = r.determineCurrentState(secret)
+	current, err = r.determineCurrentState(ctx, secret)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -37,11 +46,11 @@ func (r *Reconciler) determineState(engine *heistv1alpha1.VaultKVSecretEngine, s
 	return desired, current, nil
 }
 
-func (r *Reconciler) determineCurrentState(secret *heistv1alpha1.VaultKVSecret) (*deployedSecret, error) {
+func (r *Reconciler) determineCurrentState(ctx context.Context, secret *heistv1alpha1.VaultKVSecret) (*deployedSecret, error) {
 	plainTextFields := make(map[string]string)
 	encryptedFields := make(map[string]string)
 	for name, cipherText := range secret.Status.Fields {
-		plainTextBytes, err := r.VaultAPI.TransitDecrypt(managed.TransitEngine, managed.TransitKey, cipherText)
+		plainTextBytes, err := r.VaultAPI.TransitDecrypt(ctx, managed.TransitEngine, managed.TransitKey, cipherText)
 		if err != nil {
 			return nil, err
 		}
@@ -49,6 +58,11 @@ func (r *Reconciler) determineCurrentState(secret *heistv1alpha1.VaultKVSecret)
 		encryptedFields[name] = cipherText
 	}
 
+	engineVersion := secret.Status.EngineVersion
+	if engineVersion == "" {
+		engineVersion = kvengine.VersionV2
+	}
+
 	result := &deployedSecret{
 		Provisioned: secret.Status.Engine != "",
 		Secret: &kvsecret.KvSecret{
@@ -57,11 +71,12 @@ func (r *Reconciler) determineCurrentState(secret *heistv1alpha1.VaultKVSecret)
 		},
 		EncryptedFields: encryptedFields,
 		Engine:          core.MountPath(secret.Status.Engine),
+		EngineVersion:   engineVersion,
 		Policy: &policy.Policy{
 			Name: fmt.Sprintf("managed.kv.%s.%s", secret.Namespace, secret.Name),
 			Rules: []*policy.Rule{
 				{
-					Path: filepath.Join(secret.Status.Engine, "data", secret.Status.Path),
+					Path: kvsecret.GetPolicyPath(engineVersion, secret.Status.Engine, secret.Status.Path),
 					Capabilities: []policy.Capability{
 						policy.ReadCapability,
 					},
@@ -73,12 +88,23 @@ func (r *Reconciler) determineCurrentState(secret *heistv1alpha1.VaultKVSecret)
 	return result, nil
 }
 
-func (r *Reconciler) determineDesiredState(engine *heistv1alpha1.VaultKVSecretEngine, secret *heistv1alpha1.VaultKVSecret) (*deployedSecret, error) {
+func (r *Reconciler) determineDesiredState(ctx context.Context, engine *heistv1alpha1.VaultKVSecretEngine, secret *heistv1alpha1.VaultKVSecret) (*deployedSecret, error) {
 	plainTextFields := make(map[string]string)
 	encryptedFields := make(map[string]string)
-	for name, field := range secret.Spec.Fields {
-		if err := r.determineDesiredStateForField(plainTextFields, encryptedFields, name, field, secret); err != nil {
-			return nil, err
+	rotation := &fieldRotation{
+		Now:         metav1.Now(),
+		LastRotated: make(map[string]metav1.Time),
+	}
+	// Derived fields are determined last, as they require the value of their
+	// source field.
+	for _, derived := range []bool{false, true} {
+		for name, field := range secret.Spec.Fields {
+			if (field.AutoGenerated && field.IsDerived()) != derived {
+				continue
+			}
+			if err := r.determineDesiredStateForField(ctx, plainTextFields, encryptedFields, rotation, name, field, secret); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -92,18 +118,26 @@ func (r *Reconciler) determineDesiredState(engine *heistv1alpha1.VaultKVSecretEn
 		return nil, err
 	}
 
+	engineVersion, err := engine.GetKvEngineVersion()
+	if err != nil {
+		return nil, err
+	}
+
 	result := &deployedSecret{
 		Secret: &kvsecret.KvSecret{
 			Path:   secretPath,
 			Fields: plainTextFields,
 		},
 		EncryptedFields: encryptedFields,
+		LastRotated:     rotation.LastRotated,
+		RotatedFields:   rotation.RotatedFields,
 		Engine:          core.MountPath(mountPath),
+		EngineVersion:   engineVersion,
 		Policy: &policy.Policy{
 			Name: common.GetPolicyNameForSecret(secret),
 			Rules: []*policy.Rule{
 				{
-					Path: filepath.Join(mountPath, "data", secretPath),
+					Path: kvsecret.GetPolicyPath(engineVersion, mountPath, secretPath),
 					Capabilities: []policy.Capability{
 						policy.ReadCapability,
 					},
@@ -115,51 +149,116 @@ func (r *Reconciler) determineDesiredState(engine *heistv1alpha1.VaultKVSecretEn
 	return result, nil
 }
 
-func (r *Reconciler) determineDesiredStateForField(plainTextFields map[string]string, encryptedFields map[string]string, name string, field *heistv1alpha1.VaultKVSecretField, secret *heistv1alpha1.VaultKVSecret) error {
+// fieldRotation collects the generation times of auto generated fields while
+// determining the desired state.
+type fieldRotation struct {
+	Now           metav1.Time
+	LastRotated   map[string]metav1.Time
+	RotatedFields []string
+}
+
+func (r *Reconciler) determineDesiredStateForField(ctx context.Context, plainTextFields map[string]string, encryptedFields map[string]string, rotation *fieldRotation, name string, field *heistv1alpha1.VaultKVSecretField, secret *heistv1alpha1.VaultKVSecret) error {
 	switch {
+	case field.ValueFrom != nil:
+		plainText, err := r.getValueFromSecret(ctx, secret, name, field)
+		if err != nil {
+			return err
+		}
+
+		cipherText, err := r.encryptValue(ctx, secret, name, plainText)
+		if err != nil {
+			return err
+		}
+		plainTextFields[name] = plainText
+		encryptedFields[name] = cipherText
 	case field.CipherText != "":
-		plainTextBytes, err := r.VaultAPI.TransitDecrypt(managed.TransitEngine, managed.TransitKey, string(field.CipherText))
+		plainTextBytes, err := r.VaultAPI.TransitDecrypt(ctx, managed.TransitEngine, managed.TransitKey, string(field.CipherText))
 		if err != nil {
 			return ErrDecryptFailed.WithDetails(fmt.Sprintf("failed to decrypt cipher text in field %s", name)).WithCause(err)
 		}
 		plainTextFields[name] = string(plainTextBytes)
 		encryptedFields[name] = string(field.CipherText)
 	case field.AutoGenerated:
-		existingCipherText := secret.Status.Fields[name]
-
-		var desiredLength int
-		if field.AutoGeneratedLength <= 0 {
-			desiredLength = 64
-		} else {
-			desiredLength = field.AutoGeneratedLength
+		plainText, cipherText, err := r.determineGeneratedValue(ctx, rotation, name, field, secret, plainTextFields)
+		if err != nil {
+			return err
 		}
+		plainTextFields[name] = plainText
+		encryptedFields[name] = cipherText
 
-		if existingCipherText != "" {
-			plainTextBytes, err := r.VaultAPI.TransitDecrypt(managed.TransitEngine, managed.TransitKey, existingCipherText)
-			if err != nil {
-				return err
-			}
-			plainText := string(plainTextBytes)
-			if len(plainText) == desiredLength {
-				plainTextFields[name] = plainText
-				encryptedFields[name] = existingCipherText
-				return nil
-			}
+		if !field.IsKeyPair() {
+			return nil
 		}
 
-		plainText, err := r.VaultAPI.GenerateRandomString(desiredLength)
+		publicKeyField := field.GetPublicKeyField(name)
+		publicKey, err := getPublicKey(field.GetGenerator(), plainText)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to derive public key of field %s: %w", name, err)
 		}
-		cipherText, err := r.VaultAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte(plainText))
+
+		cipherText, err = r.encryptValue(ctx, secret, publicKeyField, publicKey)
 		if err != nil {
 			return err
 		}
-		plainTextFields[name] = plainText
-		encryptedFields[name] = cipherText
+		plainTextFields[publicKeyField] = publicKey
+		encryptedFields[publicKeyField] = cipherText
 	default:
 		return fmt.Errorf("field %s is in an unreconcilable state", name)
 	}
 
 	return nil
 }
+
+// determineGeneratedValue returns the plain and cipher text of an auto
+// generated field. The existing value is kept unless it no longer matches the
+// configuration of the field or the field has to be rotated.
+func (r *Reconciler) determineGeneratedValue(ctx context.Context, rotation *fieldRotation, name string, field *heistv1alpha1.VaultKVSecretField, secret *heistv1alpha1.VaultKVSecret, plainTextFields map[string]string) (plainText string, cipherText string, err error) {
+	generator, err := r.getFieldGenerator(ctx, secret, name, field, plainTextFields)
+	if err != nil {
+		return "", "", err
+	}
+
+	if existingCipherText := secret.Status.Fields[name]; existingCipherText != "" {
+		plainTextBytes, err := r.VaultAPI.TransitDecrypt(ctx, managed.TransitEngine, managed.TransitKey, existingCipherText)
+		if err != nil {
+			return "", "", err
+		}
+		plainText := string(plainTextBytes)
+		if generator.IsValid(plainText) && !shouldRotate(secret, name, field, rotation.Now.Time) {
+			rotation.LastRotated[name] = getLastRotation(secret, name, rotation.Now)
+			return plainText, existingCipherText, nil
+		}
+
+		rotation.RotatedFields = append(rotation.RotatedFields, name)
+	}
+
+	plainText, err = generator.Generate(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	cipherText, err = r.VaultAPI.TransitEncrypt(ctx, managed.TransitEngine, managed.TransitKey, []byte(plainText))
+	if err != nil {
+		return "", "", err
+	}
+	rotation.LastRotated[name] = rotation.Now
+
+	return plainText, cipherText, nil
+}
+
+// encryptValue encrypts the value of a field which is neither generated nor
+// configured as cipher text, like the public key of a key pair. The cipher text stored in the status is
+// reused if it still decrypts to the same value, so unchanged fields do not
+// cause updates of the status.
+func (r *Reconciler) encryptValue(ctx context.Context, secret *heistv1alpha1.VaultKVSecret, name string, plainText string) (string, error) {
+	if existingCipherText := secret.Status.Fields[name]; existingCipherText != "" {
+		plainTextBytes, err := r.VaultAPI.TransitDecrypt(ctx, managed.TransitEngine, managed.TransitKey, existingCipherText)
+		if err != nil {
+			return "", err
+		}
+		if string(plainTextBytes) == plainText {
+			return existingCipherText, nil
+		}
+	}
+
+	return r.VaultAPI.TransitEncrypt(ctx, managed.TransitEngine, managed.TransitKey, []byte(plainText))
+}
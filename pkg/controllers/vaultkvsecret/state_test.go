@@ -0,0 +1,41 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"testing"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+)
+
+func TestReconciler_determineCurrentState_policyPath(t *testing.T) {
+	tests := []struct {
+		name          string
+		engineVersion kvengine.Version
+		want          string
+	}{
+		{name: "should use data path for v2 engines", engineVersion: kvengine.VersionV2, want: "kv/data/app/config"},
+		{name: "should use secret path for v1 engines", engineVersion: kvengine.VersionV1, want: "kv/app/config"},
+		{name: "should treat secrets without recorded version as v2", engineVersion: "", want: "kv/data/app/config"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &heistv1alpha1.VaultKVSecret{
+				Status: heistv1alpha1.VaultKVSecretStatus{
+					Engine:        "kv",
+					EngineVersion: tt.engineVersion,
+					Path:          "app/config",
+				},
+			}
+
+			current, err := (&Reconciler{}).determineCurrentState(context.Background(), secret)
+			if err != nil {
+				t.Fatalf("determineCurrentState() error = %v", err)
+			}
+
+			if got := current.Policy.Rules[0].Path; got != tt.want {
+				t.Errorf("policy path = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
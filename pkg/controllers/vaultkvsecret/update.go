@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
 	"github.com/youniqx/heist/pkg/controllers/common"
+	"github.com/youniqx/heist/pkg/vault/kvsecret"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -41,14 +45,44 @@ func (r *Reconciler) updateSecret(ctx context.Context, secret *heistv1alpha1.Vau
 	r.attachFinalizer(secret)
 
 	decryptError := ErrDecryptFailed.Copy()
-	desired, current, err := r.determineState(engine, secret)
+	passwordPolicyError := ErrPasswordPolicyUnavailable.Copy()
+	sourceSecretError := ErrSourceSecretUnavailable.Copy()
+	hashSourceError := ErrHashSourceTooLong.Copy()
+	desired, current, err := r.determineState(ctx, engine, secret)
 	switch {
+	case errors.Is(err, ErrSourceSecretUnavailable) && errors.As(err, &sourceSecretError):
+		r.Recorder.Event(secret, "Warning", "SourceSecretUnavailable", sourceSecretError.GetDetails())
+		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  heistv1alpha1.Conditions.Reasons.ErrorConfig,
+			Message: sourceSecretError.GetDetails(),
+		})
+		return common.Requeue, err
+	case errors.Is(err, ErrPasswordPolicyUnavailable) && errors.As(err, &passwordPolicyError):
+		r.Recorder.Event(secret, "Warning", "PasswordPolicyUnavailable", passwordPolicyError.GetDetails())
+		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  heistv1alpha1.Conditions.Reasons.ErrorConfig,
+			Message: passwordPolicyError.GetDetails(),
+		})
+		return common.Requeue, err
+	case errors.Is(err, ErrHashSourceTooLong) && errors.As(err, &hashSourceError):
+		r.Recorder.Event(secret, "Warning", "HashSourceTooLong", hashSourceError.GetDetails())
+		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  heistv1alpha1.Conditions.Reasons.ErrorConfig,
+			Message: hashSourceError.GetDetails(),
+		})
+		return common.Requeue, err
 	case errors.As(err, &decryptError):
 		r.Recorder.Event(secret, "Warning", "DecryptFailed", decryptError.GetDetails())
 		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: decryptError.GetDetails(),
 		})
 		return common.Requeue, err
@@ -57,26 +91,105 @@ func (r *Reconciler) updateSecret(ctx context.Context, secret *heistv1alpha1.Vau
 		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to diff current and desired secret state: %v", err),
 		})
 		return common.Requeue, err
 	}
 
-	if err := r.performVaultReconciliation(desired, current); err != nil {
+	switch drift, err := r.checkDrift(ctx, secret, desired, current); {
+	case err != nil:
+		r.Recorder.Eventf(secret, "Warning", "DriftDetectionFailed", "Failed to compare secret %s with its state in Vault", secret.Name)
+		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  common.VaultErrorReason(err),
+			Message: fmt.Sprintf("Failed to read secret from Vault: %v", err),
+		})
+		return common.Requeue, err
+	case len(drift) > 0:
+		r.setConflictConditions(secret, fmt.Sprintf("fields %s differ", strings.Join(drift, ", ")))
+		return common.RequeueForDriftCheck(ctrl.Result{}, secret.GetDriftPolicy(), r.DriftCheckInterval), nil
+	}
+
+	casConflictError := kvsecret.ErrCASConflict.Copy()
+	switch err := r.performVaultReconciliation(ctx, secret, desired, current); {
+	case errors.Is(err, kvsecret.ErrCASConflict) && errors.As(err, &casConflictError):
+		r.setConflictConditions(secret, casConflictError.GetDetails())
+		return common.Requeue, err
+	case err != nil:
 		r.Recorder.Eventf(secret, "Warning", "VaultReconciliationFailed", "Failed to roll out changes for secret %s to Vault", secret.Name)
 		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to apply changes in Vault: %v", err),
 		})
 		return common.Requeue, err
 	}
 
+	meta.RemoveStatusCondition(&secret.Status.Conditions, heistv1alpha1.Conditions.Types.Conflict)
+	if _, ok := common.GetAnnotationValue(secret, heistv1alpha1.AnnotationOverwriteConflicts); ok {
+		r.Recorder.Eventf(secret, "Normal", "ConflictOverwritten", "Secret %s has been overwritten in Vault", secret.Name)
+		delete(secret.Annotations, heistv1alpha1.AnnotationOverwriteConflicts)
+	}
+
+	if len(desired.RotatedFields) > 0 {
+		sort.Strings(desired.RotatedFields)
+		r.Recorder.Eventf(secret, "Normal", "FieldsRotated", "Generated new values for fields %s of secret %s", strings.Join(desired.RotatedFields, ", "), secret.Name)
+	}
+
+	if _, ok := common.GetAnnotationValue(secret, heistv1alpha1.AnnotationRotateFields); ok {
+		delete(secret.Annotations, heistv1alpha1.AnnotationRotateFields)
+	}
+
+	pinnedVersionError := ErrPinnedVersionUnavailable.Copy()
+	switch err := r.updateVersionsInSecret(ctx, engine, secret, desired); {
+	case errors.Is(err, ErrPinnedVersionUnavailable) && errors.As(err, &pinnedVersionError):
+		r.Recorder.Event(secret, "Warning", "PinnedVersionUnavailable", pinnedVersionError.GetDetails())
+		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  heistv1alpha1.Conditions.Reasons.ErrorConfig,
+			Message: pinnedVersionError.GetDetails(),
+		})
+		return common.Requeue, err
+	case err != nil:
+		r.Recorder.Eventf(secret, "Warning", "VersionLookupFailed", "Failed to read the versions of secret %s from Vault", secret.Name)
+		meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  common.VaultErrorReason(err),
+			Message: fmt.Sprintf("Failed to read secret versions: %v", err),
+		})
+		return common.Requeue, err
+	}
+
 	r.updateCurrentStateInSecret(secret, desired)
 
-	return ctrl.Result{}, nil
+	return common.RequeueForDriftCheck(requeueForRotation(secret, time.Now()), secret.GetDriftPolicy(), r.DriftCheckInterval), nil
+}
+
+// setConflictConditions marks the secret as not provisioned because it has
+// been modified in Vault outside of Heist and must not be overwritten.
+func (r *Reconciler) setConflictConditions(secret *heistv1alpha1.VaultKVSecret, details string) {
+	message := fmt.Sprintf("Secret has been modified in Vault outside of Heist, set the %s annotation to true to overwrite it: %s", heistv1alpha1.AnnotationOverwriteConflicts, details)
+	if condition := meta.FindStatusCondition(secret.Status.Conditions, heistv1alpha1.Conditions.Types.Conflict); condition == nil || condition.Message != message {
+		r.Recorder.Eventf(secret, "Warning", "ConflictDetected", "Secret %s has been modified in Vault outside of Heist", secret.Name)
+	}
+
+	meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
+		Type:    heistv1alpha1.Conditions.Types.Conflict,
+		Status:  metav1.ConditionTrue,
+		Reason:  heistv1alpha1.Conditions.Reasons.Conflict,
+		Message: message,
+	})
+	meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
+		Type:    heistv1alpha1.Conditions.Types.Provisioned,
+		Status:  metav1.ConditionFalse,
+		Reason:  heistv1alpha1.Conditions.Reasons.Conflict,
+		Message: "Secret has been modified in Vault outside of Heist",
+	})
 }
 
 func (r *Reconciler) updateCurrentStateInSecret(secret *heistv1alpha1.VaultKVSecret, newState *deployedSecret) {
@@ -86,8 +199,16 @@ func (r *Reconciler) updateCurrentStateInSecret(secret *heistv1alpha1.VaultKVSec
 
 	secret.Status.ReadOnlyPolicyName = newState.Policy.Name
 	secret.Status.Engine = string(newState.Engine)
+	secret.Status.EngineVersion = newState.EngineVersion
 	secret.Status.Path = newState.Secret.Path
 	secret.Status.Fields = newState.EncryptedFields
+	secret.Status.WrittenVersion = newState.Version
+
+	if len(newState.LastRotated) > 0 {
+		secret.Status.LastRotated = newState.LastRotated
+	} else {
+		secret.Status.LastRotated = nil
+	}
 
 	meta.SetStatusCondition(&secret.Status.Conditions, metav1.Condition{
 		Type:    heistv1alpha1.Conditions.Types.Provisioned,
@@ -0,0 +1,156 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"testing"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/managed"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/fake"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+	"github.com/youniqx/heist/pkg/vault/kvsecret"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newTestReconciler returns a reconciler backed by a fake Vault API with a
+// provisioned kv engine of the given version named "engine".
+func newTestReconciler(t *testing.T, version kvengine.Version) (*Reconciler, *fake.API, *heistv1alpha1.VaultKVSecretEngine) {
+	t.Helper()
+
+	ctx := context.Background()
+	api := fake.New()
+	if err := managed.UpdateManagedTransitEngine(ctx, api); err != nil {
+		t.Fatalf("UpdateManagedTransitEngine() error = %v", err)
+	}
+
+	engine := &heistv1alpha1.VaultKVSecretEngine{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "engine"},
+		Spec:       heistv1alpha1.VaultKVSecretEngineSpec{Version: version},
+		Status: heistv1alpha1.VaultKVSecretEngineStatus{
+			Conditions: []metav1.Condition{{Type: heistv1alpha1.Conditions.Types.Provisioned, Status: metav1.ConditionTrue}},
+		},
+	}
+	if err := api.UpdateKvEngine(ctx, engine); err != nil {
+		t.Fatalf("UpdateKvEngine() error = %v", err)
+	}
+
+	scheme := runtime.NewScheme()
+	_ = heistv1alpha1.AddToScheme(scheme)
+
+	return &Reconciler{
+		Client:   fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(engine).Build(),
+		VaultAPI: api,
+		Recorder: record.NewFakeRecorder(100),
+	}, api, engine
+}
+
+func encryptTestValue(t *testing.T, api *fake.API, value string) string {
+	t.Helper()
+
+	cipherText, err := api.TransitEncrypt(context.Background(), managed.TransitEngine, managed.TransitKey, []byte(value))
+	if err != nil {
+		t.Fatalf("TransitEncrypt() error = %v", err)
+	}
+
+	return cipherText
+}
+
+// newProvisionedTestSecret returns a secret which Heist has written to Vault
+// with the given value as version 1.
+func newProvisionedTestSecret(t *testing.T, api *fake.API, engine *heistv1alpha1.VaultKVSecretEngine, value string) *heistv1alpha1.VaultKVSecret {
+	t.Helper()
+
+	if _, err := api.UpdateKvSecretCAS(context.Background(), engine, &kvsecret.KvSecret{Path: "app", Fields: map[string]string{"password": value}}, kvsecret.AnyVersion); err != nil {
+		t.Fatalf("UpdateKvSecretCAS() error = %v", err)
+	}
+
+	mountPath, _ := engine.GetMountPath()
+	cipherText := encryptTestValue(t, api, value)
+
+	return &heistv1alpha1.VaultKVSecret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app", Generation: 1},
+		Spec: heistv1alpha1.VaultKVSecretSpec{
+			Engine: engine.Name,
+			Fields: map[string]*heistv1alpha1.VaultKVSecretField{"password": {CipherText: heistv1alpha1.EncryptedValue(cipherText)}},
+		},
+		Status: heistv1alpha1.VaultKVSecretStatus{
+			Engine:         mountPath,
+			Path:           "app",
+			Fields:         map[string]string{"password": cipherText},
+			WrittenVersion: 1,
+			Conditions: []metav1.Condition{{
+				Type:               heistv1alpha1.Conditions.Types.Provisioned,
+				Status:             metav1.ConditionTrue,
+				Reason:             heistv1alpha1.Conditions.Reasons.Provisioned,
+				ObservedGeneration: 1,
+			}},
+		},
+	}
+}
+
+func TestReconciler_updateSecret_vaultErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		err          error
+		modify       bool
+		wantReason   string
+		wantConflict bool
+	}{
+		{
+			name:         "should report conflict if secret has been modified since it was written",
+			modify:       true,
+			wantReason:   heistv1alpha1.Conditions.Reasons.Conflict,
+			wantConflict: true,
+		},
+		{
+			name:       "should not report conflict if policy update fails",
+			method:     "UpdatePolicy",
+			err:        core.ErrAPIError.WithDetails("failed to update policy"),
+			wantReason: heistv1alpha1.Conditions.Reasons.ErrorVault,
+		},
+		{
+			name:       "should not report conflict if vault is sealed",
+			method:     "UpdateKvSecretCAS",
+			err:        core.ErrSealed.WithDetails("vault is sealed"),
+			wantReason: heistv1alpha1.Conditions.Reasons.ErrorVaultSealed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r, api, engine := newTestReconciler(t, kvengine.VersionV2)
+			secret := newProvisionedTestSecret(t, api, engine, "old-password")
+			secret.Spec.Fields["password"].CipherText = heistv1alpha1.EncryptedValue(encryptTestValue(t, api, "new-password"))
+			secret.Spec.DriftPolicy = heistv1alpha1.DriftPolicyIgnore
+
+			if tt.modify {
+				if _, err := api.UpdateKvSecretCAS(ctx, engine, &kvsecret.KvSecret{Path: "app", Fields: map[string]string{"password": "changed-in-vault"}}, 1); err != nil {
+					t.Fatalf("UpdateKvSecretCAS() error = %v", err)
+				}
+			}
+
+			if tt.err != nil {
+				api.InjectError(tt.method, tt.err)
+			}
+
+			if _, err := r.updateSecret(ctx, secret); err == nil {
+				t.Fatalf("updateSecret() error = nil, want error")
+			}
+
+			provisioned := meta.FindStatusCondition(secret.Status.Conditions, heistv1alpha1.Conditions.Types.Provisioned)
+			if provisioned == nil || provisioned.Status != metav1.ConditionFalse || provisioned.Reason != tt.wantReason {
+				t.Errorf("updateSecret() Provisioned condition = %+v, want reason %s", provisioned, tt.wantReason)
+			}
+
+			if got := meta.IsStatusConditionTrue(secret.Status.Conditions, heistv1alpha1.Conditions.Types.Conflict); got != tt.wantConflict {
+				t.Errorf("updateSecret() Conflict condition = %v, want %v", got, tt.wantConflict)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"fmt"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/erx"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+)
+
+var ErrPinnedVersionUnavailable = erx.New("VaultKVSecret", "pinned version unavailable")
+
+func (r *Reconciler) updateVersionsInSecret(ctx context.Context, engine *heistv1alpha1.VaultKVSecretEngine, secret *heistv1alpha1.VaultKVSecret, newState *deployedSecret) error {
+	engineVersion, err := engine.GetKvEngineVersion()
+	if err != nil {
+		return err
+	}
+
+	if engineVersion != kvengine.VersionV2 {
+		secret.Status.CurrentVersion = 0
+		secret.Status.PreviousVersions = nil
+
+		if secret.Spec.PinnedVersion != 0 {
+			return ErrPinnedVersionUnavailable.WithDetails(fmt.Sprintf("engine %s is a %s engine and does not support versioning", engine.Name, engineVersion))
+		}
+
+		return nil
+	}
+
+	metadata, err := r.VaultAPI.ListKvSecretVersions(ctx, engine, newState.Secret)
+	if err != nil {
+		return err
+	}
+
+	var previousVersions []int
+	for _, info := range metadata.Versions {
+		if info.Version != metadata.CurrentVersion && info.IsAvailable() {
+			previousVersions = append(previousVersions, info.Version)
+		}
+	}
+
+	secret.Status.CurrentVersion = metadata.CurrentVersion
+	secret.Status.PreviousVersions = previousVersions
+
+	if secret.Spec.PinnedVersion != 0 {
+		if info := metadata.GetVersion(secret.Spec.PinnedVersion); info == nil || !info.IsAvailable() {
+			return ErrPinnedVersionUnavailable.WithDetails(fmt.Sprintf("version %d of the secret is not available in Vault", secret.Spec.PinnedVersion))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package vaultkvsecret
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReconciler_updateSecret_pinnedVersion(t *testing.T) {
+	tests := []struct {
+		name                 string
+		engineVersion        kvengine.Version
+		pinnedVersion        int
+		err                  error
+		wantErr              bool
+		wantStatus           metav1.ConditionStatus
+		wantReason           string
+		wantCurrentVersion   int
+		wantPreviousVersions []int
+	}{
+		{
+			name:                 "should provision secret if pinned version is available",
+			engineVersion:        kvengine.VersionV2,
+			pinnedVersion:        1,
+			wantStatus:           metav1.ConditionTrue,
+			wantReason:           heistv1alpha1.Conditions.Reasons.Provisioned,
+			wantCurrentVersion:   2,
+			wantPreviousVersions: []int{1},
+		},
+		{
+			name:                 "should report config error if pinned version is unavailable",
+			engineVersion:        kvengine.VersionV2,
+			pinnedVersion:        5,
+			wantErr:              true,
+			wantStatus:           metav1.ConditionFalse,
+			wantReason:           heistv1alpha1.Conditions.Reasons.ErrorConfig,
+			wantCurrentVersion:   2,
+			wantPreviousVersions: []int{1},
+		},
+		{
+			name:          "should report vault error if versions cannot be read",
+			engineVersion: kvengine.VersionV2,
+			pinnedVersion: 1,
+			err:           core.ErrSealed.WithDetails("vault is sealed"),
+			wantErr:       true,
+			wantStatus:    metav1.ConditionFalse,
+			wantReason:    heistv1alpha1.Conditions.Reasons.ErrorVaultSealed,
+		},
+		{
+			name:          "should report config error if version is pinned in v1 engine",
+			engineVersion: kvengine.VersionV1,
+			pinnedVersion: 1,
+			wantErr:       true,
+			wantStatus:    metav1.ConditionFalse,
+			wantReason:    heistv1alpha1.Conditions.Reasons.ErrorConfig,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, api, engine := newTestReconciler(t, tt.engineVersion)
+			secret := newProvisionedTestSecret(t, api, engine, "old-password")
+			secret.Spec.Fields["password"].CipherText = heistv1alpha1.EncryptedValue(encryptTestValue(t, api, "new-password"))
+			secret.Spec.PinnedVersion = tt.pinnedVersion
+
+			if tt.err != nil {
+				api.InjectError("ListKvSecretVersions", tt.err)
+			}
+
+			if _, err := r.updateSecret(context.Background(), secret); (err != nil) != tt.wantErr {
+				t.Fatalf("updateSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			provisioned := meta.FindStatusCondition(secret.Status.Conditions, heistv1alpha1.Conditions.Types.Provisioned)
+			if provisioned == nil || provisioned.Status != tt.wantStatus || provisioned.Reason != tt.wantReason {
+				t.Errorf("updateSecret() Provisioned condition = %+v, want status %s and reason %s", provisioned, tt.wantStatus, tt.wantReason)
+			}
+
+			if secret.Status.CurrentVersion != tt.wantCurrentVersion {
+				t.Errorf("updateSecret() current version = %d, want %d", secret.Status.CurrentVersion, tt.wantCurrentVersion)
+			}
+
+			if !reflect.DeepEqual(secret.Status.PreviousVersions, tt.wantPreviousVersions) {
+				t.Errorf("updateSecret() previous versions = %v, want %v", secret.Status.PreviousVersions, tt.wantPreviousVersions)
+			}
+		})
+	}
+}
@@ -56,6 +56,9 @@ type Reconciler struct {
 
 // Reconcile sets up the controller with the Manager.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := common.StartReconcileSpan(ctx, "VaultKVSecretEngine", req)
+	defer span.End()
+
 	log := r.Log.WithValues("vaultkvsecretengine", req.NamespacedName)
 	log.Info("reconciling for secret engine")
 
@@ -64,7 +67,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if !apierrors.IsNotFound(err) {
 			log.Error(err, "unable to fetch VaultKVSecretEngine")
 		} else {
-			err = r.checkAndCleanupStaleVaultKVEngine(req)
+			err = r.checkAndCleanupStaleVaultKVEngine(ctx, req)
 			if err != nil {
 				return common.Requeue, err
 			}
@@ -94,7 +97,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return result, err
 }
 
-func (r *Reconciler) checkAndCleanupStaleVaultKVEngine(req ctrl.Request) (err error) {
+func (r *Reconciler) checkAndCleanupStaleVaultKVEngine(ctx context.Context, req ctrl.Request) (err error) {
 	log := r.Log.WithValues("vaultkvsecretengine", req.NamespacedName, "method", "checkAndCleanupStaleVaultKVEngine")
 
 	log.Info("k8s vault engine object already is deleted, verifying no stale engine in vault is left")
@@ -106,7 +109,7 @@ func (r *Reconciler) checkAndCleanupStaleVaultKVEngine(req ctrl.Request) (err er
 		},
 	}
 
-	engineStillExists, err := r.VaultAPI.HasEngine(engine)
+	engineStillExists, err := r.VaultAPI.HasEngine(ctx, engine)
 	if err != nil {
 		log.Info("couldn't check if engine exists for deleted k8s manifest", "error", err)
 		return err
@@ -117,7 +120,7 @@ func (r *Reconciler) checkAndCleanupStaleVaultKVEngine(req ctrl.Request) (err er
 		return nil
 	}
 
-	err = r.VaultAPI.DeleteEngine(engine)
+	err = r.VaultAPI.DeleteEngine(ctx, engine)
 	if err != nil {
 		log.Info("couldn't delete stale vault engine", "error", err)
 		return err
@@ -142,17 +145,17 @@ func (r *Reconciler) performReconciliation(ctx context.Context, engine *heistv1a
 		return r.finalizeEngine(ctx, engine)
 	}
 
-	return r.updateEngine(engine)
+	return r.updateEngine(ctx, engine)
 }
 
-func (r *Reconciler) updateEngine(engine *heistv1alpha1.VaultKVSecretEngine) (ctrl.Result, error) {
+func (r *Reconciler) updateEngine(ctx context.Context, engine *heistv1alpha1.VaultKVSecretEngine) (ctrl.Result, error) {
 	r.attachFinalizer(engine)
 
-	if err := r.VaultAPI.UpdateKvEngine(engine); err != nil {
+	if err := r.VaultAPI.UpdateKvEngine(ctx, engine); err != nil {
 		meta.SetStatusCondition(&engine.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: "Failed to provision engine",
 		})
 
@@ -214,7 +217,7 @@ func (r *Reconciler) applyFinalizerEngine(ctx context.Context, engine *heistv1al
 
 	log.Info("deleting engine in vault")
 
-	if err := r.VaultAPI.DeleteEngine(engine); err != nil {
+	if err := r.VaultAPI.DeleteEngine(ctx, engine); err != nil {
 		log.Info("failed to delete engine", "error", err)
 		r.Recorder.Eventf(engine, "Warning", "ErrorDuringDeletion", "Failed to delete the engine %s", engine.Name)
 		// TODO: add condition
@@ -223,7 +226,7 @@ func (r *Reconciler) applyFinalizerEngine(ctx context.Context, engine *heistv1al
 
 	log.Info("verifying if vault api actually deleted the engine")
 
-	engineStillExists, err := r.VaultAPI.HasEngine(engine)
+	engineStillExists, err := r.VaultAPI.HasEngine(ctx, engine)
 	if err != nil {
 		log.Info("failed verify deletion of engine", "error", err)
 		r.Recorder.Eventf(engine, "Warning", "ErrorDuringDeletion", "Failed verify deletion of engine %s", engine.Name)
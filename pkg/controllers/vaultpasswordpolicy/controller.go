@@ -0,0 +1,116 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultpasswordpolicy
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/go-test/deep"
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	"github.com/youniqx/heist/pkg/vault"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Reconciler reconciles a VaultPasswordPolicy object.
+type Reconciler struct {
+	client.Client
+	Log         logr.Logger
+	Scheme      *runtime.Scheme
+	VaultAPI    vault.API
+	Recorder    record.EventRecorder
+	EventFilter predicate.Predicate
+}
+
+// +kubebuilder:rbac:groups=heist.youniqx.com,resources=vaultpasswordpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=heist.youniqx.com,resources=vaultpasswordpolicies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=heist.youniqx.com,resources=vaultpasswordpolicies/finalizers,verbs=update
+
+// Reconcile provisions password policies in Vault.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := common.StartReconcileSpan(ctx, "VaultPasswordPolicy", req)
+	defer span.End()
+
+	log := r.Log.WithValues("vaultpasswordpolicy", req.NamespacedName)
+	log.Info("reconciling for password policy")
+
+	policy := &heistv1alpha1.VaultPasswordPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		if err2 := client.IgnoreNotFound(err); err2 != nil {
+			log.Error(err, "unable to fetch VaultPasswordPolicy")
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	previous := policy.DeepCopy()
+
+	setDefaultConditions(policy)
+
+	result, err := r.performReconciliation(ctx, policy)
+
+	if deep.Equal(previous.Status, policy.Status) != nil {
+		if err := r.Status().Update(ctx, policy); err != nil {
+			return common.Requeue, err
+		}
+	}
+
+	if deep.Equal(previous.Finalizers, policy.Finalizers) != nil {
+		if err := r.Update(ctx, policy); err != nil {
+			return common.Requeue, err
+		}
+	}
+
+	return result, err
+}
+
+func (r *Reconciler) performReconciliation(ctx context.Context, policy *heistv1alpha1.VaultPasswordPolicy) (ctrl.Result, error) {
+	if policy.GetDeletionTimestamp() != nil {
+		return r.finalizePolicy(ctx, policy)
+	}
+
+	return r.updatePolicy(ctx, policy)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&heistv1alpha1.VaultPasswordPolicy{}).
+		WithEventFilter(r.EventFilter).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: 1,
+		}).
+		Complete(r)
+}
+
+func setDefaultConditions(policy *heistv1alpha1.VaultPasswordPolicy) {
+	if meta.FindStatusCondition(policy.Status.Conditions, heistv1alpha1.Conditions.Types.Provisioned) == nil {
+		meta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  heistv1alpha1.Conditions.Reasons.Initializing,
+			Message: "provisioning is about to start",
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package vaultpasswordpolicy
+
+import (
+	"context"
+	"fmt"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func (r *Reconciler) finalizePolicy(ctx context.Context, policy *heistv1alpha1.VaultPasswordPolicy) (ctrl.Result, error) {
+	meta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+		Type:    heistv1alpha1.Conditions.Types.Provisioned,
+		Status:  metav1.ConditionFalse,
+		Reason:  heistv1alpha1.Conditions.Reasons.Terminating,
+		Message: "Password policy is being deleted",
+	})
+
+	if !controllerutil.ContainsFinalizer(policy, common.YouniqxFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.VaultAPI.DeletePasswordPolicy(ctx, policy); err != nil {
+		r.Recorder.Eventf(policy, "Warning", "ErrorDuringDeletion", "Failed to delete the password policy %s", policy.Name)
+		meta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  common.VaultErrorReason(err),
+			Message: fmt.Sprintf("Failed to delete password policy from Vault: %v", err),
+		})
+		return common.Requeue, err
+	}
+
+	r.Recorder.Eventf(policy, "Normal", "PasswordPolicyDeleted", "The password policy %s has been deleted", policy.Name)
+	controllerutil.RemoveFinalizer(policy, common.YouniqxFinalizer)
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,45 @@
+package vaultpasswordpolicy
+
+import (
+	"context"
+	"fmt"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func (r *Reconciler) updatePolicy(ctx context.Context, policy *heistv1alpha1.VaultPasswordPolicy) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(policy, common.YouniqxFinalizer) {
+		controllerutil.AddFinalizer(policy, common.YouniqxFinalizer)
+		r.Recorder.Eventf(policy, "Normal", "FinalizerAttached", "Attached finalizer to password policy %s", policy.Name)
+	}
+
+	if err := r.VaultAPI.UpdatePasswordPolicy(ctx, policy); err != nil {
+		r.Recorder.Eventf(policy, "Warning", "ProvisioningFailed", "Failed to provision password policy %s", policy.Name)
+		meta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  common.VaultErrorReason(err),
+			Message: fmt.Sprintf("Failed to update password policy: %v", err),
+		})
+		return common.Requeue, err
+	}
+
+	policy.Status.PolicyName, _ = policy.GetPolicyName()
+
+	if !meta.IsStatusConditionTrue(policy.Status.Conditions, heistv1alpha1.Conditions.Types.Provisioned) {
+		r.Recorder.Eventf(policy, "Normal", "ProvisioningSuccessful", "Password policy %s has been provisioned", policy.Name)
+		meta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionTrue,
+			Reason:  heistv1alpha1.Conditions.Reasons.Provisioned,
+			Message: "Password policy has been provisioned",
+		})
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -61,6 +61,9 @@ type Reconciler struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.9.2/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := common.StartReconcileSpan(ctx, "VaultSyncSecret", req)
+	defer span.End()
+
 	logger := log.FromContext(ctx)
 
 	sync := &heistv1alpha1.VaultSyncSecret{}
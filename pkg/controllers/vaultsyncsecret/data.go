@@ -12,6 +12,7 @@ import (
 	"github.com/youniqx/heist/pkg/managed"
 	"github.com/youniqx/heist/pkg/vault"
 	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvsecret"
 	"github.com/youniqx/heist/pkg/vault/pki"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,31 +53,31 @@ func (r *Reconciler) FetchData(ctx context.Context, sync *heistv1alpha1.VaultSyn
 		CertMap:       make(map[int]*pki.Certificate),
 	}
 
-	if err := fetcher.FetchData(); err != nil {
+	if err := fetcher.FetchData(ctx); err != nil {
 		return renewDisabled, nil, err
 	}
 
 	return fetcher.RenewInterval, fetcher.Data, nil
 }
 
-func (d *dataFetcher) FetchData() error {
+func (d *dataFetcher) FetchData(ctx context.Context) error {
 	var err error
 	for key, source := range d.Spec.Data {
 		switch {
 		case source.CipherText != "":
-			if d.Data[key], err = d.VaultAPI.TransitDecrypt(managed.TransitEngine, managed.TransitKey, string(source.CipherText)); err != nil {
+			if d.Data[key], err = d.VaultAPI.TransitDecrypt(ctx, managed.TransitEngine, managed.TransitKey, string(source.CipherText)); err != nil {
 				return err
 			}
 		case source.CertificateAuthority != nil:
-			if d.Data[key], err = d.FetchCertificateAuthority(source.CertificateAuthority); err != nil {
+			if d.Data[key], err = d.FetchCertificateAuthority(ctx, source.CertificateAuthority); err != nil {
 				return err
 			}
 		case source.Certificate != nil:
-			if d.Data[key], err = d.FetchCertificate(source.Certificate); err != nil {
+			if d.Data[key], err = d.FetchCertificate(ctx, source.Certificate); err != nil {
 				return err
 			}
 		case source.KVSecret != nil:
-			if d.Data[key], err = d.FetchKvSecret(source.KVSecret); err != nil {
+			if d.Data[key], err = d.FetchKvSecret(ctx, source.KVSecret); err != nil {
 				return err
 			}
 		}
@@ -85,7 +86,7 @@ func (d *dataFetcher) FetchData() error {
 	return nil
 }
 
-func (d *dataFetcher) FetchCertificateAuthority(authority *heistv1alpha1.VaultSyncCertificateAuthoritySource) ([]byte, error) {
+func (d *dataFetcher) FetchCertificateAuthority(ctx context.Context, authority *heistv1alpha1.VaultSyncCertificateAuthoritySource) ([]byte, error) {
 	ca := &heistv1alpha1.VaultCertificateAuthority{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      authority.Name,
@@ -100,11 +101,11 @@ func (d *dataFetcher) FetchCertificateAuthority(authority *heistv1alpha1.VaultSy
 		return nil, fmt.Errorf("CertificateAuthority not provisioned yet")
 	}
 
-	publicSecret, err := d.VaultAPI.ReadKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(ca)))
+	publicSecret, err := d.VaultAPI.ReadKvSecret(ctx, common.InternalKvEngine, core.SecretPath(common.GetCAInfoSecretPath(ca)))
 	if err != nil {
 		return nil, err
 	}
-	privateSecret, err := d.VaultAPI.ReadKvSecret(common.InternalKvEngine, core.SecretPath(common.GetCAPrivateKeySecretPath(ca)))
+	privateSecret, err := d.VaultAPI.ReadKvSecret(ctx, common.InternalKvEngine, core.SecretPath(common.GetCAPrivateKeySecretPath(ca)))
 	if err != nil {
 		return nil, err
 	}
@@ -127,7 +128,7 @@ func (d *dataFetcher) FetchCertificateAuthority(authority *heistv1alpha1.VaultSy
 }
 
 //nolint:cyclop
-func (d *dataFetcher) FetchCertificate(certificate *heistv1alpha1.VaultSyncCertificateSource) ([]byte, error) {
+func (d *dataFetcher) FetchCertificate(ctx context.Context, certificate *heistv1alpha1.VaultSyncCertificateSource) ([]byte, error) {
 	_, tpl, err := d.findTemplateAndIndex(certificate)
 	if err != nil {
 		return nil, err
@@ -170,7 +171,7 @@ func (d *dataFetcher) FetchCertificate(certificate *heistv1alpha1.VaultSyncCerti
 		return nil, fmt.Errorf("root authority not provisioned yet")
 	}
 
-	rootPEM, err := d.VaultAPI.ReadCACertificatePEM(rootCA)
+	rootPEM, err := d.VaultAPI.ReadCACertificatePEM(ctx, rootCA)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +181,7 @@ func (d *dataFetcher) FetchCertificate(certificate *heistv1alpha1.VaultSyncCerti
 		return nil, err
 	}
 
-	issuedCert, err := d.getIssuedCert(certificate, ca, roleName)
+	issuedCert, err := d.getIssuedCert(ctx, certificate, ca, roleName)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +224,7 @@ func (d *dataFetcher) findTemplateAndIndex(certificate *heistv1alpha1.VaultSyncC
 	return -1, nil, ErrTemplateNotFound
 }
 
-func (d *dataFetcher) getIssuedCert(certificate *heistv1alpha1.VaultSyncCertificateSource, ca *heistv1alpha1.VaultCertificateAuthority, roleName string) (*pki.Certificate, error) {
+func (d *dataFetcher) getIssuedCert(ctx context.Context, certificate *heistv1alpha1.VaultSyncCertificateSource, ca *heistv1alpha1.VaultCertificateAuthority, roleName string) (*pki.Certificate, error) {
 	index, template, err := d.findTemplateAndIndex(certificate)
 	if err != nil {
 		return nil, err
@@ -234,7 +235,7 @@ func (d *dataFetcher) getIssuedCert(certificate *heistv1alpha1.VaultSyncCertific
 		return existingCertificate, nil
 	}
 
-	issuedCert, err := d.VaultAPI.IssueCertificate(ca, core.RoleName(roleName), &pki.IssueCertOptions{
+	issuedCert, err := d.VaultAPI.IssueCertificate(ctx, ca, core.RoleName(roleName), &pki.IssueCertOptions{
 		CommonName:        template.CommonName,
 		DNSSans:           template.DNSSans,
 		OtherSans:         template.OtherSans,
@@ -252,7 +253,7 @@ func (d *dataFetcher) getIssuedCert(certificate *heistv1alpha1.VaultSyncCertific
 	return issuedCert, nil
 }
 
-func (d *dataFetcher) FetchKvSecret(secret *heistv1alpha1.VaultSyncKVSecretSource) ([]byte, error) {
+func (d *dataFetcher) FetchKvSecret(ctx context.Context, secret *heistv1alpha1.VaultSyncKVSecretSource) ([]byte, error) {
 	kvSecret := &heistv1alpha1.VaultKVSecret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      secret.Name,
@@ -281,7 +282,15 @@ func (d *dataFetcher) FetchKvSecret(secret *heistv1alpha1.VaultSyncKVSecretSourc
 		return nil, fmt.Errorf("kv engine not provisioned yet")
 	}
 
-	secretData, err := d.VaultAPI.ReadKvSecret(kvEngine, kvSecret)
+	var (
+		secretData *kvsecret.KvSecret
+		err        error
+	)
+	if kvSecret.Spec.PinnedVersion != 0 {
+		secretData, err = d.VaultAPI.ReadKvSecretVersion(ctx, kvEngine, kvSecret, kvSecret.Spec.PinnedVersion)
+	} else {
+		secretData, err = d.VaultAPI.ReadKvSecret(ctx, kvEngine, kvSecret)
+	}
 	if err != nil {
 		return nil, err
 	}
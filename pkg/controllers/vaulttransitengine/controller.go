@@ -50,6 +50,9 @@ type Reconciler struct {
 
 // Reconcile sets up the controller with the Manager.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := common.StartReconcileSpan(ctx, "VaultTransitEngine", req)
+	defer span.End()
+
 	log := r.Log.WithValues("vaulttransitengine", req.NamespacedName)
 	log.Info("reconciling for secret engine")
 
@@ -58,7 +61,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if err2 := client.IgnoreNotFound(err); err2 != nil { // nestif
 			log.Error(err, "unable to fetch VaultTransitEngine")
 		} else {
-			errchkeng := r.checkAndCleanupStaleVaultTransitEngine(req)
+			errchkeng := r.checkAndCleanupStaleVaultTransitEngine(ctx, req)
 			if errchkeng != nil {
 				return common.Requeue, errchkeng
 			}
@@ -117,7 +120,7 @@ func setDefaultConditions(engine *heistv1alpha1.VaultTransitEngine) {
 	}
 }
 
-func (r *Reconciler) checkAndCleanupStaleVaultTransitEngine(req ctrl.Request) (err error) {
+func (r *Reconciler) checkAndCleanupStaleVaultTransitEngine(ctx context.Context, req ctrl.Request) (err error) {
 	log := r.Log.WithValues("vaulttransitengine", req.NamespacedName, "method", "checkAndCleanupStaleVaultTransitEngine")
 
 	log.Info("k8s vault engine object already is deleted, verifying no stale engine in vault is left")
@@ -129,7 +132,7 @@ func (r *Reconciler) checkAndCleanupStaleVaultTransitEngine(req ctrl.Request) (e
 		},
 	}
 
-	engineStillExists, err := r.VaultAPI.HasEngine(engine)
+	engineStillExists, err := r.VaultAPI.HasEngine(ctx, engine)
 	if err != nil {
 		log.Info("couldn't check if engine exists for deleted k8s manifest", "error", err)
 		return err
@@ -140,7 +143,7 @@ func (r *Reconciler) checkAndCleanupStaleVaultTransitEngine(req ctrl.Request) (e
 		return nil
 	}
 
-	err = r.VaultAPI.DeleteEngine(engine)
+	err = r.VaultAPI.DeleteEngine(ctx, engine)
 	if err != nil {
 		log.Info("couldn't delete stale vault engine", "error", err)
 		return err
@@ -12,7 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-func (r *Reconciler) finalizeEngine(_ context.Context, engine *heistv1alpha1.VaultTransitEngine) (ctrl.Result, error) {
+func (r *Reconciler) finalizeEngine(ctx context.Context, engine *heistv1alpha1.VaultTransitEngine) (ctrl.Result, error) {
 	meta.SetStatusCondition(&engine.Status.Conditions, metav1.Condition{
 		Type:    heistv1alpha1.Conditions.Types.Provisioned,
 		Status:  metav1.ConditionFalse,
@@ -20,12 +20,12 @@ func (r *Reconciler) finalizeEngine(_ context.Context, engine *heistv1alpha1.Vau
 		Message: "Engine is being deleted",
 	})
 
-	if err := r.VaultAPI.DeleteEngine(engine); err != nil {
+	if err := r.VaultAPI.DeleteEngine(ctx, engine); err != nil {
 		r.Recorder.Eventf(engine, "Warning", "ErrorDuringDeletion", "Failed to delete the engine %s", engine.Name)
 		meta.SetStatusCondition(&engine.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionFalse,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to delete Transit Engine from Vault: %v", err),
 		})
 		return common.Requeue, err
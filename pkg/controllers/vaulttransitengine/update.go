@@ -12,18 +12,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
-func (r *Reconciler) updateEngine(_ context.Context, engine *heistv1alpha1.VaultTransitEngine) (ctrl.Result, error) {
+func (r *Reconciler) updateEngine(ctx context.Context, engine *heistv1alpha1.VaultTransitEngine) (ctrl.Result, error) {
 	if !controllerutil.ContainsFinalizer(engine, common.YouniqxFinalizer) {
 		controllerutil.AddFinalizer(engine, common.YouniqxFinalizer)
 		r.Recorder.Eventf(engine, "Normal", "FinalizerAttached", "Attached finalizer to engine %s", engine.Name)
 	}
 
-	if err := r.VaultAPI.UpdateTransitEngine(engine); err != nil {
+	if err := r.VaultAPI.UpdateTransitEngine(ctx, engine); err != nil {
 		r.Recorder.Eventf(engine, "Warning", "ProvisioningFailed", "Failed to provision engine %s", engine.Name)
 		meta.SetStatusCondition(&engine.Status.Conditions, metav1.Condition{
 			Type:    heistv1alpha1.Conditions.Types.Provisioned,
 			Status:  metav1.ConditionTrue,
-			Reason:  heistv1alpha1.Conditions.Reasons.ErrorVault,
+			Reason:  common.VaultErrorReason(err),
 			Message: fmt.Sprintf("Failed to update transit engine: %v", err),
 		})
 		return common.Requeue, err
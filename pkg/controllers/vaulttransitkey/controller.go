@@ -38,11 +38,12 @@ import (
 // Reconciler reconciles a VaultTransitKey object.
 type Reconciler struct {
 	client.Client
-	Log         logr.Logger
-	Scheme      *runtime.Scheme
-	VaultAPI    vault.API
-	Recorder    record.EventRecorder
-	EventFilter predicate.Predicate
+	Log                logr.Logger
+	Scheme             *runtime.Scheme
+	VaultAPI           vault.API
+	Recorder           record.EventRecorder
+	EventFilter        predicate.Predicate
+	DriftCheckInterval time.Duration
 }
 
 // +kubebuilder:rbac:groups=heist.youniqx.com,resources=vaulttransitkeys,verbs=get;list;watch;create;update;patch;delete
@@ -51,6 +52,9 @@ type Reconciler struct {
 
 // Reconcile sets up the controller with the Manager.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := common.StartReconcileSpan(ctx, "VaultTransitKey", req)
+	defer span.End()
+
 	log := r.Log.WithValues("vaulttransitkey", req.NamespacedName)
 	log.Info("reconciling for transit key")
 
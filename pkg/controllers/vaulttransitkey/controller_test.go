@@ -1,6 +1,7 @@
 package vaulttransitkey
 
 import (
+	"reflect"
 	"testing"
 
 	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
@@ -256,3 +257,54 @@ func Test_hasChangedKey(t *testing.T) {
 		})
 	}
 }
+
+func Test_diffTransitKey(t *testing.T) {
+	key := &heistv1alpha1.VaultTransitKey{
+		Spec: heistv1alpha1.VaultTransitKeySpec{
+			Type:                     transit.TypeAes256Gcm96,
+			MinimumEncryptionVersion: 2,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		actual *transit.Key
+		want   []string
+	}{
+		{
+			name: "should not detect drift of unchanged key",
+			actual: &transit.Key{
+				Type:   transit.TypeAes256Gcm96,
+				Config: &transit.KeyConfig{MinimumDecryptionVersion: 1, MinimumEncryptionVersion: 2, DeletionAllowed: true},
+			},
+			want: nil,
+		},
+		{
+			name: "should detect changed settings",
+			actual: &transit.Key{
+				Type:   transit.TypeAes256Gcm96,
+				Config: &transit.KeyConfig{MinimumDecryptionVersion: 1, MinimumEncryptionVersion: 1, DeletionAllowed: false, Exportable: true},
+			},
+			want: []string{"min_encryption_version", "deletion_allowed", "exportable"},
+		},
+		{
+			name: "should detect changed key type",
+			actual: &transit.Key{
+				Type:   transit.TypeRSA2048,
+				Config: &transit.KeyConfig{MinimumEncryptionVersion: 2, DeletionAllowed: true},
+			},
+			want: []string{"type"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diffTransitKey(key, tt.actual)
+			if err != nil {
+				t.Fatalf("diffTransitKey() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffTransitKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -30,13 +30,13 @@ func (r *Reconciler) finalizeTransitKey(ctx context.Context, key *heistv1alpha1.
 		}
 	}
 
-	if err := r.VaultAPI.DeleteTransitKey(engine, key); err != nil {
+	if err := r.VaultAPI.DeleteTransitKey(ctx, engine, key); err != nil {
 		r.Recorder.Eventf(key, "Warning", "ErrorDuringDeletion", "Failed to delete the key %s", key.Name)
 		return common.Requeue, err
 	}
 	r.Recorder.Eventf(key, "Normal", "TransitKeyDeleted", "The key %s has been deleted", key.Name)
 
-	if err := r.deletePolicy(key); err != nil {
+	if err := r.deletePolicy(ctx, key); err != nil {
 		r.Recorder.Eventf(key, "Warning", "ErrorDuringDeletion", "Failed to delete policies for key %s", key.Name)
 		return common.Requeue, err
 	}
@@ -0,0 +1,66 @@
+package vaulttransitkey
+
+import (
+	"context"
+	"errors"
+
+	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
+	"github.com/youniqx/heist/pkg/controllers/common"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/transit"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// checkDrift compares the key in Vault with the desired state and updates the
+// Drifted condition. The comparison only takes place if the current spec has
+// already been applied or is held back by a reported drift. It returns false
+// if the key must not be updated because it has drifted and the drift is only
+// reported.
+func (r *Reconciler) checkDrift(ctx context.Context, engine *heistv1alpha1.VaultTransitEngine, key *heistv1alpha1.VaultTransitKey) (bool, error) {
+	policy := key.GetDriftPolicy()
+	if policy == heistv1alpha1.DriftPolicyIgnore {
+		meta.RemoveStatusCondition(&key.Status.Conditions, heistv1alpha1.Conditions.Types.Drifted)
+		return true, nil
+	}
+
+	if !common.IsDriftCheckDue(key.Status.Conditions, key.Generation) {
+		return true, nil
+	}
+
+	drift, err := r.detectDrift(ctx, engine, key)
+	if err != nil {
+		return false, err
+	}
+
+	repair := common.UpdateDriftCondition(r.Recorder, key, &key.Status.Conditions, policy, drift)
+
+	return len(drift) == 0 || repair, nil
+}
+
+// detectDrift returns the names of all settings of the key which differ
+// between Vault and the desired state.
+func (r *Reconciler) detectDrift(ctx context.Context, engine *heistv1alpha1.VaultTransitEngine, key *heistv1alpha1.VaultTransitKey) ([]string, error) {
+	actual, err := r.VaultAPI.ReadTransitKey(ctx, engine, key)
+	switch {
+	case errors.Is(err, core.ErrDoesNotExist), errors.Is(err, core.ErrNotFound):
+		return []string{"key has been deleted"}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return diffTransitKey(key, actual)
+}
+
+func diffTransitKey(key *heistv1alpha1.VaultTransitKey, actual *transit.Key) ([]string, error) {
+	desired, err := key.GetTransitKeyConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	drift := common.DiffFields(desired, actual.Config)
+	if actual.Type != key.Spec.Type {
+		drift = append(drift, "type")
+	}
+
+	return drift, nil
+}
@@ -46,6 +46,21 @@ func (r *Reconciler) updateTransitKey(ctx context.Context, key *heistv1alpha1.Va
 		return common.Requeue, fmt.Errorf("engine not provisioned yet")
 	}
 
+	switch update, err := r.checkDrift(ctx, engine, key); {
+	case err != nil:
+		r.Recorder.Eventf(key, "Warning", "DriftDetectionFailed", "Failed to compare key %s with its state in Vault", key.Name)
+		meta.SetStatusCondition(&key.Status.Conditions, metav1.Condition{
+			Type:    heistv1alpha1.Conditions.Types.Provisioned,
+			Status:  metav1.ConditionFalse,
+			Reason:  common.VaultErrorReason(err),
+			Message: fmt.Sprintf("Failed to read key from Vault: %v", err),
+		})
+		return common.Requeue, err
+	case !update:
+		common.SetDriftBlockedCondition(&key.Status.Conditions, key.Generation, "Key has been modified in Vault outside of Heist and is not updated until the drift has been resolved")
+		return common.RequeueForDriftCheck(ctrl.Result{}, key.GetDriftPolicy(), r.DriftCheckInterval), nil
+	}
+
 	if hasIncompatibleChanges(key) {
 		oldKey := key.DeepCopy()
 		oldKey.Spec = key.Status.AppliedSpec
@@ -56,7 +71,7 @@ func (r *Reconciler) updateTransitKey(ctx context.Context, key *heistv1alpha1.Va
 			return common.Requeue, fmt.Errorf("old engine doesn't exist, can't cleanup")
 		}
 
-		if err := r.VaultAPI.DeleteTransitKey(oldEngine, oldKey); err != nil {
+		if err := r.VaultAPI.DeleteTransitKey(ctx, oldEngine, oldKey); err != nil {
 			r.Recorder.Eventf(key, "Warning", "CantDeleteOldKey", "Previously applied transit key %s can't be deleted", oldKey.Name)
 			meta.SetStatusCondition(&key.Status.Conditions, metav1.Condition{
 				Type:    heistv1alpha1.Conditions.Types.Provisioned,
@@ -69,27 +84,29 @@ func (r *Reconciler) updateTransitKey(ctx context.Context, key *heistv1alpha1.Va
 	}
 	key.Status.AppliedSpec = key.Spec
 
-	if err := r.VaultAPI.UpdateTransitKey(engine, key); err != nil {
+	if err := r.VaultAPI.UpdateTransitKey(ctx, engine, key); err != nil {
 		r.Recorder.Eventf(key, "Warning", "ProvisioningFailed", "Failed to provision key %s", key.Name)
 		return common.Requeue, err
 	}
 
-	if err := r.updatePolicy(engine, key); err != nil {
+	if err := r.updatePolicy(ctx, engine, key); err != nil {
 		r.Recorder.Eventf(key, "Warning", "ProvisioningFailed", "Failed to provision policies for key %s", key.Name)
 		return common.Requeue, err
 	}
 
 	if meta.IsStatusConditionFalse(key.Status.Conditions, heistv1alpha1.Conditions.Types.Provisioned) {
 		r.Recorder.Eventf(key, "Normal", "ProvisioningSuccessful", "TransitKey %s has been provisioned", key.Name)
-		meta.SetStatusCondition(&key.Status.Conditions, metav1.Condition{
-			Type:    heistv1alpha1.Conditions.Types.Provisioned,
-			Status:  metav1.ConditionTrue,
-			Reason:  heistv1alpha1.Conditions.Reasons.Provisioned,
-			Message: "TransitKey has been provisioned",
-		})
 	}
 
-	return ctrl.Result{}, nil
+	meta.SetStatusCondition(&key.Status.Conditions, metav1.Condition{
+		Type:               heistv1alpha1.Conditions.Types.Provisioned,
+		Status:             metav1.ConditionTrue,
+		Reason:             heistv1alpha1.Conditions.Reasons.Provisioned,
+		Message:            "TransitKey has been provisioned",
+		ObservedGeneration: key.Generation,
+	})
+
+	return common.RequeueForDriftCheck(ctrl.Result{}, key.GetDriftPolicy(), r.DriftCheckInterval), nil
 }
 
 // hasIncompatibleChanges determines if the key spec has changed in a way
@@ -1,6 +1,7 @@
 package vaulttransitkey
 
 import (
+	"context"
 	"path/filepath"
 
 	heistv1alpha1 "github.com/youniqx/heist/pkg/apis/heist.youniqx.com/v1alpha1"
@@ -8,7 +9,7 @@ import (
 	"github.com/youniqx/heist/pkg/vault/policy"
 )
 
-func (r *Reconciler) updatePolicy(engine *heistv1alpha1.VaultTransitEngine, key *heistv1alpha1.VaultTransitKey) error {
+func (r *Reconciler) updatePolicy(ctx context.Context, engine *heistv1alpha1.VaultTransitEngine, key *heistv1alpha1.VaultTransitKey) error {
 	issuerPath, err := engine.GetMountPath()
 	if err != nil {
 		return err
@@ -31,7 +32,7 @@ func (r *Reconciler) updatePolicy(engine *heistv1alpha1.VaultTransitEngine, key
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(readPolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, readPolicy); err != nil {
 		return err
 	}
 
@@ -47,7 +48,7 @@ func (r *Reconciler) updatePolicy(engine *heistv1alpha1.VaultTransitEngine, key
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(encryptPolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, encryptPolicy); err != nil {
 		return err
 	}
 
@@ -63,7 +64,7 @@ func (r *Reconciler) updatePolicy(engine *heistv1alpha1.VaultTransitEngine, key
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(decryptPolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, decryptPolicy); err != nil {
 		return err
 	}
 
@@ -79,7 +80,7 @@ func (r *Reconciler) updatePolicy(engine *heistv1alpha1.VaultTransitEngine, key
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(rewrapPolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, rewrapPolicy); err != nil {
 		return err
 	}
 
@@ -101,7 +102,7 @@ func (r *Reconciler) updatePolicy(engine *heistv1alpha1.VaultTransitEngine, key
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(dataKeyPolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, dataKeyPolicy); err != nil {
 		return err
 	}
 
@@ -141,7 +142,7 @@ func (r *Reconciler) updatePolicy(engine *heistv1alpha1.VaultTransitEngine, key
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(hmacPolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, hmacPolicy); err != nil {
 		return err
 	}
 
@@ -181,7 +182,7 @@ func (r *Reconciler) updatePolicy(engine *heistv1alpha1.VaultTransitEngine, key
 		},
 	}
 
-	if err := r.VaultAPI.UpdatePolicy(signPolicy); err != nil {
+	if err := r.VaultAPI.UpdatePolicy(ctx, signPolicy); err != nil {
 		return err
 	}
 
@@ -221,5 +222,5 @@ func (r *Reconciler) updatePolicy(engine *heistv1alpha1.VaultTransitEngine, key
 		},
 	}
 
-	return r.VaultAPI.UpdatePolicy(verifyPolicy)
+	return r.VaultAPI.UpdatePolicy(ctx, verifyPolicy)
 }
@@ -26,6 +26,9 @@ type client struct {
 
 type Result struct {
 	StatusCode int
+	// URL is the URL of the last request sent, which differs from the requested
+	// URL when the server has redirected the request.
+	URL *url.URL
 }
 
 func NewClient(base *url.URL) Client {
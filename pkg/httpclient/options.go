@@ -1,6 +1,7 @@
 package httpclient
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"time"
@@ -12,6 +13,7 @@ type header struct {
 }
 
 type requestConfig struct {
+	BaseURL  *url.URL
 	Path     string
 	Method   string
 	Request  Encodable
@@ -19,10 +21,19 @@ type requestConfig struct {
 	Headers  []*header
 	Query    []string
 	Timeout  time.Duration
+	Context  context.Context
 }
 
 type Option func(config *requestConfig) error
 
+// BaseURL overrides the base URL of the client for a single request.
+func BaseURL(base *url.URL) Option {
+	return func(config *requestConfig) error {
+		config.BaseURL = base
+		return nil
+	}
+}
+
 func Path(path string) Option {
 	return func(config *requestConfig) error {
 		config.Path = path
@@ -51,6 +62,18 @@ func Timeout(timeout time.Duration) Option {
 	}
 }
 
+// Context sets the parent context of the request. The request is aborted
+// as soon as the context is cancelled or the configured timeout has elapsed.
+func Context(ctx context.Context) Option {
+	return func(config *requestConfig) error {
+		if ctx != nil {
+			config.Context = ctx
+		}
+
+		return nil
+	}
+}
+
 func Response(body Decodeable) Option {
 	return func(config *requestConfig) error {
 		if body != nil {
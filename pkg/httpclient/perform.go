@@ -8,6 +8,11 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
 const (
@@ -18,6 +23,7 @@ const (
 //nolint:cyclop
 func (c *client) Perform(options ...Option) (*Result, error) {
 	config := &requestConfig{
+		BaseURL:  c.BaseURL,
 		Path:     "",
 		Method:   defaultMethod,
 		Request:  nil,
@@ -25,6 +31,7 @@ func (c *client) Perform(options ...Option) (*Result, error) {
 		Headers:  nil,
 		Query:    nil,
 		Timeout:  defaultTimeout,
+		Context:  context.Background(),
 	}
 
 	for _, option := range options {
@@ -33,7 +40,7 @@ func (c *client) Perform(options ...Option) (*Result, error) {
 		}
 	}
 
-	requestURL, err := url.Parse(c.BaseURL.String())
+	requestURL, err := url.Parse(config.BaseURL.String())
 	if err != nil {
 		return nil, ErrRequestError.WithDetails("failed to parse base url").WithCause(err)
 	}
@@ -52,11 +59,19 @@ func (c *client) Perform(options ...Option) (*Result, error) {
 		requestBody = buffer
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), config.Timeout)
+	ctx, span := tracing.StartClient(config.Context, "HTTP "+config.Method,
+		semconv.HTTPRequestMethodKey.String(config.Method),
+		semconv.ServerAddress(requestURL.Hostname()),
+		semconv.URLPath(requestURL.Path),
+	)
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, config.Timeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, config.Method, requestURL.String(), requestBody)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, ErrRequestError.WithDetails("failed to create request").WithCause(err)
 	}
 
@@ -64,11 +79,19 @@ func (c *client) Perform(options ...Option) (*Result, error) {
 		req.Header.Add(header.Key, header.Value)
 	}
 
+	tracing.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
 	resp, err := c.Client.Do(req)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, ErrRequestError.WithDetails("failed to send request").WithCause(err)
 	}
 
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+
 	defer resp.Body.Close()
 
 	for _, bodyDecoder := range config.Response {
@@ -83,5 +106,6 @@ func (c *client) Perform(options ...Option) (*Result, error) {
 
 	return &Result{
 		StatusCode: resp.StatusCode,
+		URL:        resp.Request.URL,
 	}, nil
 }
@@ -6,7 +6,6 @@ import (
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/vault/sdk/helper/strutil"
@@ -152,7 +151,7 @@ func (h *Handler) Mutate(req *v1.AdmissionRequest) *v1.AdmissionResponse {
 	}
 
 	inject, err := h.ShouldInject(&pod)
-	if err != nil && !strings.Contains(err.Error(), "no inject annotation found") {
+	if err != nil {
 		err := fmt.Errorf("error checking if should inject agent: %w", err)
 		return admissionError(err)
 	} else if !inject {
@@ -19,6 +19,8 @@ const (
 	preloadContainerName = "heist-agent-preload"
 	defaultMountPath     = "/heist"
 	agentURLEnvVarName   = "HEIST_AGENT_URL"
+	agentTokenEnvVarName = "HEIST_AGENT_TOKEN_PATH"
+	agentTokenFileName   = "agent-token"
 	agentMemoryRequest   = 25
 	agentCPURequest      = 25
 	agentMemoryLimits    = 50
@@ -172,6 +174,10 @@ func (i *Injector) Patch() ([]*jsonpatch.JsonPatchOperation, error) {
 					Name:  agentURLEnvVarName,
 					Value: agentURL,
 				},
+				{
+					Name:  agentTokenEnvVarName,
+					Value: filepath.Join(defaultMountPath, agentTokenFileName),
+				},
 			},
 			fmt.Sprintf("/spec/containers/%d/env", i),
 		)
@@ -203,6 +203,14 @@ func TestInjector_Patch(t *testing.T) {
 						},
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/0/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -369,6 +377,14 @@ func TestInjector_Patch(t *testing.T) {
 						},
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/0/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -541,6 +557,14 @@ func TestInjector_Patch(t *testing.T) {
 						},
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/0/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -712,6 +736,14 @@ func TestInjector_Patch(t *testing.T) {
 						Value: "http://localhost:13037",
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/0/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -897,6 +929,14 @@ func TestInjector_Patch(t *testing.T) {
 						Value: "http://localhost:13037",
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/0/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 				{
 					Operation: "add",
 					Path:      "/spec/containers/1/volumeMounts",
@@ -916,6 +956,14 @@ func TestInjector_Patch(t *testing.T) {
 						Value: "http://localhost:13037",
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/1/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 				{
 					Operation: "add",
 					Path:      "/spec/containers/2/volumeMounts",
@@ -937,6 +985,14 @@ func TestInjector_Patch(t *testing.T) {
 						},
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/2/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -1149,6 +1205,14 @@ func TestInjector_Patch(t *testing.T) {
 						Value: "http://localhost:13037",
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/0/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 				{
 					Operation: "add",
 					Path:      "/spec/containers/1/volumeMounts",
@@ -1177,6 +1241,14 @@ func TestInjector_Patch(t *testing.T) {
 						Value: "http://localhost:13037",
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/1/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 				{
 					Operation: "add",
 					Path:      "/spec/containers/2/volumeMounts",
@@ -1207,6 +1279,14 @@ func TestInjector_Patch(t *testing.T) {
 						},
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/2/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -1375,6 +1455,14 @@ func TestInjector_Patch(t *testing.T) {
 						},
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/0/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 				{
 					Operation: "add",
 					Path:      "/spec/initContainers",
@@ -1628,6 +1716,14 @@ func TestInjector_Patch(t *testing.T) {
 						},
 					},
 				},
+				{
+					Operation: "add",
+					Path:      "/spec/containers/0/env/-",
+					Value: corev1.EnvVar{
+						Name:  "HEIST_AGENT_TOKEN_PATH",
+						Value: "/heist/agent-token",
+					},
+				},
 				{
 					Operation: "add",
 					Path:      "/spec/initContainers/0",
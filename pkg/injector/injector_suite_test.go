@@ -83,7 +83,7 @@ func BeforeSuiteSetup() func() {
 		Expect(VaultEnv).NotTo(BeNil())
 
 		By("setup Vault for Operator")
-		err = operator.SetupOperator(&operator.SetupConfig{
+		err = operator.SetupOperator(context.TODO(), &operator.SetupConfig{
 			VaultURL:             VaultEnv.GetAddress(),
 			VaultToken:           "root",
 			PolicyName:           "heist",
@@ -139,7 +139,7 @@ func BeforeSuiteSetup() func() {
 			Register(Component(&Config{
 				AgentImage: "youniqx/heist:latest",
 			})).
-			Complete()
+			Complete(context.TODO())
 		Expect(err).ToNot(HaveOccurred())
 		Expect(mgr).ToNot(BeNil())
 
@@ -158,7 +158,7 @@ func BeforeSuiteSetup() func() {
 
 		K8sEnv = testhelper.New(cfg, K8sClient)
 
-		DefaultCipherText, err = RootAPI.TransitEncrypt(managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
+		DefaultCipherText, err = RootAPI.TransitEncrypt(context.TODO(), managed.TransitEngine, managed.TransitKey, []byte("ASDF ASDF"))
 		Expect(err).NotTo(HaveOccurred())
 		Expect(DefaultCipherText).NotTo(BeEmpty())
 
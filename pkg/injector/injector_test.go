@@ -174,6 +174,10 @@ var _ = Describe("Agent Injector", func() {
 									Name:  "HEIST_AGENT_URL",
 									Value: "http://localhost:13037",
 								},
+								{
+									Name:  "HEIST_AGENT_TOKEN_PATH",
+									Value: "/heist/agent-token",
+								},
 							},
 							TerminationMessagePath:   "/dev/termination-log",
 							TerminationMessagePolicy: "File",
@@ -521,6 +525,10 @@ var _ = Describe("Agent Injector", func() {
 									Name:  "HEIST_AGENT_URL",
 									Value: "http://localhost:13037",
 								},
+								{
+									Name:  "HEIST_AGENT_TOKEN_PATH",
+									Value: "/heist/agent-token",
+								},
 							},
 							ImagePullPolicy: corev1.PullAlways,
 						},
@@ -736,6 +744,10 @@ var _ = Describe("Agent Injector", func() {
 									Name:  "HEIST_AGENT_URL",
 									Value: "http://localhost:13037",
 								},
+								{
+									Name:  "HEIST_AGENT_TOKEN_PATH",
+									Value: "/heist/agent-token",
+								},
 							},
 							TerminationMessagePath:   "/dev/termination-log",
 							TerminationMessagePolicy: "File",
@@ -1084,6 +1096,10 @@ var _ = Describe("Agent Injector", func() {
 									Name:  "HEIST_AGENT_URL",
 									Value: "http://localhost:13037",
 								},
+								{
+									Name:  "HEIST_AGENT_TOKEN_PATH",
+									Value: "/heist/agent-token",
+								},
 							},
 							TerminationMessagePath:   "/dev/termination-log",
 							TerminationMessagePolicy: "File",
@@ -1469,6 +1485,10 @@ var _ = Describe("Agent Injector", func() {
 									Name:  "HEIST_AGENT_URL",
 									Value: "http://localhost:13037",
 								},
+								{
+									Name:  "HEIST_AGENT_TOKEN_PATH",
+									Value: "/heist/agent-token",
+								},
 							},
 							TerminationMessagePath:   "/dev/termination-log",
 							TerminationMessagePolicy: "File",
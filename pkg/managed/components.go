@@ -1,6 +1,7 @@
 package managed
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/vault"
@@ -53,14 +54,14 @@ var (
 	EncryptPolicy  core.PolicyName       = EncryptPolicyName
 )
 
-func UpdateManagedTransitEngine(api vault.API) error {
-	if err := api.UpdateTransitEngine(managedTransitEngine); err != nil {
+func UpdateManagedTransitEngine(ctx context.Context, api vault.API) error {
+	if err := api.UpdateTransitEngine(ctx, managedTransitEngine); err != nil {
 		return err
 	}
 
-	if err := api.UpdateTransitKey(managedTransitEngine, managedTransitKey); err != nil {
+	if err := api.UpdateTransitKey(ctx, managedTransitEngine, managedTransitKey); err != nil {
 		return err
 	}
 
-	return api.UpdatePolicy(managedEncryptPolicy)
+	return api.UpdatePolicy(ctx, managedEncryptPolicy)
 }
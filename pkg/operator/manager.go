@@ -1,6 +1,7 @@
 package operator
 
 import (
+	"context"
 	"errors"
 	"strconv"
 
@@ -23,7 +24,7 @@ type Builder interface {
 	WithRestConfig(config *rest.Config) Builder
 	WithOptions(options controllerruntime.Options) Builder
 	Register(component ...Component) Builder
-	Complete() (manager.Manager, error)
+	Complete(ctx context.Context) (manager.Manager, error)
 }
 
 type builder struct {
@@ -53,8 +54,8 @@ func (b *builder) WithOptions(options controllerruntime.Options) Builder {
 	return b
 }
 
-func (b *builder) Complete() (manager.Manager, error) {
-	if err := managed.UpdateManagedTransitEngine(b.API); err != nil {
+func (b *builder) Complete(ctx context.Context) (manager.Manager, error) {
+	if err := managed.UpdateManagedTransitEngine(ctx, b.API); err != nil {
 		log.Error(err, "failed to updated managed components for operator")
 		return nil, err
 	}
@@ -42,6 +42,15 @@ var operatorPolicyRules = []*policy.Rule{
 			policy.DeleteCapability,
 		},
 	},
+	{
+		Path: "sys/policies/password/*",
+		Capabilities: []policy.Capability{
+			policy.CreateCapability,
+			policy.ReadCapability,
+			policy.UpdateCapability,
+			policy.DeleteCapability,
+		},
+	},
 	{
 		Path: "sys/mounts/managed/*",
 		Capabilities: []policy.Capability{
@@ -23,26 +23,28 @@ const (
 )
 
 type SetupConfig struct {
-	VaultNamespace       string
-	VaultServiceName     string
-	VaultPort            string
-	VaultURL             string
-	VaultCAs             []string
-	VaultToken           string
-	KubernetesHost       string
-	KubernetesJWTIssuer  string
-	KubernetesJWTCACert  string
-	KubernetesJWTPemKeys []string
-	PolicyName           string
-	RoleName             string
-	HeistNamespace       string
-	HeistServiceAccount  string
-	RESTConfig           *rest.Config
-	Quiet                bool
-	VaultScheme          string
+	VaultNamespace           string
+	VaultServiceName         string
+	VaultPort                string
+	VaultURL                 string
+	VaultCAs                 []string
+	VaultToken               string
+	VaultEnterpriseNamespace string
+	KubernetesHost           string
+	KubernetesJWTIssuer      string
+	KubernetesJWTCACert      string
+	KubernetesJWTPemKeys     []string
+	PolicyName               string
+	RoleName                 string
+	HeistNamespace           string
+	HeistServiceAccount      string
+	RESTConfig               *rest.Config
+	Quiet                    bool
+	VaultScheme              string
 }
 
 type setupManager struct {
+	Context      context.Context
 	StepManager  *stepManager
 	WaitGroup    sync.WaitGroup
 	Config       *SetupConfig
@@ -50,10 +52,11 @@ type setupManager struct {
 	ErrorChannel chan error
 }
 
-func SetupOperator(config *SetupConfig) error {
+func SetupOperator(ctx context.Context, config *SetupConfig) error {
 	stepChannel := make(chan *Step)
 
 	setup := &setupManager{
+		Context:      ctx,
 		StepChannel:  stepChannel,
 		ErrorChannel: make(chan error, 1),
 		StepManager: &stepManager{
@@ -84,6 +87,8 @@ func (s *setupManager) runSetup() {
 func (s *setupManager) performSetup() error {
 	defer s.StepManager.Complete()
 
+	ctx := s.Context
+
 	var vaultURL string
 	if s.Config.VaultURL == "" {
 		s.StepManager.NextStep("Fetch Vault Service")
@@ -94,7 +99,7 @@ func (s *setupManager) performSetup() error {
 			return err
 		}
 
-		service, err := clientSet.CoreV1().Services(s.Config.VaultNamespace).Get(context.TODO(), s.Config.VaultServiceName, metav1.GetOptions{})
+		service, err := clientSet.CoreV1().Services(s.Config.VaultNamespace).Get(ctx, s.Config.VaultServiceName, metav1.GetOptions{})
 		if err != nil {
 			s.StepManager.StepFailed()
 			return err
@@ -123,6 +128,7 @@ func (s *setupManager) performSetup() error {
 
 	api, err := vault.NewAPI().
 		WithAddressFrom(core.Value(vaultURL)).
+		WithNamespaceFrom(core.Value(s.Config.VaultEnterpriseNamespace)).
 		WithCAsFrom(cas...).
 		WithTokenFrom(core.Value(s.Config.VaultToken)).
 		Complete()
@@ -133,7 +139,7 @@ func (s *setupManager) performSetup() error {
 
 	s.StepManager.NextStep("Write Heist Operator Policy")
 
-	err = api.UpdatePolicy(&policy.Policy{
+	err = api.UpdatePolicy(ctx, &policy.Policy{
 		Name:  s.Config.PolicyName,
 		Rules: operatorPolicyRules,
 	})
@@ -150,7 +156,7 @@ func (s *setupManager) performSetup() error {
 		return err
 	}
 
-	err = api.UpdateKubernetesAuthMethod(&kubernetesauth.Method{
+	err = api.UpdateKubernetesAuthMethod(ctx, &kubernetesauth.Method{
 		Path: authMethodPath,
 		Config: &kubernetesauth.Config{
 			KubernetesHost:       s.Config.KubernetesHost,
@@ -169,7 +175,7 @@ func (s *setupManager) performSetup() error {
 
 	s.StepManager.NextStep("Write Heist Operator Kubernetes Auth Role")
 
-	err = api.UpdateKubernetesAuthRole(managed.KubernetesAuth, &kubernetesauth.Role{
+	err = api.UpdateKubernetesAuthRole(ctx, managed.KubernetesAuth, &kubernetesauth.Role{
 		Name:                 s.Config.RoleName,
 		Policies:             []core.PolicyName{core.PolicyName(s.Config.PolicyName)},
 		BoundNamespaces:      []string{s.Config.HeistNamespace},
@@ -187,6 +187,8 @@ func getPluralNaming(singularName string) (pluralName string, err error) {
 	case "vaulttransitkey":
 		return fmt.Sprintf("%ss", strings.ToLower(singularName)), nil
 	case "vaultcertificateauthority":
+		fallthrough
+	case "vaultpasswordpolicy":
 		regex := regexp.MustCompile("y$")
 		return regex.ReplaceAllString(strings.ToLower(singularName), "ies"), nil
 	}
@@ -69,6 +69,11 @@ func Test_getPluralNaming(t *testing.T) {
 			args:           args{singularName: "VaultCertificateAuthority"},
 			wantPluralName: "vaultcertificateauthorities",
 		},
+		{
+			name:           "should convert VaultPasswordPolicy",
+			args:           args{singularName: "VaultPasswordPolicy"},
+			wantPluralName: "vaultpasswordpolicies",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
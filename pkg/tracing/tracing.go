@@ -0,0 +1,114 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"github.com/youniqx/heist/pkg/erx"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrSetupFailed is returned when the trace exporter could not be set up.
+var ErrSetupFailed = erx.New("Tracing", "failed to set up tracing")
+
+const tracerName = "github.com/youniqx/heist"
+
+// Config configures the export of traces to an OpenTelemetry collector.
+type Config struct {
+	// ServiceName is reported as service.name resource attribute of all spans.
+	ServiceName string
+	// Endpoint is the URL of the OTLP HTTP receiver of the collector, e.g.
+	// http://otel-collector:4318. If it is empty, the standard OTEL_EXPORTER_OTLP_*
+	// environment variables are used instead.
+	Endpoint string
+	// SampleRatio is the fraction of traces which are sampled, between 0 and 1.
+	// Traces started by a sampled parent are always sampled.
+	SampleRatio float64
+}
+
+// ShutdownFunc flushes all pending spans and stops the export of traces.
+type ShutdownFunc func(ctx context.Context) error
+
+// Setup configures the global tracer provider and trace context propagation.
+// Traces are only exported if an endpoint is configured, otherwise all spans
+// are discarded.
+func Setup(ctx context.Context, config *Config) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !isEnabled(config) {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var options []otlptracehttp.Option
+	if config.Endpoint != "" {
+		options = append(options, otlptracehttp.WithEndpointURL(config.Endpoint))
+	}
+
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, ErrSetupFailed.WithDetails("failed to create OTLP trace exporter").WithCause(err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(config.ServiceName),
+	))
+	if err != nil {
+		return nil, ErrSetupFailed.WithDetails("failed to create trace resource").WithCause(err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func isEnabled(config *Config) bool {
+	if config.Endpoint != "" {
+		return true
+	}
+
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// Start starts a new span as child of the span in the passed context.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// StartClient starts a new span for an outgoing request as child of the span
+// in the passed context.
+func StartClient(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...), trace.WithSpanKind(trace.SpanKindClient))
+}
+
+// RecordError marks the span as failed if err is not nil.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// Inject writes the trace context of the passed context to the headers of an
+// outgoing request.
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
@@ -0,0 +1,119 @@
+package tracing_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type collector struct {
+	lock  sync.Mutex
+	spans map[string][]byte
+}
+
+func (c *collector) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	export := &collectortrace.ExportTraceServiceRequest{}
+	if err := proto.Unmarshal(body, export); err != nil {
+		writer.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, resourceSpans := range export.GetResourceSpans() {
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			for _, span := range scopeSpans.GetSpans() {
+				c.spans[span.GetName()] = span.GetTraceId()
+			}
+		}
+	}
+
+	writer.Header().Set("Content-Type", "application/x-protobuf")
+	writer.WriteHeader(http.StatusOK)
+}
+
+func TestSetup_ExportsSpansWithPropagatedContext(t *testing.T) {
+	receiver := &collector{spans: make(map[string][]byte)}
+	collectorServer := httptest.NewServer(receiver)
+	defer collectorServer.Close()
+
+	var traceParent string
+	targetServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		traceParent = request.Header.Get("traceparent")
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer targetServer.Close()
+
+	shutdown, err := tracing.Setup(context.Background(), &tracing.Config{
+		ServiceName: "heist-test",
+		Endpoint:    collectorServer.URL,
+		SampleRatio: 1,
+	})
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+
+	targetURL, _ := url.Parse(targetServer.URL)
+	ctx, span := tracing.Start(context.Background(), "VaultBinding.Reconcile")
+	_, err = httpclient.NewClient(targetURL).Perform(httpclient.Context(ctx), httpclient.Path("/v1/sys/health"))
+	span.End()
+
+	if err != nil {
+		t.Fatalf("Perform() error = %v", err)
+	}
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown() error = %v", err)
+	}
+
+	if traceParent == "" {
+		t.Errorf("traceparent header has not been sent")
+	}
+
+	receiver.lock.Lock()
+	defer receiver.lock.Unlock()
+
+	parentTraceID, ok := receiver.spans["VaultBinding.Reconcile"]
+	if !ok {
+		t.Fatalf("reconcile span has not been exported, got %v", receiver.spans)
+	}
+
+	requestTraceID, ok := receiver.spans["HTTP GET"]
+	if !ok {
+		t.Fatalf("request span has not been exported, got %v", receiver.spans)
+	}
+
+	if string(parentTraceID) != string(requestTraceID) {
+		t.Errorf("request span is not part of the reconcile trace")
+	}
+}
+
+func TestSetup_DisabledWithoutEndpoint(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	shutdown, err := tracing.Setup(context.Background(), &tracing.Config{ServiceName: "heist-test"})
+	if err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+}
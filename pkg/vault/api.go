@@ -1,12 +1,19 @@
 package vault
 
 import (
+	"context"
+	"strings"
+
+	"github.com/youniqx/heist/pkg/vault/approleauth"
 	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/certauth"
 	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/jwtauth"
 	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
 	"github.com/youniqx/heist/pkg/vault/kvengine"
 	"github.com/youniqx/heist/pkg/vault/kvsecret"
 	"github.com/youniqx/heist/pkg/vault/mount"
+	"github.com/youniqx/heist/pkg/vault/passwordpolicy"
 	"github.com/youniqx/heist/pkg/vault/pki"
 	"github.com/youniqx/heist/pkg/vault/policy"
 	"github.com/youniqx/heist/pkg/vault/random"
@@ -18,13 +25,20 @@ type API interface {
 	kvengine.API
 	transit.API
 	policy.API
+	passwordpolicy.API
 	mount.API
 	random.API
 	auth.API
 	kubernetesauth.API
+	approleauth.API
+	jwtauth.API
+	certauth.API
 	pki.API
 	GetAddress() string
+	GetAddresses() []string
+	GetNamespace() string
 	GetCACerts() []string
+	RevokeToken(ctx context.Context) error
 }
 
 type (
@@ -32,11 +46,15 @@ type (
 	kvEngineAPI       = kvengine.API
 	transitAPI        = transit.API
 	policyAPI         = policy.API
+	passwordPolicyAPI = passwordpolicy.API
 	mountAPI          = mount.API
 	randomAPI         = random.API
 	authAPI           = auth.API
 	pkiAPI            = pki.API
 	kubernetesAuthAPI = kubernetesauth.API
+	appRoleAuthAPI    = approleauth.API
+	jwtAuthAPI        = jwtauth.API
+	certAuthAPI       = certauth.API
 )
 
 type vaultAPI struct {
@@ -44,14 +62,25 @@ type vaultAPI struct {
 	kvEngineAPI
 	transitAPI
 	policyAPI
+	passwordPolicyAPI
 	mountAPI
 	randomAPI
 	authAPI
 	kubernetesAuthAPI
+	appRoleAuthAPI
+	jwtAuthAPI
+	certAuthAPI
 	pkiAPI
 
-	Address string
-	CACerts []string
+	Core      core.API
+	Address   string
+	Addresses []string
+	Namespace string
+	CACerts   []string
+}
+
+func (v *vaultAPI) RevokeToken(ctx context.Context) error {
+	return v.Core.RevokeToken(ctx)
 }
 
 func (v *vaultAPI) GetCACerts() []string {
@@ -62,10 +91,24 @@ func (v *vaultAPI) GetAddress() string {
 	return v.Address
 }
 
+func (v *vaultAPI) GetAddresses() []string {
+	return v.Addresses
+}
+
+func (v *vaultAPI) GetNamespace() string {
+	return v.Namespace
+}
+
 type Builder interface {
-	WithAddressFrom(source core.StringSource) Builder
+	WithAddressFrom(sources ...core.StringSource) Builder
+	WithNamespaceFrom(source core.StringSource) Builder
 	WithTokenFrom(source core.StringSource) Builder
 	WithCAsFrom(source ...core.StringSource) Builder
+	WithClientCertificateFrom(cert core.StringSource, key core.StringSource) Builder
+	WithClientCertFrom(source core.StringSource) Builder
+	WithClientKeyFrom(source core.StringSource) Builder
+	WithRetryPolicy(policy core.RetryPolicy) Builder
+	WithRequestLimits(limits *core.RequestLimits) Builder
 	WithAuthProvider(provider core.AuthProvider) Builder
 	Complete() (API, error)
 }
@@ -75,15 +118,28 @@ func NewAPI() Builder {
 }
 
 type builder struct {
-	Address      core.StringSource
-	AuthOption   authOptionFactory
-	CACertOption func() ([]string, error)
+	Addresses         []core.StringSource
+	Namespace         core.StringSource
+	AuthOption        authOptionFactory
+	CACertOption      func() ([]string, error)
+	ClientCertificate core.StringSource
+	ClientKey         core.StringSource
+	RetryPolicy       core.RetryPolicy
+	RequestLimits     *core.RequestLimits
 }
 
 type authOptionFactory func() (core.Option, error)
 
-func (b *builder) WithAddressFrom(source core.StringSource) Builder {
-	b.Address = source
+// WithAddressFrom sets the addresses of the Vault nodes. Every source may
+// contain a comma separated list of addresses. The first address is the
+// primary one, all further addresses are used for failover.
+func (b *builder) WithAddressFrom(sources ...core.StringSource) Builder {
+	b.Addresses = sources
+	return b
+}
+
+func (b *builder) WithNamespaceFrom(source core.StringSource) Builder {
+	b.Namespace = source
 	return b
 }
 
@@ -116,6 +172,35 @@ func (b *builder) WithCAsFrom(sources ...core.StringSource) Builder {
 	return b
 }
 
+func (b *builder) WithClientCertificateFrom(cert core.StringSource, key core.StringSource) Builder {
+	return b.WithClientCertFrom(cert).WithClientKeyFrom(key)
+}
+
+// WithClientCertFrom sets the source of the TLS client certificate presented to
+// Vault. The source is read again whenever a new connection is established, so
+// rotated certificates are used without recreating the API.
+func (b *builder) WithClientCertFrom(source core.StringSource) Builder {
+	b.ClientCertificate = source
+	return b
+}
+
+// WithClientKeyFrom sets the source of the private key of the TLS client certificate.
+func (b *builder) WithClientKeyFrom(source core.StringSource) Builder {
+	b.ClientKey = source
+	return b
+}
+
+func (b *builder) WithRetryPolicy(policy core.RetryPolicy) Builder {
+	b.RetryPolicy = policy
+	return b
+}
+
+// WithRequestLimits limits the rate and concurrency of requests sent to Vault.
+func (b *builder) WithRequestLimits(limits *core.RequestLimits) Builder {
+	b.RequestLimits = limits
+	return b
+}
+
 func (b *builder) WithAuthProvider(provider core.AuthProvider) Builder {
 	b.AuthOption = func() (core.Option, error) {
 		return core.WithAuthProvider(provider), nil
@@ -129,9 +214,17 @@ func (b *builder) WithAuthProvider(provider core.AuthProvider) Builder {
 const DefaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 
 func (b *builder) Complete() (API, error) {
-	address, err := b.Address.FetchStringValue()
+	addresses, err := b.fetchAddresses()
 	if err != nil {
-		return nil, core.ErrAPIError.WithDetails("failed to fetch Vault address").WithCause(err)
+		return nil, err
+	}
+
+	var namespace string
+	if b.Namespace != nil {
+		namespace, err = b.Namespace.FetchStringValue()
+		if err != nil {
+			return nil, core.ErrAPIError.WithDetails("failed to fetch Vault namespace").WithCause(err)
+		}
 	}
 
 	authOption, err := b.AuthOption()
@@ -147,25 +240,76 @@ func (b *builder) Complete() (API, error) {
 		}
 	}
 
-	coreAPI, err := core.NewCoreAPI(address, core.WithCACerts(caCerts...), authOption)
+	options := []core.Option{
+		core.WithCACerts(caCerts...),
+		core.WithNamespace(namespace),
+		core.WithFailoverAddresses(addresses[1:]...),
+	}
+
+	if b.RetryPolicy != nil {
+		options = append(options, core.WithRetryPolicy(b.RetryPolicy))
+	}
+
+	if b.RequestLimits != nil {
+		options = append(options, core.WithRequestLimits(b.RequestLimits))
+	}
+
+	switch {
+	case b.ClientCertificate != nil && b.ClientKey != nil:
+		options = append(options, core.WithClientCertificateFrom(b.ClientCertificate, b.ClientKey))
+	case b.ClientCertificate != nil || b.ClientKey != nil:
+		return nil, core.ErrAPIError.WithDetails("Vault client certificate and key have to be configured together")
+	}
+
+	coreAPI, err := core.NewCoreAPI(addresses[0], append(options, authOption)...)
 	if err != nil {
 		return nil, core.ErrAPIError.WithDetails("failed to create core api instance").WithCause(err)
 	}
 
 	mountAPI := mount.NewAPI(coreAPI)
 	authAPI := auth.NewAPI(coreAPI)
+	kvEngineAPI := kvengine.NewAPI(coreAPI, mountAPI)
 
 	return &vaultAPI{
-		kvSecretAPI:       kvsecret.NewAPI(coreAPI),
-		kvEngineAPI:       kvengine.NewAPI(coreAPI, mountAPI),
+		kvSecretAPI:       kvsecret.NewAPI(coreAPI, kvEngineAPI),
+		kvEngineAPI:       kvEngineAPI,
 		policyAPI:         policy.NewAPI(coreAPI),
+		passwordPolicyAPI: passwordpolicy.NewAPI(coreAPI),
 		transitAPI:        transit.NewAPI(coreAPI, mountAPI),
 		randomAPI:         random.NewAPI(coreAPI),
 		kubernetesAuthAPI: kubernetesauth.NewAPI(coreAPI, authAPI),
+		appRoleAuthAPI:    approleauth.NewAPI(coreAPI, authAPI),
+		jwtAuthAPI:        jwtauth.NewAPI(coreAPI, authAPI),
+		certAuthAPI:       certauth.NewAPI(coreAPI, authAPI),
 		authAPI:           authAPI,
 		mountAPI:          mountAPI,
 		pkiAPI:            pki.NewAPI(coreAPI, mountAPI),
-		Address:           address,
+		Core:              coreAPI,
+		Address:           addresses[0],
+		Addresses:         addresses,
+		Namespace:         namespace,
 		CACerts:           caCerts,
 	}, nil
 }
+
+func (b *builder) fetchAddresses() ([]string, error) {
+	addresses := make([]string, 0, len(b.Addresses))
+	for _, source := range b.Addresses {
+		value, err := source.FetchStringValue()
+		if err != nil {
+			return nil, core.ErrAPIError.WithDetails("failed to fetch Vault address").WithCause(err)
+		}
+
+		for _, address := range strings.Split(value, ",") {
+			if address = strings.TrimSpace(address); address != "" {
+				addresses = append(addresses, address)
+			}
+		}
+	}
+
+	if len(addresses) == 0 {
+		return nil, core.ErrAPIError.WithDetails("no Vault address has been configured")
+	}
+
+	return addresses, nil
+}
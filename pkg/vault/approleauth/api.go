@@ -0,0 +1,47 @@
+package approleauth
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type RoleEntity interface {
+	core.RoleNameEntity
+	core.RolePoliciesEntity
+}
+
+type API interface {
+	UpdateAppRoleAuthMethod(ctx context.Context, method core.MountPathEntity) error
+	UpdateAppRoleAuthRole(ctx context.Context, method core.MountPathEntity, role RoleEntity) error
+	DeleteAppRoleAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error
+	ReadAppRoleRoleID(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (string, error)
+	CreateAppRoleSecretID(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (string, error)
+	LoginWithAppRoleAuth(ctx context.Context, method core.MountPathEntity, roleID string, secretID string) (*core.AuthResponse, error)
+}
+
+type Role struct {
+	Name     string
+	Policies []core.PolicyName
+}
+
+func (r *Role) GetRoleName() (string, error) {
+	return r.Name, nil
+}
+
+func (r *Role) GetRolePolicies() ([]core.PolicyName, error) {
+	return r.Policies, nil
+}
+
+type appRoleAuthAPI struct {
+	Core core.API
+	Auth auth.API
+}
+
+func NewAPI(coreAPI core.API, authAPI auth.API) API {
+	return &appRoleAuthAPI{
+		Core: coreAPI,
+		Auth: authAPI,
+	}
+}
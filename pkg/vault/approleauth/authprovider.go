@@ -0,0 +1,70 @@
+package approleauth
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func AuthProvider(method core.MountPathEntity, roleID core.StringSource, secretID core.StringSource) core.AuthProvider {
+	return &authProvider{
+		Method:   method,
+		RoleID:   roleID,
+		SecretID: secretID,
+	}
+}
+
+type authProvider struct {
+	Method   core.MountPathEntity
+	RoleID   core.StringSource
+	SecretID core.StringSource
+}
+
+type loginRequest struct {
+	RoleID   string `json:"role_id"`
+	SecretID string `json:"secret_id"`
+}
+
+func (a *authProvider) Authenticate(ctx context.Context, api core.API) (*core.AuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "vault.approleauth.Authenticate")
+	defer span.End()
+
+	log := api.Log().WithValues("method", "LoginWithAppRoleAuth")
+
+	path, err := a.Method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	roleID, err := a.RoleID.FetchStringValue()
+	if err != nil {
+		log.Info("failed to fetch approle role id", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to fetch approle role id").WithCause(err)
+	}
+
+	secretID, err := a.SecretID.FetchStringValue()
+	if err != nil {
+		log.Info("failed to fetch approle secret id", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to fetch approle secret id").WithCause(err)
+	}
+
+	loginPath := filepath.Join("/v1/auth", path, "login")
+	request := &loginRequest{
+		RoleID:   roleID,
+		SecretID: secretID,
+	}
+	response := &core.AuthResponse{}
+
+	if err := api.MakeRequest(ctx, core.MethodPost, loginPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to login using approle authentication", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to login using approle authentication").WithCause(err)
+	}
+
+	return response, nil
+}
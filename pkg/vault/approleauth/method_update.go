@@ -0,0 +1,36 @@
+package approleauth
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (a *appRoleAuthAPI) UpdateAppRoleAuthMethod(ctx context.Context, method core.MountPathEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.approleauth.UpdateAppRoleAuthMethod")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "UpdateAppRoleAuthMethod")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	authMethod := &auth.Method{
+		Path: path,
+		Type: auth.MethodAppRole,
+	}
+
+	if err := a.Auth.EnsureAuthMethod(ctx, authMethod); err != nil {
+		log.Info("failed to create approle auth method", "error", err)
+		return core.ErrAPIError.WithDetails("failed to create approle auth method").WithCause(err)
+	}
+
+	return nil
+}
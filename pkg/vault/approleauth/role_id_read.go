@@ -0,0 +1,59 @@
+package approleauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type readRoleIDResponse struct {
+	Data readRoleIDResponseData `json:"data"`
+}
+
+type readRoleIDResponseData struct {
+	RoleID string `json:"role_id"`
+}
+
+func (a *appRoleAuthAPI) ReadAppRoleRoleID(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (string, error) {
+	ctx, span := tracing.Start(ctx, "vault.approleauth.ReadAppRoleRoleID")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "ReadAppRoleRoleID")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	roleName, err := role.GetRoleName()
+	if err != nil {
+		log.Info("failed to get approle role name", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to get approle role name").WithCause(err)
+	}
+
+	log = log.WithValues("role", roleName)
+
+	fetchPath := filepath.Join("/v1/auth", path, "role", roleName, "role-id")
+	response := &readRoleIDResponse{}
+
+	if err := a.Core.MakeRequest(ctx, core.MethodGet, fetchPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to fetch approle role id", "error", err)
+
+		var responseError *core.VaultHTTPError
+		if errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound {
+			return "", core.ErrDoesNotExist.WithCause(err)
+		}
+
+		return "", core.ErrAPIError.WithDetails("failed to fetch approle role id").WithCause(err)
+	}
+
+	return response.Data.RoleID, nil
+}
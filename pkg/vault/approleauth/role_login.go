@@ -0,0 +1,21 @@
+package approleauth
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (a *appRoleAuthAPI) LoginWithAppRoleAuth(ctx context.Context, method core.MountPathEntity, roleID string, secretID string) (*core.AuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "vault.approleauth.LoginWithAppRoleAuth")
+	defer span.End()
+
+	provider := &authProvider{
+		Method:   method,
+		RoleID:   core.Value(roleID),
+		SecretID: core.Value(secretID),
+	}
+
+	return provider.Authenticate(ctx, a.Core)
+}
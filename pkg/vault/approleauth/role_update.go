@@ -0,0 +1,57 @@
+package approleauth
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type createRoleRequest struct {
+	TokenPolicies []core.PolicyName `json:"token_policies"`
+}
+
+func (a *appRoleAuthAPI) UpdateAppRoleAuthRole(ctx context.Context, method core.MountPathEntity, role RoleEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.approleauth.UpdateAppRoleAuthRole")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "UpdateAppRoleAuthRole")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	roleName, err := role.GetRoleName()
+	if err != nil {
+		log.Info("failed to get approle role name", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get approle role name").WithCause(err)
+	}
+
+	log = log.WithValues("role", roleName)
+
+	rolePolicies, err := role.GetRolePolicies()
+	if err != nil {
+		log.Info("failed to get approle role policies", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get approle role policies").WithCause(err)
+	}
+
+	log = log.WithValues("policies", rolePolicies)
+
+	rolePath := filepath.Join("/v1/auth", path, "role", roleName)
+	request := &createRoleRequest{
+		TokenPolicies: rolePolicies,
+	}
+
+	if err := a.Core.MakeRequest(ctx, core.MethodPost, rolePath, httpclient.JSON(request), nil); err != nil {
+		log.Info("failed to create approle auth role", "error", err)
+		return core.ErrAPIError.WithDetails("failed to create approle auth role").WithCause(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package approleauth
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type createSecretIDResponse struct {
+	Data createSecretIDResponseData `json:"data"`
+}
+
+type createSecretIDResponseData struct {
+	SecretID string `json:"secret_id"`
+}
+
+func (a *appRoleAuthAPI) CreateAppRoleSecretID(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (string, error) {
+	ctx, span := tracing.Start(ctx, "vault.approleauth.CreateAppRoleSecretID")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "CreateAppRoleSecretID")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	roleName, err := role.GetRoleName()
+	if err != nil {
+		log.Info("failed to get approle role name", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to get approle role name").WithCause(err)
+	}
+
+	log = log.WithValues("role", roleName)
+
+	createPath := filepath.Join("/v1/auth", path, "role", roleName, "secret-id")
+	response := &createSecretIDResponse{}
+
+	if err := a.Core.MakeRequest(ctx, core.MethodPost, createPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to create approle secret id", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to create approle secret id").WithCause(err)
+	}
+
+	return response.Data.SecretID, nil
+}
@@ -1,13 +1,17 @@
 package auth
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
 
 type API interface {
-	HasAuthMethod(auth core.MountPathEntity) (bool, error)
-	ListAuthMethods() ([]*Method, error)
-	DeleteAuthMethod(auth core.MountPathEntity) error
-	CreateAuthMethod(auth MethodEntity) error
-	ReadAuthMethod(auth core.MountPathEntity) (*Method, error)
+	HasAuthMethod(ctx context.Context, auth core.MountPathEntity) (bool, error)
+	ListAuthMethods(ctx context.Context) ([]*Method, error)
+	DeleteAuthMethod(ctx context.Context, auth core.MountPathEntity) error
+	CreateAuthMethod(ctx context.Context, auth MethodEntity) error
+	ReadAuthMethod(ctx context.Context, auth core.MountPathEntity) (*Method, error)
+	EnsureAuthMethod(ctx context.Context, auth MethodEntity) error
 }
 
 type Type string
@@ -17,6 +21,12 @@ const (
 	MethodKubernetes Type = "kubernetes"
 	// MethodToken authenticates all requests with a vault issued token.
 	MethodToken Type = "token"
+	// MethodAppRole authenticates using a role id and secret id.
+	MethodAppRole Type = "approle"
+	// MethodJWT authenticates using a JWT signed by a trusted issuer, e.g. a projected service account token.
+	MethodJWT Type = "jwt"
+	// MethodCert authenticates using the TLS client certificate presented to Vault.
+	MethodCert Type = "cert"
 )
 
 type MethodEntity interface {
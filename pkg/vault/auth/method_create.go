@@ -1,9 +1,11 @@
 package auth
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -11,7 +13,10 @@ type createAuthRequest struct {
 	Type Type `json:"type"`
 }
 
-func (a *authAPI) CreateAuthMethod(auth MethodEntity) error {
+func (a *authAPI) CreateAuthMethod(ctx context.Context, auth MethodEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.auth.CreateAuthMethod")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "CreateAuthMethod")
 
 	path, err := auth.GetMountPath()
@@ -35,7 +40,7 @@ func (a *authAPI) CreateAuthMethod(auth MethodEntity) error {
 		Type: method,
 	}
 
-	if err := a.Core.MakeRequest(core.MethodPost, authPath, httpclient.JSON(request), nil); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodPost, authPath, httpclient.JSON(request), nil); err != nil {
 		log.Info("unable to create auth method", "error", err)
 		return core.ErrAPIError.WithDetails("failed to create auth method").WithCause(err)
 	}
@@ -1,12 +1,17 @@
 package auth
 
 import (
+	"context"
 	"path/filepath"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (a *authAPI) DeleteAuthMethod(auth core.MountPathEntity) error {
+func (a *authAPI) DeleteAuthMethod(ctx context.Context, auth core.MountPathEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.auth.DeleteAuthMethod")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "DeleteAuthMethod")
 
 	path, err := auth.GetMountPath()
@@ -18,7 +23,7 @@ func (a *authAPI) DeleteAuthMethod(auth core.MountPathEntity) error {
 	log = log.WithValues("path", path)
 
 	authPath := filepath.Join("/v1/sys/auth", path)
-	if err := a.Core.MakeRequest(core.MethodDelete, authPath, nil, nil); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodDelete, authPath, nil, nil); err != nil {
 		log.Info("unable to delete auth method", "error", err)
 		return core.ErrAPIError.WithDetails("failed to delete auth method").WithCause(err)
 	}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (a *authAPI) EnsureAuthMethod(ctx context.Context, auth MethodEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.auth.EnsureAuthMethod")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "EnsureAuthMethod")
+
+	path, err := auth.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	exists, err := a.HasAuthMethod(ctx, auth)
+	if err != nil {
+		log.Info("failed to check if auth method exists", "error", err)
+		return core.ErrAPIError.WithDetails("failed to check if auth method exists").WithCause(err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	if err := a.CreateAuthMethod(ctx, auth); err != nil {
+		log.Info("failed to create auth method", "error", err)
+		return core.ErrAPIError.WithDetails("failed to create auth method").WithCause(err)
+	}
+
+	return nil
+}
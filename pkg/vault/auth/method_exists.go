@@ -1,8 +1,15 @@
 package auth
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (a *authAPI) HasAuthMethod(ctx context.Context, auth core.MountPathEntity) (bool, error) {
+	ctx, span := tracing.Start(ctx, "vault.auth.HasAuthMethod")
+	defer span.End()
 
-func (a *authAPI) HasAuthMethod(auth core.MountPathEntity) (bool, error) {
 	log := a.Core.Log().WithValues("method", "HasAuthMethod")
 
 	path, err := auth.GetMountPath()
@@ -13,7 +20,7 @@ func (a *authAPI) HasAuthMethod(auth core.MountPathEntity) (bool, error) {
 
 	log = log.WithValues("path", path)
 
-	methods, err := a.ListAuthMethods()
+	methods, err := a.ListAuthMethods(ctx)
 	if err != nil {
 		log.Info("failed to list auth methods", "error", err)
 		return false, core.ErrAPIError.WithDetails("failed to list auth methods").WithCause(err)
@@ -1,9 +1,11 @@
 package auth
 
 import (
+	"context"
 	"strings"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -15,11 +17,14 @@ type listMethodResponse struct {
 	Data map[string]*methodInfo `json:"data"`
 }
 
-func (a *authAPI) ListAuthMethods() ([]*Method, error) {
+func (a *authAPI) ListAuthMethods(ctx context.Context) ([]*Method, error) {
+	ctx, span := tracing.Start(ctx, "vault.auth.ListAuthMethods")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "ListAuthMethods")
 
 	response := &listMethodResponse{}
-	if err := a.Core.MakeRequest(core.MethodGet, "/v1/sys/auth", nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodGet, "/v1/sys/auth", nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("unable to list auth methods", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to list auth methods").WithCause(err)
 	}
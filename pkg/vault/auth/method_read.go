@@ -1,8 +1,15 @@
 package auth
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (a *authAPI) ReadAuthMethod(ctx context.Context, auth core.MountPathEntity) (*Method, error) {
+	ctx, span := tracing.Start(ctx, "vault.auth.ReadAuthMethod")
+	defer span.End()
 
-func (a *authAPI) ReadAuthMethod(auth core.MountPathEntity) (*Method, error) {
 	log := a.Core.Log().WithValues("method", "ReadAuthMethod")
 
 	path, err := auth.GetMountPath()
@@ -13,7 +20,7 @@ func (a *authAPI) ReadAuthMethod(auth core.MountPathEntity) (*Method, error) {
 
 	log = log.WithValues("path", path)
 
-	methods, err := a.ListAuthMethods()
+	methods, err := a.ListAuthMethods(ctx)
 	if err != nil {
 		log.Info("failed to fetch auth method", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to list auth methods").WithCause(err)
@@ -0,0 +1,57 @@
+package certauth
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type RoleEntity interface {
+	core.RoleNameEntity
+	core.RolePoliciesEntity
+	GetCertificate() (string, error)
+	GetAllowedCommonNames() ([]string, error)
+}
+
+type API interface {
+	UpdateCertAuthMethod(ctx context.Context, method core.MountPathEntity) error
+	UpdateCertAuthRole(ctx context.Context, method core.MountPathEntity, role RoleEntity) error
+	DeleteCertAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error
+	LoginWithCertAuth(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (*core.AuthResponse, error)
+}
+
+type Role struct {
+	Name               string
+	Policies           []core.PolicyName
+	Certificate        string
+	AllowedCommonNames []string
+}
+
+func (r *Role) GetRoleName() (string, error) {
+	return r.Name, nil
+}
+
+func (r *Role) GetRolePolicies() ([]core.PolicyName, error) {
+	return r.Policies, nil
+}
+
+func (r *Role) GetCertificate() (string, error) {
+	return r.Certificate, nil
+}
+
+func (r *Role) GetAllowedCommonNames() ([]string, error) {
+	return r.AllowedCommonNames, nil
+}
+
+type certAuthAPI struct {
+	Core core.API
+	Auth auth.API
+}
+
+func NewAPI(coreAPI core.API, authAPI auth.API) API {
+	return &certAuthAPI{
+		Core: coreAPI,
+		Auth: authAPI,
+	}
+}
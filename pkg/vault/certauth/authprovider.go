@@ -0,0 +1,69 @@
+package certauth
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+// AuthProvider logs in using the TLS certificate auth method. The client certificate
+// itself is presented on the TLS connection, so the Vault API has to be created with
+// a client certificate as well. The role is optional, if it is empty Vault tries
+// all roles of the auth method.
+func AuthProvider(method core.MountPathEntity, role core.StringSource) core.AuthProvider {
+	return &authProvider{
+		Method: method,
+		Role:   role,
+	}
+}
+
+type authProvider struct {
+	Method core.MountPathEntity
+	Role   core.StringSource
+}
+
+type loginRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (a *authProvider) Authenticate(ctx context.Context, api core.API) (*core.AuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "vault.certauth.Authenticate")
+	defer span.End()
+
+	log := api.Log().WithValues("method", "LoginWithCertAuth")
+
+	path, err := a.Method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	var roleName string
+	if a.Role != nil {
+		roleName, err = a.Role.FetchStringValue()
+		if err != nil {
+			log.Info("failed to get cert role name", "error", err)
+			return nil, core.ErrAPIError.WithDetails("failed to fetch cert role name").WithCause(err)
+		}
+	}
+
+	log = log.WithValues("role", roleName)
+
+	loginPath := filepath.Join("/v1/auth", path, "login")
+	request := &loginRequest{
+		Name: roleName,
+	}
+	response := &core.AuthResponse{}
+
+	if err := api.MakeRequest(ctx, core.MethodPost, loginPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to login using cert authentication", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to login using cert authentication").WithCause(err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,36 @@
+package certauth
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (c *certAuthAPI) UpdateCertAuthMethod(ctx context.Context, method core.MountPathEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.certauth.UpdateCertAuthMethod")
+	defer span.End()
+
+	log := c.Core.Log().WithValues("method", "UpdateCertAuthMethod")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	authMethod := &auth.Method{
+		Path: path,
+		Type: auth.MethodCert,
+	}
+
+	if err := c.Auth.EnsureAuthMethod(ctx, authMethod); err != nil {
+		log.Info("failed to create cert auth method", "error", err)
+		return core.ErrAPIError.WithDetails("failed to create cert auth method").WithCause(err)
+	}
+
+	return nil
+}
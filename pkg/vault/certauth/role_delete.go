@@ -0,0 +1,40 @@
+package certauth
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (c *certAuthAPI) DeleteCertAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.certauth.DeleteCertAuthRole")
+	defer span.End()
+
+	log := c.Core.Log().WithValues("method", "DeleteCertAuthRole")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	roleName, err := role.GetRoleName()
+	if err != nil {
+		log.Info("failed to get cert role name", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get cert role name").WithCause(err)
+	}
+
+	log = log.WithValues("role", roleName)
+
+	deletePath := filepath.Join("/v1/auth", path, "certs", roleName)
+	if err := c.Core.MakeRequest(ctx, core.MethodDelete, deletePath, nil, nil); err != nil {
+		log.Info("failed to delete role", "error", err)
+		return core.ErrAPIError.WithDetails("failed to delete cert auth role").WithCause(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package certauth
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (c *certAuthAPI) LoginWithCertAuth(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (*core.AuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "vault.certauth.LoginWithCertAuth")
+	defer span.End()
+
+	log := c.Core.Log().WithValues("method", "LoginWithCertAuth")
+
+	roleName, err := role.GetRoleName()
+	if err != nil {
+		log.Info("failed to get role name", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to get cert role name").WithCause(err)
+	}
+
+	provider := &authProvider{
+		Method: method,
+		Role:   core.Value(roleName),
+	}
+
+	return provider.Authenticate(ctx, c.Core)
+}
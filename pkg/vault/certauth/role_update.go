@@ -0,0 +1,73 @@
+package certauth
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type createRoleRequest struct {
+	Certificate        string            `json:"certificate"`
+	AllowedCommonNames []string          `json:"allowed_common_names,omitempty"`
+	TokenPolicies      []core.PolicyName `json:"token_policies"`
+}
+
+func (c *certAuthAPI) UpdateCertAuthRole(ctx context.Context, method core.MountPathEntity, role RoleEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.certauth.UpdateCertAuthRole")
+	defer span.End()
+
+	log := c.Core.Log().WithValues("method", "UpdateCertAuthRole")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	roleName, err := role.GetRoleName()
+	if err != nil {
+		log.Info("failed to get cert role name", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get cert role name").WithCause(err)
+	}
+
+	log = log.WithValues("role", roleName)
+
+	rolePolicies, err := role.GetRolePolicies()
+	if err != nil {
+		log.Info("failed to get cert role policies", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get cert role policies").WithCause(err)
+	}
+
+	log = log.WithValues("policies", rolePolicies)
+
+	certificate, err := role.GetCertificate()
+	if err != nil {
+		log.Info("failed to get cert role certificate", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get cert role certificate").WithCause(err)
+	}
+
+	allowedCommonNames, err := role.GetAllowedCommonNames()
+	if err != nil {
+		log.Info("failed to get cert role allowed common names", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get cert role allowed common names").WithCause(err)
+	}
+
+	rolePath := filepath.Join("/v1/auth", path, "certs", roleName)
+	request := &createRoleRequest{
+		Certificate:        certificate,
+		AllowedCommonNames: allowedCommonNames,
+		TokenPolicies:      rolePolicies,
+	}
+
+	if err := c.Core.MakeRequest(ctx, core.MethodPost, rolePath, httpclient.JSON(request), nil); err != nil {
+		log.Info("failed to create cert auth role", "error", err)
+		return core.ErrAPIError.WithDetails("failed to create cert auth role").WithCause(err)
+	}
+
+	return nil
+}
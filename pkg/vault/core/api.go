@@ -1,6 +1,8 @@
 package core
 
 import (
+	"context"
+
 	"github.com/go-logr/logr"
 	"github.com/youniqx/heist/pkg/erx"
 	"github.com/youniqx/heist/pkg/httpclient"
@@ -51,7 +53,7 @@ const (
 )
 
 type RequestAPI interface {
-	MakeRequest(method RequestType, path string, request httpclient.Encodable, response httpclient.Decodeable) error
+	MakeRequest(ctx context.Context, method RequestType, path string, request httpclient.Encodable, response httpclient.Decodeable) error
 	GetVaultAddress(path ...string) string
 }
 
@@ -59,9 +61,14 @@ type LoggingAPI interface {
 	Log() logr.Logger
 }
 
+type TokenAPI interface {
+	RevokeToken(ctx context.Context) error
+}
+
 type API interface {
 	RequestAPI
 	LoggingAPI
+	TokenAPI
 }
 
 type MountPath string
@@ -1,7 +1,9 @@
 package core
 
+import "context"
+
 type AuthProvider interface {
-	Authenticate(core API) (*AuthResponse, error)
+	Authenticate(ctx context.Context, core API) (*AuthResponse, error)
 }
 
 type AuthResponse struct {
@@ -0,0 +1,103 @@
+package core
+
+import (
+	"crypto/tls"
+	"sync"
+)
+
+// WithClientCertificate sets the TLS client certificate presented to Vault, which is
+// required to log in using the TLS certificate auth method. It has to be passed
+// before WithAuthProvider.
+func WithClientCertificate(cert string, key string) Option {
+	return WithClientCertificateFrom(Value(cert), Value(key))
+}
+
+// WithClientCertificateFrom sets the sources of the TLS client certificate presented
+// to Vault, e.g. for listeners requiring mutual TLS. The sources are read again for
+// every new connection, so a rotated certificate is picked up without recreating
+// the API. It has to be passed after WithCACerts and before WithAuthProvider.
+func WithClientCertificateFrom(cert StringSource, key StringSource) Option {
+	return func(api *api) error {
+		loader := &clientCertificateLoader{
+			CertSource: cert,
+			KeySource:  key,
+		}
+
+		if _, err := loader.load(); err != nil {
+			return err
+		}
+
+		if api.TLSConfig == nil {
+			if err := WithCACerts()(api); err != nil {
+				return err
+			}
+		}
+
+		api.TLSConfig.Certificates = nil
+		api.TLSConfig.GetClientCertificate = loader.GetClientCertificate
+		return nil
+	}
+}
+
+type clientCertificateLoader struct {
+	CertSource  StringSource
+	KeySource   StringSource
+	Lock        sync.Mutex
+	CertPEM     string
+	KeyPEM      string
+	Certificate *tls.Certificate
+}
+
+// GetClientCertificate returns the current client certificate. If the sources
+// can't be read or contain an invalid key pair, the last valid certificate is
+// kept, so a partially written rotation does not break new connections.
+func (l *clientCertificateLoader) GetClientCertificate(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	certificate, err := l.load()
+	if err != nil {
+		l.Lock.Lock()
+		defer l.Lock.Unlock()
+
+		if l.Certificate != nil {
+			vaultAPILogger.Info("failed to reload client certificate, using previous one", "error", err)
+			return l.Certificate, nil
+		}
+
+		return nil, err
+	}
+
+	return certificate, nil
+}
+
+func (l *clientCertificateLoader) load() (*tls.Certificate, error) {
+	certPEM, err := l.CertSource.FetchStringValue()
+	if err != nil {
+		return nil, ErrSetupFailed.WithDetails("Failed to fetch client certificate").WithCause(err)
+	}
+
+	keyPEM, err := l.KeySource.FetchStringValue()
+	if err != nil {
+		return nil, ErrSetupFailed.WithDetails("Failed to fetch client key").WithCause(err)
+	}
+
+	l.Lock.Lock()
+	defer l.Lock.Unlock()
+
+	if l.Certificate != nil && certPEM == l.CertPEM && keyPEM == l.KeyPEM {
+		return l.Certificate, nil
+	}
+
+	certificate, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		return nil, ErrSetupFailed.WithDetails("Failed to parse client certificate").WithCause(err)
+	}
+
+	if l.Certificate != nil {
+		vaultAPILogger.Info("reloaded rotated client certificate")
+	}
+
+	l.CertPEM = certPEM
+	l.KeyPEM = keyPEM
+	l.Certificate = &certificate
+
+	return l.Certificate, nil
+}
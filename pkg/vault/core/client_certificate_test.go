@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func writeClientCertificate(t *testing.T, dir string, commonName string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	if err := os.WriteFile(filepath.Join(dir, "tls.crt"), certPEM, 0o600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "tls.key"), keyPEM, 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+}
+
+func TestWithClientCertificateFrom_ReloadsRotatedCertificate(t *testing.T) {
+	var lock sync.Mutex
+	var commonName string
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		commonName = r.TLS.PeerCertificates[0].Subject.CommonName
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	dir := t.TempDir()
+	writeClientCertificate(t, dir, "first")
+
+	instance, err := NewCoreAPI(server.URL,
+		WithCACerts(serverCA),
+		WithClientCertificateFrom(File(filepath.Join(dir, "tls.crt")), File(filepath.Join(dir, "tls.key"))),
+		WithToken("token"),
+	)
+	if err != nil {
+		t.Fatalf("NewCoreAPI() error = %v", err)
+	}
+
+	assertClientCertificate := func(want string) {
+		t.Helper()
+
+		if err := instance.MakeRequest(context.Background(), MethodGet, "/v1/sys/mounts", nil, nil); err != nil {
+			t.Fatalf("MakeRequest() error = %v", err)
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		if commonName != want {
+			t.Errorf("client certificate = %s, want %s", commonName, want)
+		}
+	}
+
+	assertClientCertificate("first")
+
+	writeClientCertificate(t, dir, "second")
+	server.CloseClientConnections()
+
+	assertClientCertificate("second")
+
+	if err := os.WriteFile(filepath.Join(dir, "tls.key"), []byte("invalid"), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	server.CloseClientConnections()
+
+	assertClientCertificate("second")
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"context"
+	"crypto/tls"
 	"net/url"
 	"strings"
 	"sync"
@@ -15,12 +17,22 @@ var vaultAPILogger = logf.Log.WithName("vault-api")
 
 type api struct {
 	Client         httpclient.Client
+	TLSConfig      *tls.Config
 	Logger         logr.Logger
 	AuthProvider   AuthProvider
 	AuthLock       sync.Mutex
 	AuthValidUntil time.Time
 	Token          string
+	TokenLease     *tokenLease
+	RetryPolicy    RetryPolicy
+	Limiter        *requestLimiter
 	VaultAddress   string
+	Namespace      string
+
+	AddressLock     sync.Mutex
+	Addresses       []*url.URL
+	ActiveAddress   *url.URL
+	AddressSelected bool
 }
 
 func (a *api) GetVaultAddress(path ...string) string {
@@ -53,9 +65,13 @@ func NewCoreAPI(address string, opts ...Option) (API, error) {
 	}
 
 	instance := &api{
-		Client:       httpclient.NewClient(vaultURL),
-		Logger:       log,
-		VaultAddress: vaultURL.String(),
+		Client:          httpclient.NewClient(vaultURL),
+		Logger:          log,
+		VaultAddress:    vaultURL.String(),
+		RetryPolicy:     DefaultRetryPolicy(),
+		Addresses:       []*url.URL{vaultURL},
+		ActiveAddress:   vaultURL,
+		AddressSelected: true,
 	}
 
 	for _, opt := range opts {
@@ -67,7 +83,7 @@ func NewCoreAPI(address string, opts ...Option) (API, error) {
 	return instance, nil
 }
 
-func (a *api) authenticate() error {
+func (a *api) authenticate(ctx context.Context) error {
 	a.AuthLock.Lock()
 	defer a.AuthLock.Unlock()
 
@@ -75,21 +91,48 @@ func (a *api) authenticate() error {
 		return nil
 	}
 
-	response, err := a.AuthProvider.Authenticate(a)
+	if a.TokenLease.canBeRenewed() {
+		err := a.renewToken(ctx)
+		if err == nil {
+			return nil
+		}
+
+		a.Logger.Info("failed to renew token, logging in again", "error", err)
+	}
+
+	response, err := a.AuthProvider.Authenticate(ctx, a)
 	if err != nil {
 		return ErrAPIError.WithDetails("failed to authenticate in Vault").WithCause(err)
 	}
 
-	authValidity := time.Duration(response.Auth.LeaseDuration) * time.Second
-	authValidity *= authValidityFactor
-	authValidity /= authValidityDivisor
-
-	a.AuthValidUntil = time.Now().Add(authValidity)
 	a.Token = response.Auth.ClientToken
+	a.TokenLease = newTokenLease(&response.Auth)
+	a.AuthValidUntil = getAuthValidUntil(response.Auth.LeaseDuration)
 
 	return nil
 }
 
+// isAuthExpired returns true once three quarters of the lease of a token issued
+// by a login have passed, so the token is renewed before it is used again. While
+// an authentication is in progress, e.g. for the login request itself, it
+// returns false instead of waiting for it.
+func (a *api) isAuthExpired() bool {
+	if !a.AuthLock.TryLock() {
+		return false
+	}
+	defer a.AuthLock.Unlock()
+
+	return a.TokenLease != nil && !time.Now().Before(a.AuthValidUntil)
+}
+
+func getAuthValidUntil(leaseDuration int) time.Time {
+	authValidity := time.Duration(leaseDuration) * time.Second
+	authValidity *= authValidityFactor
+	authValidity /= authValidityDivisor
+
+	return time.Now().Add(authValidity)
+}
+
 func (a *api) Log() logr.Logger {
 	return a.Logger
 }
@@ -0,0 +1,166 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+)
+
+// nodeState is the state of a Vault node as reported by its sys/health endpoint.
+// Lower values are preferred when selecting the node requests are sent to.
+type nodeState int
+
+const (
+	nodeStateActive nodeState = iota
+	nodeStatePerformanceStandby
+	nodeStateStandby
+	nodeStateUnavailable
+)
+
+const (
+	healthCheckTimeout = 5 * time.Second
+
+	statusStandby            = 429
+	statusPerformanceStandby = 473
+)
+
+func (a *api) getActiveAddress(ctx context.Context) *url.URL {
+	a.AddressLock.Lock()
+	defer a.AddressLock.Unlock()
+
+	if !a.AddressSelected {
+		a.selectActiveAddress(ctx, a.ActiveAddress)
+		a.AddressSelected = true
+	}
+
+	return a.ActiveAddress
+}
+
+// failover selects another node after a request to the failed address could
+// not be handled. Nothing happens if another request has already moved on to
+// a different node in the meantime.
+func (a *api) failover(ctx context.Context, failed *url.URL) {
+	a.AddressLock.Lock()
+	defer a.AddressLock.Unlock()
+
+	if a.ActiveAddress != failed {
+		return
+	}
+
+	a.selectActiveAddress(ctx, failed)
+	a.AddressSelected = true
+}
+
+// selectActiveAddress switches to the node in the best state. If no node is
+// healthy, the node following the failed one is used so retries still reach
+// a different node.
+func (a *api) selectActiveAddress(ctx context.Context, failed *url.URL) {
+	log := a.Logger.WithName("selectActiveAddress")
+
+	var best *url.URL
+	bestState := nodeStateUnavailable
+
+	for _, address := range a.Addresses {
+		state := a.checkHealth(ctx, address)
+		if state < bestState {
+			best = address
+			bestState = state
+		}
+	}
+
+	if best == nil {
+		best = a.nextAddress(failed)
+		log.Info("no healthy Vault node found, trying next address", "address", best.String())
+	}
+
+	if best != a.ActiveAddress {
+		log.Info("switching Vault node", "previous_address", a.ActiveAddress.String(), "address", best.String())
+	}
+
+	a.ActiveAddress = best
+}
+
+func (a *api) nextAddress(current *url.URL) *url.URL {
+	for index, address := range a.Addresses {
+		if address == current {
+			return a.Addresses[(index+1)%len(a.Addresses)]
+		}
+	}
+
+	return a.Addresses[0]
+}
+
+func (a *api) checkHealth(ctx context.Context, address *url.URL) nodeState {
+	result, err := a.Client.Perform(
+		httpclient.Context(ctx),
+		httpclient.Timeout(healthCheckTimeout),
+		httpclient.BaseURL(address),
+		httpclient.Path("/v1/sys/health"),
+		httpclient.Method(http.MethodGet),
+	)
+	if err != nil {
+		a.Logger.Info("Vault node is unreachable", "address", address.String(), "error", err)
+		return nodeStateUnavailable
+	}
+
+	switch result.StatusCode {
+	case http.StatusOK:
+		return nodeStateActive
+	case statusPerformanceStandby:
+		return nodeStatePerformanceStandby
+	case statusStandby:
+		return nodeStateStandby
+	default:
+		a.Logger.Info("Vault node is unavailable", "address", address.String(), "status_code", result.StatusCode)
+		return nodeStateUnavailable
+	}
+}
+
+// followRedirect makes the node a standby has redirected a request to the
+// active node, as long as it is one of the configured addresses.
+func (a *api) followRedirect(requested *url.URL, result *httpclient.Result) {
+	if result.URL == nil || result.URL.Host == requested.Host {
+		return
+	}
+
+	a.AddressLock.Lock()
+	defer a.AddressLock.Unlock()
+
+	for _, address := range a.Addresses {
+		if address.Host == result.URL.Host && address != a.ActiveAddress {
+			a.Logger.Info("following redirect to active Vault node", "previous_address", a.ActiveAddress.String(), "address", address.String())
+			a.ActiveAddress = address
+			return
+		}
+	}
+}
+
+func (a *api) canFailover() bool {
+	return len(a.Addresses) > 1
+}
+
+// isNodeError returns true if an error indicates that the node itself is
+// unable to handle requests, e.g. because it is unreachable or sealed. Requests
+// which were cancelled or ran into the deadline of their context don't say
+// anything about the node.
+func isNodeError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var responseError *VaultHTTPError
+	if errors.As(err, &responseError) {
+		switch responseError.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
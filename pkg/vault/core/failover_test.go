@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func newTestNode(t *testing.T, healthStatus int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	requests := new(int32)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/sys/health" {
+			w.WriteHeader(healthStatus)
+			return
+		}
+
+		atomic.AddInt32(requests, 1)
+		if healthStatus != http.StatusOK {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+func newTestAPI(t *testing.T, addresses ...string) *api {
+	t.Helper()
+
+	instance, err := NewCoreAPI(addresses[0], WithFailoverAddresses(addresses[1:]...), WithToken("token"))
+	if err != nil {
+		t.Fatalf("NewCoreAPI() error = %v", err)
+	}
+
+	return instance.(*api)
+}
+
+func TestAPI_SelectsActiveNode(t *testing.T) {
+	sealed, sealedRequests := newTestNode(t, http.StatusServiceUnavailable)
+	standby, standbyRequests := newTestNode(t, statusStandby)
+	active, activeRequests := newTestNode(t, http.StatusOK)
+
+	instance := newTestAPI(t, sealed.URL, standby.URL, active.URL)
+
+	if err := instance.MakeRequest(context.Background(), MethodGet, "/v1/secret/data/test", nil, nil); err != nil {
+		t.Fatalf("MakeRequest() error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(activeRequests); got != 1 {
+		t.Errorf("requests to active node = %d, want 1", got)
+	}
+
+	if got := atomic.LoadInt32(sealedRequests) + atomic.LoadInt32(standbyRequests); got != 0 {
+		t.Errorf("requests to other nodes = %d, want 0", got)
+	}
+}
+
+func TestAPI_FailsOverToNextNode(t *testing.T) {
+	first, firstRequests := newTestNode(t, http.StatusOK)
+	second, secondRequests := newTestNode(t, http.StatusOK)
+
+	instance := newTestAPI(t, first.URL, second.URL)
+	instance.RetryPolicy = &BackoffRetryPolicy{
+		MaxAttempts:        2,
+		RetryNetworkErrors: true,
+	}
+
+	if err := instance.MakeRequest(context.Background(), MethodGet, "/v1/secret/data/test", nil, nil); err != nil {
+		t.Fatalf("MakeRequest() error = %v", err)
+	}
+
+	first.Close()
+
+	if err := instance.MakeRequest(context.Background(), MethodGet, "/v1/secret/data/test", nil, nil); err != nil {
+		t.Fatalf("MakeRequest() after failover error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(firstRequests); got != 1 {
+		t.Errorf("requests to first node = %d, want 1", got)
+	}
+
+	if got := atomic.LoadInt32(secondRequests); got != 1 {
+		t.Errorf("requests to second node = %d, want 1", got)
+	}
+}
+
+func TestAPI_FollowsStandbyRedirect(t *testing.T) {
+	active, activeRequests := newTestNode(t, http.StatusOK)
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/sys/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		http.Redirect(w, r, active.URL+r.URL.Path, http.StatusTemporaryRedirect)
+	}))
+	t.Cleanup(standby.Close)
+
+	instance := newTestAPI(t, standby.URL, active.URL)
+
+	for i := 0; i < 2; i++ {
+		if err := instance.MakeRequest(context.Background(), MethodPost, "/v1/secret/data/test", nil, nil); err != nil {
+			t.Fatalf("MakeRequest() error = %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(activeRequests); got != 2 {
+		t.Errorf("requests to active node = %d, want 2", got)
+	}
+
+	if instance.ActiveAddress.String() != active.URL {
+		t.Errorf("active address = %s, want %s", instance.ActiveAddress.String(), active.URL)
+	}
+}
+
+func TestIsNodeError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "network error",
+			err:  ErrHTTPError.WithDetails("failed to make api request to Vault").WithCause(errors.New("connection refused")),
+			want: true,
+		},
+		{
+			name: "sealed node",
+			err:  &VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusServiceUnavailable},
+			want: true,
+		},
+		{
+			name: "bad request",
+			err:  &VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusBadRequest},
+			want: false,
+		},
+		{
+			name: "cancelled context",
+			err:  ErrHTTPError.WithDetails("failed to make api request to Vault").WithCause(context.Canceled),
+			want: false,
+		},
+		{
+			name: "deadline exceeded",
+			err:  ErrHTTPError.WithDetails("failed to make api request to Vault").WithCause(context.DeadlineExceeded),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isNodeError(tt.err); got != tt.want {
+				t.Errorf("isNodeError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/youniqx/heist/pkg/erx"
+)
+
+// ErrPermissionDenied is returned when Vault rejects a request because the
+// token is invalid or lacks the required capabilities.
+var ErrPermissionDenied = erx.New("Vault API", "permission denied")
+
+// ErrNotFound is returned when Vault responds to a request with 404 Not Found.
+// APIs usually report missing objects with ErrDoesNotExist instead, wrapping
+// the original response error.
+var ErrNotFound = erx.New("Vault API", "path not found")
+
+// ErrSealed is returned when a request failed because Vault is sealed.
+var ErrSealed = erx.New("Vault API", "vault is sealed")
+
+// ErrRateLimited is returned when Vault rejects a request because a rate limit quota has been exceeded.
+var ErrRateLimited = erx.New("Vault API", "rate limit exceeded")
+
+// ErrCASConflict is returned when a check-and-set write failed because the
+// object has been modified in Vault since it was last read.
+var ErrCASConflict = erx.New("Vault API", "secret has been modified concurrently")
+
+// ErrInvalidRequest is returned when Vault rejects the parameters of a request.
+var ErrInvalidRequest = erx.New("Vault API", "invalid request")
+
+const (
+	sealedError      = "Vault is sealed"
+	casMismatchError = "check-and-set parameter did not match the current version"
+)
+
+// Is makes errors.Is match the sentinel error classifying the response in
+// addition to ErrHTTPError.
+func (e *VaultHTTPError) Is(target error) bool {
+	if erx.Is(e.erxError, target) {
+		return true
+	}
+
+	if class := e.classify(); class != nil {
+		return erx.Is(class, target)
+	}
+
+	return false
+}
+
+func (e *VaultHTTPError) classify() erx.Error {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		if e.containsError(casMismatchError) {
+			return ErrCASConflict
+		}
+
+		return ErrInvalidRequest
+	case http.StatusForbidden:
+		return ErrPermissionDenied
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusServiceUnavailable:
+		if e.containsError(sealedError) {
+			return ErrSealed
+		}
+	}
+
+	return nil
+}
+
+func (e *VaultHTTPError) containsError(text string) bool {
+	for _, vaultError := range e.VaultErrors {
+		if strings.Contains(vaultError, text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StatusCode returns the status code of the Vault response which caused the
+// error. It returns false if the error wasn't caused by an error response.
+func StatusCode(err error) (int, bool) {
+	var responseError *VaultHTTPError
+	if !errors.As(err, &responseError) {
+		return 0, false
+	}
+
+	return responseError.StatusCode, true
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestVaultHTTPError_Is(t *testing.T) {
+	sentinels := []error{
+		ErrPermissionDenied,
+		ErrNotFound,
+		ErrSealed,
+		ErrRateLimited,
+		ErrCASConflict,
+		ErrInvalidRequest,
+	}
+
+	tests := []struct {
+		name        string
+		statusCode  int
+		vaultErrors []string
+		want        error
+	}{
+		{name: "should classify permission denied", statusCode: http.StatusForbidden, vaultErrors: []string{"permission denied"}, want: ErrPermissionDenied},
+		{name: "should classify not found", statusCode: http.StatusNotFound, want: ErrNotFound},
+		{name: "should classify sealed", statusCode: http.StatusServiceUnavailable, vaultErrors: []string{"Vault is sealed"}, want: ErrSealed},
+		{name: "should classify rate limited", statusCode: http.StatusTooManyRequests, vaultErrors: []string{"request path \"kv/\": rate limit quota exceeded"}, want: ErrRateLimited},
+		{name: "should classify cas conflict", statusCode: http.StatusBadRequest, vaultErrors: []string{"check-and-set parameter did not match the current version"}, want: ErrCASConflict},
+		{name: "should classify invalid request", statusCode: http.StatusBadRequest, vaultErrors: []string{"missing client token"}, want: ErrInvalidRequest},
+		{name: "should not classify other unavailable responses", statusCode: http.StatusServiceUnavailable, vaultErrors: []string{"Vault is in standby mode"}},
+		{name: "should not classify internal server errors", statusCode: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ErrAPIError.WithCause(&VaultHTTPError{erxError: ErrHTTPError, StatusCode: tt.statusCode, VaultErrors: tt.vaultErrors})
+
+			if !errors.Is(err, ErrHTTPError) {
+				t.Errorf("errors.Is(err, ErrHTTPError) = false, want true")
+			}
+
+			for _, sentinel := range sentinels {
+				if got, want := errors.Is(err, sentinel), sentinel == tt.want; got != want {
+					t.Errorf("errors.Is(err, %v) = %v, want %v", sentinel, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantOK     bool
+	}{
+		{name: "should return status code of wrapped response error", err: ErrAPIError.WithCause(&VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusNotFound}), wantStatus: http.StatusNotFound, wantOK: true},
+		{name: "should return false for other errors", err: ErrAPIError, wantStatus: 0, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, ok := StatusCode(tt.err)
+			if status != tt.wantStatus || ok != tt.wantOK {
+				t.Errorf("StatusCode() = %v, %v, want %v, %v", status, ok, tt.wantStatus, tt.wantOK)
+			}
+		})
+	}
+}
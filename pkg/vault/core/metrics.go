@@ -0,0 +1,156 @@
+package core
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "heist"
+	metricsSubsystem = "vault"
+
+	// statusCodeNone is used as status code label of requests which did not
+	// receive a response from Vault.
+	statusCodeNone = "none"
+)
+
+var metricLabels = []string{"method", "path", "status_code"}
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "requests_total",
+		Help:      "Total number of requests sent to Vault.",
+	}, metricLabels)
+
+	requestErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "request_errors_total",
+		Help:      "Total number of requests sent to Vault which failed or received an error status code.",
+	}, metricLabels)
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests sent to Vault.",
+		Buckets:   prometheus.DefBuckets,
+	}, metricLabels)
+
+	requestsInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "requests_in_flight",
+		Help:      "Number of requests currently being sent to Vault.",
+	})
+
+	requestsWaiting = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "requests_waiting",
+		Help:      "Number of requests waiting for the client side rate limit or a free in-flight slot.",
+	})
+
+	requestWaitDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "request_wait_duration_seconds",
+		Help:      "Time requests waited for the client side rate limit or a free in-flight slot before being sent to Vault.",
+		Buckets:   prometheus.DefBuckets,
+	})
+)
+
+// Collectors returns the collectors of all metrics recorded for requests sent
+// to Vault.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		requestsTotal,
+		requestErrorsTotal,
+		requestDuration,
+		requestsInFlight,
+		requestsWaiting,
+		requestWaitDuration,
+	}
+}
+
+// RegisterMetrics registers the metrics of requests sent to Vault with the
+// passed registerer. Collectors which have already been registered are skipped.
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	for _, collector := range Collectors() {
+		if err := registerer.Register(collector); err != nil {
+			var alreadyRegistered prometheus.AlreadyRegisteredError
+			if errors.As(err, &alreadyRegistered) {
+				continue
+			}
+
+			return ErrSetupFailed.WithDetails("failed to register Vault metrics").WithCause(err)
+		}
+	}
+
+	return nil
+}
+
+func observeRequest(req *request, statusCode int, duration time.Duration, err error) {
+	status := statusCodeNone
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+
+	labels := prometheus.Labels{
+		"method":      req.Method,
+		"path":        normalizePath(req.Path),
+		"status_code": status,
+	}
+
+	requestsTotal.With(labels).Inc()
+	requestDuration.With(labels).Observe(duration.Seconds())
+
+	if err != nil {
+		requestErrorsTotal.With(labels).Inc()
+	}
+}
+
+type pathTemplate struct {
+	Pattern  *regexp.Regexp
+	Template string
+}
+
+// pathTemplates replace mount paths, object names and secret paths in request
+// paths with placeholders to keep the cardinality of the path label bounded.
+// They are matched in order, the first matching template is used.
+var pathTemplates = []pathTemplate{
+	{regexp.MustCompile(`^/v1/sys/(auth|mounts|health|plugins/reload/backend)$`), "/v1/sys/$1"},
+	{regexp.MustCompile(`^/v1/sys/mounts/.+/tune$`), "/v1/sys/mounts/{mount}/tune"},
+	{regexp.MustCompile(`^/v1/sys/(auth|mounts)/.+$`), "/v1/sys/$1/{mount}"},
+	{regexp.MustCompile(`^/v1/sys/policies/acl/.+$`), "/v1/sys/policies/acl/{name}"},
+	{regexp.MustCompile(`^/v1/sys/policies/password/[^/]+/generate$`), "/v1/sys/policies/password/{name}/generate"},
+	{regexp.MustCompile(`^/v1/sys/policies/password/.+$`), "/v1/sys/policies/password/{name}"},
+	{regexp.MustCompile(`^/v1/sys/internal/ui/mounts/.+$`), "/v1/sys/internal/ui/mounts/{mount}"},
+	{regexp.MustCompile(`^/v1/sys/tools/random/[^/]+$`), "/v1/sys/tools/random/{bytes}"},
+	{regexp.MustCompile(`^/v1/auth/token/(renew-self|revoke-self)$`), "/v1/auth/token/$1"},
+	{regexp.MustCompile(`^/v1/auth/.+/role/[^/]+/(role-id|secret-id)$`), "/v1/auth/{mount}/role/{name}/$1"},
+	{regexp.MustCompile(`^/v1/auth/.+/(role|certs)/[^/]+$`), "/v1/auth/{mount}/$1/{name}"},
+	{regexp.MustCompile(`^/v1/auth/.+/(login|config)$`), "/v1/auth/{mount}/$1"},
+	{regexp.MustCompile(`^/v1/.+?/(data|metadata|delete|undelete|destroy)/.+$`), "/v1/{mount}/$1/{path}"},
+	{regexp.MustCompile(`^/v1/.+/keys/[^/]+/(config|rotate)$`), "/v1/{mount}/keys/{name}/$1"},
+	{regexp.MustCompile(`^/v1/.+/(encrypt|decrypt|rewrap|sign|verify|hmac|keys|issue|roles)/[^/]+$`), "/v1/{mount}/$1/{name}"},
+	{regexp.MustCompile(`^/v1/.+/(root|intermediate)/generate/[^/]+$`), "/v1/{mount}/$1/generate/{type}"},
+	{regexp.MustCompile(`^/v1/.+/(keys|cache-config|config|config/ca|config/urls|ca/pem|ca_chain|crl/rotate|certs|tidy|revoke|root/sign-intermediate|intermediate/set-signed)$`), "/v1/{mount}/$1"},
+	{regexp.MustCompile(`^/v1/.+$`), "/v1/{path}"},
+}
+
+func normalizePath(path string) string {
+	for _, template := range pathTemplates {
+		if template.Pattern.MatchString(path) {
+			return template.Pattern.ReplaceAllString(path, template.Template)
+		}
+	}
+
+	return path
+}
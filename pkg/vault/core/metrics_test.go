@@ -0,0 +1,35 @@
+package core
+
+import "testing"
+
+func TestNormalizePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/v1/sys/mounts", want: "/v1/sys/mounts"},
+		{path: "/v1/sys/mounts/managed/kv/tune", want: "/v1/sys/mounts/{mount}/tune"},
+		{path: "/v1/sys/auth/managed/kubernetes", want: "/v1/sys/auth/{mount}"},
+		{path: "/v1/sys/policies/acl/heist-operator", want: "/v1/sys/policies/acl/{name}"},
+		{path: "/v1/sys/policies/password/managed.password.default.app", want: "/v1/sys/policies/password/{name}"},
+		{path: "/v1/sys/policies/password/managed.password.default.app/generate", want: "/v1/sys/policies/password/{name}/generate"},
+		{path: "/v1/sys/tools/random/32", want: "/v1/sys/tools/random/{bytes}"},
+		{path: "/v1/auth/token/renew-self", want: "/v1/auth/token/renew-self"},
+		{path: "/v1/auth/managed/kubernetes/login", want: "/v1/auth/{mount}/login"},
+		{path: "/v1/auth/managed/kubernetes/role/default.app", want: "/v1/auth/{mount}/role/{name}"},
+		{path: "/v1/auth/approle/role/operator/secret-id", want: "/v1/auth/{mount}/role/{name}/secret-id"},
+		{path: "/v1/managed/kv/team-a/data/app/database", want: "/v1/{mount}/data/{path}"},
+		{path: "/v1/managed/transit/team-a/encrypt/app-key", want: "/v1/{mount}/encrypt/{name}"},
+		{path: "/v1/managed/transit/team-a/keys/app-key/rotate", want: "/v1/{mount}/keys/{name}/rotate"},
+		{path: "/v1/managed/pki/root/generate/internal", want: "/v1/{mount}/root/generate/{type}"},
+		{path: "/v1/managed/pki/root/ca_chain", want: "/v1/{mount}/ca_chain"},
+		{path: "/v1/kv-v1/some/secret", want: "/v1/{path}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := normalizePath(tt.path); got != tt.want {
+				t.Errorf("normalizePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
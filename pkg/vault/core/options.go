@@ -1,11 +1,13 @@
 package core
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/youniqx/heist/pkg/httpclient"
 )
@@ -13,7 +15,37 @@ import (
 func WithAuthProvider(provider AuthProvider) Option {
 	return func(api *api) error {
 		api.AuthProvider = provider
-		return api.authenticate()
+		return api.authenticate(context.Background())
+	}
+}
+
+// WithNamespace sets the Vault Enterprise namespace all requests are sent to.
+// It has to be passed before WithAuthProvider, so the login request is also sent
+// to the namespace.
+func WithNamespace(namespace string) Option {
+	return func(api *api) error {
+		api.Namespace = strings.Trim(namespace, "/")
+		return nil
+	}
+}
+
+// WithFailoverAddresses adds the addresses of further nodes of the same Vault
+// cluster. Before the first request the active node is looked up using the
+// sys/health endpoint of every node, and requests fail over to another node
+// as soon as the current one becomes unreachable or sealed.
+func WithFailoverAddresses(addresses ...string) Option {
+	return func(api *api) error {
+		for _, address := range addresses {
+			vaultURL, err := url.Parse(address)
+			if err != nil {
+				return ErrSetupFailed.WithDetails(fmt.Sprintf("Failed to parse vault address: %s", address)).WithCause(err)
+			}
+
+			api.Addresses = append(api.Addresses, vaultURL)
+		}
+
+		api.AddressSelected = len(api.Addresses) < 2
+		return nil
 	}
 }
 
@@ -35,12 +67,20 @@ func WithCACerts(cas ...string) Option {
 			}
 		}
 
+		tlsConfig := &tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS12,
+		}
+
+		if api.TLSConfig != nil {
+			tlsConfig.Certificates = api.TLSConfig.Certificates
+			tlsConfig.GetClientCertificate = api.TLSConfig.GetClientCertificate
+		}
+
+		api.TLSConfig = tlsConfig
 		api.Client = httpclient.NewClientWithHttpClient(vaultURL, &http.Client{
 			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					RootCAs:    rootCAs,
-					MinVersion: tls.VersionTLS12,
-				},
+				TLSClientConfig: tlsConfig,
 			},
 		})
 		return nil
@@ -1,8 +1,10 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -15,7 +17,7 @@ type request struct {
 	RequestBody  httpclient.Encodable
 	ResponseBody httpclient.Decodeable
 	Attempts     int
-	MaxAttempts  int
+	StartedAt    time.Time
 	Parameters   map[string]string
 }
 
@@ -23,31 +25,21 @@ func (r *request) incrementAttempts() {
 	r.Attempts++
 }
 
-func (r *request) getDelay() time.Duration {
-	if r.Attempts == 0 {
-		return 0
-	}
-
-	delay := minimumRetryDelay
-
-	for i := 1; i < r.Attempts; i++ {
-		delay *= 2
-	}
+func waitForRetry(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
 
-	if delay > maximumRetryDelay {
-		delay = maximumRetryDelay
+	select {
+	case <-ctx.Done():
+		return ErrHTTPError.WithDetails("request was cancelled while waiting for the next attempt").WithCause(ctx.Err())
+	case <-timer.C:
+		return nil
 	}
-
-	return delay
 }
 
-const (
-	minimumRetryDelay = 1 * time.Second
-	maximumRetryDelay = 16 * time.Second
-	maxAttempts       = 2
-)
+const namespaceHeader = "X-Vault-Namespace"
 
-func (a *api) MakeRequest(method RequestType, path string, requestBody httpclient.Encodable, responseBody httpclient.Decodeable) error {
+func (a *api) MakeRequest(ctx context.Context, method RequestType, path string, requestBody httpclient.Encodable, responseBody httpclient.Decodeable) error {
 	log := a.Logger.WithName("request").
 		WithValues("method", method).
 		WithValues("path", path)
@@ -59,7 +51,19 @@ func (a *api) MakeRequest(method RequestType, path string, requestBody httpclien
 		ResponseBody: responseBody,
 		Parameters:   make(map[string]string),
 		Attempts:     0,
-		MaxAttempts:  maxAttempts,
+		StartedAt:    time.Now(),
+	}
+
+	if base, query, found := strings.Cut(path, "?"); found {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return ErrHTTPError.WithDetails("failed to parse query parameters of request path").WithCause(err)
+		}
+
+		info.Path = base
+		for key := range values {
+			info.Parameters[key] = values.Get(key)
+		}
 	}
 
 	if info.Method == string(MethodList) {
@@ -67,15 +71,21 @@ func (a *api) MakeRequest(method RequestType, path string, requestBody httpclien
 		info.Parameters["list"] = "true"
 	}
 
-	if err := a.tryMakingRequest(info); err != nil {
+	if a.isAuthExpired() {
+		if err := a.authenticate(ctx); err != nil {
+			log.Info("failed to refresh authentication before making the request", "error", err)
+		}
+	}
+
+	if err := a.tryMakingRequest(ctx, info); err != nil {
 		if isAuthError(err) {
 			log.Info("Client is not authenticated, attempting to authenticate again", "error", err)
-			return a.authenticateAndRetryRequest(info)
+			return a.authenticateAndRetryRequest(ctx, info, err)
 		}
 
-		if isBadRequestError(err) {
-			log.Info("Client got a bad request error, retrying...", "error", err)
-			return a.retryBadRequest(info)
+		if a.isRetryableError(info, err) {
+			log.Info("Client got a retryable error, retrying...", "error", err)
+			return a.retryRequest(ctx, info, err)
 		}
 
 		log.Info("failed to make api request", "error", err)
@@ -86,34 +96,64 @@ func (a *api) MakeRequest(method RequestType, path string, requestBody httpclien
 	return nil
 }
 
-func (a *api) tryMakingRequest(requestInfo *request) error {
+func (a *api) tryMakingRequest(ctx context.Context, requestInfo *request) error {
 	defer requestInfo.incrementAttempts()
 
-	errorResponse := &ErrorResponse{}
+	address := a.getActiveAddress(ctx)
 
 	options := []httpclient.Option{
+		httpclient.Context(ctx),
+		httpclient.BaseURL(address),
 		httpclient.Path(requestInfo.Path),
 		httpclient.Method(requestInfo.Method),
 		httpclient.Header("X-Vault-Token", a.Token),
 		httpclient.Request(requestInfo.RequestBody),
 		httpclient.Response(requestInfo.ResponseBody),
-		httpclient.Response(httpclient.JSON(errorResponse, httpclient.ConstraintFailed)),
+	}
+
+	if a.Namespace != "" {
+		options = append(options, httpclient.Header(namespaceHeader, a.Namespace))
 	}
 
 	for k, v := range requestInfo.Parameters {
 		options = append(options, httpclient.Parameter(k, v))
 	}
 
+	release, err := a.Limiter.acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	requestsInFlight.Inc()
+	start := time.Now()
+	statusCode, err := a.performRequest(address, options)
+	observeRequest(requestInfo, statusCode, time.Since(start), err)
+	requestsInFlight.Dec()
+	release()
+
+	if err != nil && a.canFailover() && isNodeError(err) {
+		a.failover(ctx, address)
+	}
+
+	return err
+}
+
+func (a *api) performRequest(address *url.URL, options []httpclient.Option) (int, error) {
+	errorResponse := &ErrorResponse{}
+	options = append(options, httpclient.Response(httpclient.JSON(errorResponse, httpclient.ConstraintFailed)))
+
 	result, err := a.Client.Perform(options...)
 	if err != nil {
-		return ErrHTTPError.WithDetails("failed to make api request to Vault").WithCause(err)
+		return 0, ErrHTTPError.WithDetails("failed to make api request to Vault").WithCause(err)
 	}
 
+	a.followRedirect(address, result)
+
 	if result.StatusCode >= http.StatusOK && result.StatusCode < http.StatusMultipleChoices {
-		return nil
+		return result.StatusCode, nil
 	}
 
-	return &VaultHTTPError{
+	return result.StatusCode, &VaultHTTPError{
 		erxError:    ErrHTTPError.WithDetails(fmt.Sprintf("received error status code: %d\n\t%s", result.StatusCode, strings.Join(errorResponse.Errors, "\n\t"))),
 		StatusCode:  result.StatusCode,
 		VaultErrors: errorResponse.Errors,
@@ -0,0 +1,124 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RequestLimits configures client side limits for requests sent to Vault, so
+// bursts of requests are queued instead of tripping the rate limit quotas of
+// Vault. Requests which are retried have to pass the limits again.
+type RequestLimits struct {
+	// RequestsPerSecond is the rate at which requests are allowed to be sent.
+	// The rate isn't limited if it is zero.
+	RequestsPerSecond float64
+	// Burst is the number of requests which may be sent at once before the
+	// rate limit applies. It defaults to 1 if the rate is limited.
+	Burst int
+	// MaxInFlight is the maximum number of concurrent requests. The number
+	// isn't limited if it is zero.
+	MaxInFlight int
+}
+
+const (
+	defaultRequestsPerSecond = 50
+	defaultRequestBurst      = 100
+	defaultMaxInFlight       = 32
+)
+
+// DefaultRequestLimits returns limits which smooth out bursts of requests
+// while still allowing a busy operator to work without noticeable delays.
+func DefaultRequestLimits() *RequestLimits {
+	return &RequestLimits{
+		RequestsPerSecond: defaultRequestsPerSecond,
+		Burst:             defaultRequestBurst,
+		MaxInFlight:       defaultMaxInFlight,
+	}
+}
+
+type requestLimiter struct {
+	RateLimiter *rate.Limiter
+	Slots       chan struct{}
+}
+
+func newRequestLimiter(limits *RequestLimits) (*requestLimiter, error) {
+	switch {
+	case limits.RequestsPerSecond < 0:
+		return nil, ErrSetupFailed.WithDetails("requests per second must not be negative")
+	case limits.Burst < 0:
+		return nil, ErrSetupFailed.WithDetails("request burst must not be negative")
+	case limits.MaxInFlight < 0:
+		return nil, ErrSetupFailed.WithDetails("max in-flight requests must not be negative")
+	}
+
+	limiter := &requestLimiter{}
+
+	if limits.RequestsPerSecond > 0 {
+		burst := limits.Burst
+		if burst == 0 {
+			burst = 1
+		}
+
+		limiter.RateLimiter = rate.NewLimiter(rate.Limit(limits.RequestsPerSecond), burst)
+	}
+
+	if limits.MaxInFlight > 0 {
+		limiter.Slots = make(chan struct{}, limits.MaxInFlight)
+	}
+
+	return limiter, nil
+}
+
+// acquire blocks until the request may be sent. The returned function has to
+// be called once the request is done to free its in-flight slot.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	requestsWaiting.Inc()
+	defer requestsWaiting.Dec()
+
+	start := time.Now()
+	defer func() {
+		requestWaitDuration.Observe(time.Since(start).Seconds())
+	}()
+
+	if l.RateLimiter != nil {
+		if err := l.RateLimiter.Wait(ctx); err != nil {
+			return nil, ErrHTTPError.WithDetails("request was cancelled while waiting for the rate limiter").WithCause(err)
+		}
+	}
+
+	if l.Slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.Slots <- struct{}{}:
+		return func() { <-l.Slots }, nil
+	case <-ctx.Done():
+		return nil, ErrHTTPError.WithDetails("request was cancelled while waiting for a free in-flight slot").WithCause(ctx.Err())
+	}
+}
+
+// WithRequestLimits limits the rate and concurrency of requests sent to Vault.
+// The operator and the agent use DefaultRequestLimits unless different limits
+// are configured with their flags, setting a limit to zero disables it.
+func WithRequestLimits(limits *RequestLimits) Option {
+	return func(api *api) error {
+		if limits == nil {
+			return ErrSetupFailed.WithDetails("request limits must not be nil")
+		}
+
+		limiter, err := newRequestLimiter(limits)
+		if err != nil {
+			return err
+		}
+
+		api.Limiter = limiter
+		return nil
+	}
+}
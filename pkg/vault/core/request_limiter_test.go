@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewRequestLimiter(t *testing.T) {
+	tests := []struct {
+		name    string
+		limits  *RequestLimits
+		wantErr bool
+	}{
+		{name: "should accept default limits", limits: DefaultRequestLimits(), wantErr: false},
+		{name: "should accept disabled limits", limits: &RequestLimits{}, wantErr: false},
+		{name: "should reject negative rate", limits: &RequestLimits{RequestsPerSecond: -1}, wantErr: true},
+		{name: "should reject negative burst", limits: &RequestLimits{RequestsPerSecond: 1, Burst: -1}, wantErr: true},
+		{name: "should reject negative max in-flight requests", limits: &RequestLimits{MaxInFlight: -1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newRequestLimiter(tt.limits); (err != nil) != tt.wantErr {
+				t.Errorf("newRequestLimiter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequestLimiter_Acquire(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits *RequestLimits
+	}{
+		{name: "should wait for a free in-flight slot", limits: &RequestLimits{MaxInFlight: 1}},
+		{name: "should wait for the rate limit", limits: &RequestLimits{RequestsPerSecond: 0.001, Burst: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, err := newRequestLimiter(tt.limits)
+			if err != nil {
+				t.Fatalf("newRequestLimiter() error = %v", err)
+			}
+
+			release, err := limiter.acquire(context.Background())
+			if err != nil {
+				t.Fatalf("acquire() error = %v", err)
+			}
+			defer release()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			if _, err := limiter.acquire(ctx); !errors.Is(err, ErrHTTPError) {
+				t.Errorf("acquire() error = %v, want %v", err, ErrHTTPError)
+			}
+		})
+	}
+}
+
+func TestRequestLimiter_AcquireReleasedSlot(t *testing.T) {
+	limiter, err := newRequestLimiter(&RequestLimits{MaxInFlight: 1})
+	if err != nil {
+		t.Fatalf("newRequestLimiter() error = %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		release, err := limiter.acquire(context.Background())
+		if err != nil {
+			t.Fatalf("acquire() error = %v", err)
+		}
+
+		release()
+	}
+}
+
+func TestRequestLimiter_AcquireWithoutLimiter(t *testing.T) {
+	var limiter *requestLimiter
+
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+
+	release()
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var retryableBadRequests = []string{
+	"Upgrading from non-versioned to versioned data",
+}
+
+func isBadRequestError(err error) bool {
+	var responseError *VaultHTTPError
+	if errors.As(err, &responseError) {
+		if responseError.StatusCode == http.StatusBadRequest {
+			for _, retryableBadRequest := range retryableBadRequests {
+				for _, text := range responseError.VaultErrors {
+					if strings.Contains(text, retryableBadRequest) {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func (a *api) isRetryableError(req *request, err error) bool {
+	return isBadRequestError(err) || a.RetryPolicy.IsRetryable(req.Method, err)
+}
+
+func (a *api) nextDelay(req *request) (time.Duration, bool) {
+	return a.RetryPolicy.NextDelay(req.Attempts, time.Since(req.StartedAt))
+}
+
+func (a *api) retryRequest(ctx context.Context, req *request, lastErr error) error {
+	log := a.Logger.WithName("retryRequest").
+		WithValues("method", req.Method).
+		WithValues("path", req.Path)
+
+	delay, ok := a.nextDelay(req)
+	attemptLogger := log.WithValues("attempt", req.Attempts, "delay", delay)
+
+	if !ok {
+		attemptLogger.Info("Too many retries, giving up...", "error", lastErr)
+		return lastErr
+	}
+
+	if delay > 0 {
+		attemptLogger.Info("Waiting between retry attempts")
+		if err := waitForRetry(ctx, delay); err != nil {
+			attemptLogger.Info("stopped waiting for the next retry attempt", "error", err)
+			return err
+		}
+	}
+
+	if err := a.tryMakingRequest(ctx, req); err != nil {
+		if isAuthError(err) {
+			attemptLogger.Info("Client is not authenticated, attempting to authenticate again", "error", err)
+			return a.authenticateAndRetryRequest(ctx, req, err)
+		}
+
+		if a.isRetryableError(req, err) {
+			attemptLogger.Info("Received retryable error, retrying...", "error", err)
+			return a.retryRequest(ctx, req, err)
+		}
+
+		attemptLogger.Info("failed to make api request to Vault", "error", err)
+
+		return err
+	}
+
+	return nil
+}
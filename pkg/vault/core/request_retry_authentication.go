@@ -1,65 +1,64 @@
 package core
 
 import (
+	"context"
 	"errors"
-	"net/http"
 	"time"
 )
 
 func isAuthError(err error) bool {
-	var responseError *VaultHTTPError
-	if errors.As(err, &responseError) {
-		if responseError.StatusCode == http.StatusForbidden {
-			return true
-		}
-	}
-
-	return false
+	return errors.Is(err, ErrPermissionDenied)
 }
 
-func (a *api) authenticateAndRetryRequest(req *request) error {
+func (a *api) authenticateAndRetryRequest(ctx context.Context, req *request, lastErr error) error {
 	log := a.Logger.WithName("authenticateAndRetryRequest").
 		WithValues("method", req.Method).
 		WithValues("path", req.Path)
 
 	if a.AuthValidUntil.After(time.Now()) {
-		if err := a.tryMakingRequest(req); err != nil {
+		if err := a.tryMakingRequest(ctx, req); err != nil {
 			if !isAuthError(err) {
 				log.Info("failed to make api request to Vault", "error", err)
 				return err
 			}
+
+			lastErr = err
 		} else {
 			return nil
 		}
 	}
 
-	delay := req.getDelay()
+	delay, ok := a.nextDelay(req)
 	attemptLogger := log.WithValues("attempt", req.Attempts, "delay", delay)
 
+	if !ok {
+		attemptLogger.Info("Too many retries, giving up...", "error", lastErr)
+		return lastErr
+	}
+
 	if delay > 0 {
 		attemptLogger.Info("Waiting between authorization attempts")
-		time.Sleep(delay)
+		if err := waitForRetry(ctx, delay); err != nil {
+			attemptLogger.Info("stopped waiting for the next authorization attempt", "error", err)
+			return err
+		}
 	}
 
-	if err := a.authenticate(); err != nil {
+	if err := a.authenticate(ctx); err != nil {
 		attemptLogger.Info("failed to authenticate in Vault")
-		return a.authenticateAndRetryRequest(req)
+		req.incrementAttempts()
+		return a.authenticateAndRetryRequest(ctx, req, err)
 	}
 
-	if err := a.tryMakingRequest(req); err != nil {
-		if req.Attempts >= req.MaxAttempts {
-			attemptLogger.Info("Too many retries, giving up...", "error", err)
-			return err
-		}
-
+	if err := a.tryMakingRequest(ctx, req); err != nil {
 		if isAuthError(err) {
 			attemptLogger.Info("Authentication in Vault failed", "error", err)
-			return a.authenticateAndRetryRequest(req)
+			return a.authenticateAndRetryRequest(ctx, req, err)
 		}
 
-		if isBadRequestError(err) {
-			attemptLogger.Info("Client received a Bad Request Error, retrying...", "error", err)
-			return a.retryBadRequest(req)
+		if a.isRetryableError(req, err) {
+			attemptLogger.Info("Client received a retryable error, retrying...", "error", err)
+			return a.retryRequest(ctx, req, err)
 		}
 
 		attemptLogger.Info("failed to make api request to Vault", "error", err)
@@ -0,0 +1,195 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy decides which failed requests to Vault are retried and how long to
+// wait before the next attempt.
+type RetryPolicy interface {
+	// IsRetryable returns true if a request with the passed HTTP method which failed with
+	// the passed error should be retried.
+	IsRetryable(method string, err error) bool
+	// NextDelay returns the delay before the passed attempt and false if no further attempt
+	// should be made, because the maximum number of attempts or the maximum elapsed time
+	// has been reached.
+	NextDelay(attempt int, elapsed time.Duration) (time.Duration, bool)
+}
+
+// StatusClass matches either a single HTTP status code, e.g. 503, or a whole class of
+// status codes, e.g. 5xx.
+type StatusClass string
+
+func (s StatusClass) Matches(statusCode int) bool {
+	code := strconv.Itoa(statusCode)
+	pattern := strings.ToLower(string(s))
+
+	if len(pattern) != len(code) {
+		return false
+	}
+
+	for i := range pattern {
+		if pattern[i] != 'x' && pattern[i] != code[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s StatusClass) Validate() error {
+	pattern := strings.ToLower(string(s))
+	if len(pattern) != 3 || pattern[0] < '1' || pattern[0] > '5' {
+		return ErrSetupFailed.WithDetails(fmt.Sprintf("invalid status class %q, expected a status code like 503 or a class like 5xx", s))
+	}
+
+	for i := 1; i < len(pattern); i++ {
+		if pattern[i] != 'x' && (pattern[i] < '0' || pattern[i] > '9') {
+			return ErrSetupFailed.WithDetails(fmt.Sprintf("invalid status class %q, expected a status code like 503 or a class like 5xx", s))
+		}
+	}
+
+	return nil
+}
+
+// BackoffRetryPolicy retries requests with a jittered exponential backoff.
+type BackoffRetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including the first one.
+	MaxAttempts int
+	// MinDelay is the delay before the first retry, it doubles with every further attempt.
+	MinDelay time.Duration
+	// MaxDelay caps the delay between two attempts.
+	MaxDelay time.Duration
+	// MaxElapsedTime stops retrying once the request has been running for this long.
+	// No limit is applied if it is zero.
+	MaxElapsedTime time.Duration
+	// Jitter is the fraction of the delay which is randomized, between 0 and 1.
+	Jitter float64
+	// RetryableStatusClasses are the status codes of responses which are retried.
+	// Requests with non idempotent methods like POST are only retried for the
+	// status codes among them which Vault returns without processing the request,
+	// unless RetryNonIdempotentRequests is set.
+	RetryableStatusClasses []StatusClass
+	// RetryNonIdempotentRequests enables retries of non idempotent requests for all
+	// RetryableStatusClasses. A request which failed with a server error may have been
+	// performed anyway, e.g. a transit encryption or a certificate issued by a PKI engine,
+	// so retrying it performs it twice.
+	RetryNonIdempotentRequests bool
+	// RetryNetworkErrors enables retries of requests which didn't receive a response.
+	RetryNetworkErrors bool
+}
+
+const (
+	defaultMaxAttempts    = 4
+	defaultMinRetryDelay  = 1 * time.Second
+	defaultMaxRetryDelay  = 16 * time.Second
+	defaultMaxElapsedTime = 1 * time.Minute
+	defaultRetryJitter    = 0.2
+)
+
+// DefaultRetryPolicy retries rate limited requests and requests sent while Vault is
+// sealed or in standby, as well as network errors. Server errors are only retried
+// for idempotent requests.
+func DefaultRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxAttempts:            defaultMaxAttempts,
+		MinDelay:               defaultMinRetryDelay,
+		MaxDelay:               defaultMaxRetryDelay,
+		MaxElapsedTime:         defaultMaxElapsedTime,
+		Jitter:                 defaultRetryJitter,
+		RetryableStatusClasses: []StatusClass{"429", "500", "502", "503"},
+		RetryNetworkErrors:     true,
+	}
+}
+
+// unprocessedStatusClasses are the status codes of responses which Vault sends
+// without processing the request, i.e. when it is rate limited, sealed or in
+// standby. Retrying them is safe for all methods.
+var unprocessedStatusClasses = []StatusClass{"429", "503"}
+
+func (b *BackoffRetryPolicy) IsRetryable(method string, err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var responseError *VaultHTTPError
+	if !errors.As(err, &responseError) {
+		var networkError net.Error
+		return b.RetryNetworkErrors && errors.As(err, &networkError)
+	}
+
+	if !b.RetryNonIdempotentRequests && !isIdempotentMethod(method) && !matchesStatusClass(unprocessedStatusClasses, responseError.StatusCode) {
+		return false
+	}
+
+	return matchesStatusClass(b.RetryableStatusClasses, responseError.StatusCode)
+}
+
+func matchesStatusClass(classes []StatusClass, statusCode int) bool {
+	for _, class := range classes {
+		if class.Matches(statusCode) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch RequestType(method) {
+	case MethodGet, MethodList, MethodPut, MethodDelete:
+		return true
+	default:
+		return method == http.MethodHead || method == http.MethodOptions
+	}
+}
+
+func (b *BackoffRetryPolicy) NextDelay(attempt int, elapsed time.Duration) (time.Duration, bool) {
+	if attempt >= b.MaxAttempts {
+		return 0, false
+	}
+
+	if attempt == 0 {
+		return 0, true
+	}
+
+	delay := b.MinDelay
+	for i := 1; i < attempt && delay < b.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if b.MaxDelay > 0 && delay > b.MaxDelay {
+		delay = b.MaxDelay
+	}
+
+	if b.Jitter > 0 {
+		//nolint:gosec // the jitter doesn't need a cryptographically secure random source
+		delay += time.Duration((rand.Float64()*2 - 1) * b.Jitter * float64(delay))
+	}
+
+	if b.MaxElapsedTime > 0 && elapsed+delay > b.MaxElapsedTime {
+		return 0, false
+	}
+
+	return delay, true
+}
+
+// WithRetryPolicy replaces the default retry policy used for all requests to Vault.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(api *api) error {
+		if policy == nil {
+			return ErrSetupFailed.WithDetails("retry policy must not be nil")
+		}
+
+		api.RetryPolicy = policy
+		return nil
+	}
+}
@@ -0,0 +1,181 @@
+package core
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestStatusClass_Matches(t *testing.T) {
+	tests := []struct {
+		name       string
+		class      StatusClass
+		statusCode int
+		want       bool
+	}{
+		{name: "should match exact status code", class: "503", statusCode: http.StatusServiceUnavailable, want: true},
+		{name: "should not match different status code", class: "503", statusCode: http.StatusBadGateway, want: false},
+		{name: "should match status class", class: "5xx", statusCode: http.StatusBadGateway, want: true},
+		{name: "should match upper case status class", class: "5XX", statusCode: http.StatusInternalServerError, want: true},
+		{name: "should not match other status class", class: "5xx", statusCode: http.StatusTooManyRequests, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.class.Matches(tt.statusCode); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusClass_Validate(t *testing.T) {
+	tests := []struct {
+		class   StatusClass
+		wantErr bool
+	}{
+		{class: "429", wantErr: false},
+		{class: "5xx", wantErr: false},
+		{class: "50x", wantErr: false},
+		{class: "600", wantErr: true},
+		{class: "5x", wantErr: true},
+		{class: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.class), func(t *testing.T) {
+			if err := tt.class.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBackoffRetryPolicy_IsRetryable(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *BackoffRetryPolicy
+		method string
+		err    error
+		want   bool
+	}{
+		{
+			name:   "should retry sealed or standby vault",
+			policy: DefaultRetryPolicy(),
+			method: http.MethodGet,
+			err:    &VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusServiceUnavailable},
+			want:   true,
+		},
+		{
+			name:   "should retry rate limited requests",
+			policy: DefaultRetryPolicy(),
+			method: http.MethodGet,
+			err:    &VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusTooManyRequests},
+			want:   true,
+		},
+		{
+			name:   "should retry server errors of idempotent requests",
+			policy: DefaultRetryPolicy(),
+			method: http.MethodPut,
+			err:    &VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusInternalServerError},
+			want:   true,
+		},
+		{
+			name:   "should not retry server errors of non idempotent requests",
+			policy: DefaultRetryPolicy(),
+			method: http.MethodPost,
+			err:    &VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusBadGateway},
+			want:   false,
+		},
+		{
+			name:   "should retry non idempotent requests to sealed or standby vault",
+			policy: DefaultRetryPolicy(),
+			method: http.MethodPost,
+			err:    &VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusServiceUnavailable},
+			want:   true,
+		},
+		{
+			name:   "should retry server errors of non idempotent requests if enabled",
+			policy: &BackoffRetryPolicy{RetryableStatusClasses: []StatusClass{"5xx"}, RetryNonIdempotentRequests: true},
+			method: http.MethodPost,
+			err:    &VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusInternalServerError},
+			want:   true,
+		},
+		{
+			name:   "should not retry not found errors",
+			policy: DefaultRetryPolicy(),
+			method: http.MethodGet,
+			err:    &VaultHTTPError{erxError: ErrHTTPError, StatusCode: http.StatusNotFound},
+			want:   false,
+		},
+		{
+			name:   "should retry network errors",
+			policy: DefaultRetryPolicy(),
+			method: http.MethodPost,
+			err:    ErrHTTPError.WithCause(&net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}),
+			want:   true,
+		},
+		{
+			name:   "should not retry cancelled requests",
+			policy: DefaultRetryPolicy(),
+			method: http.MethodGet,
+			err:    ErrHTTPError.WithCause(context.Canceled),
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.IsRetryable(tt.method, tt.err); got != tt.want {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoffRetryPolicy_NextDelay(t *testing.T) {
+	policy := &BackoffRetryPolicy{
+		MaxAttempts:    5,
+		MinDelay:       time.Second,
+		MaxDelay:       3 * time.Second,
+		MaxElapsedTime: 10 * time.Second,
+	}
+
+	tests := []struct {
+		name      string
+		attempt   int
+		elapsed   time.Duration
+		wantDelay time.Duration
+		wantOk    bool
+	}{
+		{name: "should not wait before the first attempt", attempt: 0, wantDelay: 0, wantOk: true},
+		{name: "should wait the minimum delay before the first retry", attempt: 1, wantDelay: time.Second, wantOk: true},
+		{name: "should double the delay", attempt: 2, wantDelay: 2 * time.Second, wantOk: true},
+		{name: "should cap the delay", attempt: 4, wantDelay: 3 * time.Second, wantOk: true},
+		{name: "should stop after the maximum number of attempts", attempt: 5, wantDelay: 0, wantOk: false},
+		{name: "should stop after the maximum elapsed time", attempt: 1, elapsed: 9500 * time.Millisecond, wantDelay: 0, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := policy.NextDelay(tt.attempt, tt.elapsed)
+			if delay != tt.wantDelay || ok != tt.wantOk {
+				t.Errorf("NextDelay() = (%v, %v), want (%v, %v)", delay, ok, tt.wantDelay, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestBackoffRetryPolicy_NextDelayJitter(t *testing.T) {
+	policy := &BackoffRetryPolicy{
+		MaxAttempts: 3,
+		MinDelay:    time.Second,
+		Jitter:      0.5,
+	}
+
+	for i := 0; i < 100; i++ {
+		delay, ok := policy.NextDelay(1, 0)
+		if !ok || delay < 500*time.Millisecond || delay > 1500*time.Millisecond {
+			t.Fatalf("NextDelay() = (%v, %v), want a delay between 0.5s and 1.5s", delay, ok)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"os"
 )
 
@@ -10,7 +11,7 @@ func WithTokenAuth() Option {
 
 type tokenAuth struct{}
 
-func (t *tokenAuth) Authenticate(API) (*AuthResponse, error) {
+func (t *tokenAuth) Authenticate(_ context.Context, _ API) (*AuthResponse, error) {
 	token := os.Getenv("VAULT_TOKEN")
 	if token == "" {
 		return nil, ErrAPIError.WithDetails("required env var VAULT_TOKEN is not set")
@@ -24,16 +25,21 @@ func (t *tokenAuth) Authenticate(API) (*AuthResponse, error) {
 }
 
 func WithToken(token string) Option {
-	return WithAuthProvider(&staticTokenAuth{
+	return WithAuthProvider(StaticToken(token))
+}
+
+// StaticToken returns an auth provider which always uses the passed Vault token.
+func StaticToken(token string) AuthProvider {
+	return &staticTokenAuth{
 		Token: token,
-	})
+	}
 }
 
 type staticTokenAuth struct {
 	Token string
 }
 
-func (s *staticTokenAuth) Authenticate(API) (*AuthResponse, error) {
+func (s *staticTokenAuth) Authenticate(_ context.Context, _ API) (*AuthResponse, error) {
 	if s.Token == "" {
 		return nil, ErrAPIError.WithDetails("vault token is empty")
 	}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+)
+
+const (
+	renewSelfPath  = "/v1/auth/token/renew-self"
+	revokeSelfPath = "/v1/auth/token/revoke-self"
+)
+
+// tokenLease tracks the lease of a token issued by a login. Tokens without a lease,
+// e.g. static tokens passed to the operator, are never renewed or revoked.
+type tokenLease struct {
+	Duration      time.Duration
+	Renewable     bool
+	ExpiresAt     time.Time
+	MaxTTLReached bool
+}
+
+func newTokenLease(auth *AuthData) *tokenLease {
+	if auth.LeaseDuration <= 0 {
+		return nil
+	}
+
+	duration := time.Duration(auth.LeaseDuration) * time.Second
+
+	return &tokenLease{
+		Duration:  duration,
+		Renewable: auth.Renewable,
+		ExpiresAt: time.Now().Add(duration),
+	}
+}
+
+func (t *tokenLease) canBeRenewed() bool {
+	if t == nil {
+		return false
+	}
+
+	return t.Renewable && !t.MaxTTLReached && time.Now().Before(t.ExpiresAt)
+}
+
+type renewSelfRequest struct {
+	Increment int `json:"increment"`
+}
+
+// renewToken extends the lease of the current token. Vault caps the lease at the
+// max TTL of the token, once the returned lease is shorter than the requested
+// increment the token is used until it expires and a new login is performed.
+func (a *api) renewToken(ctx context.Context) error {
+	log := a.Logger.WithValues("method", "RenewToken")
+
+	response := &AuthResponse{}
+	req := &request{
+		Method: string(MethodPost),
+		Path:   renewSelfPath,
+		RequestBody: httpclient.JSON(&renewSelfRequest{
+			Increment: int(a.TokenLease.Duration / time.Second),
+		}),
+		ResponseBody: httpclient.JSON(response, httpclient.ConstraintSuccess),
+		Parameters:   make(map[string]string),
+	}
+
+	if err := a.tryMakingRequest(ctx, req); err != nil {
+		return ErrAPIError.WithDetails("failed to renew token").WithCause(err)
+	}
+
+	if response.Auth.LeaseDuration <= 0 {
+		return ErrAPIError.WithDetails("renewed token has no lease")
+	}
+
+	renewed := time.Duration(response.Auth.LeaseDuration) * time.Second
+
+	a.TokenLease.ExpiresAt = time.Now().Add(renewed)
+	a.TokenLease.MaxTTLReached = renewed < a.TokenLease.Duration
+	a.AuthValidUntil = getAuthValidUntil(response.Auth.LeaseDuration)
+
+	log.Info("renewed token", "lease", renewed, "max_ttl_reached", a.TokenLease.MaxTTLReached)
+
+	return nil
+}
+
+// RevokeToken revokes the token issued by the last login, so no orphaned tokens are
+// left in Vault when the operator or an agent shuts down. Tokens which were not
+// issued by a login are left untouched.
+func (a *api) RevokeToken(ctx context.Context) error {
+	a.AuthLock.Lock()
+	defer a.AuthLock.Unlock()
+
+	if a.TokenLease == nil || a.Token == "" {
+		return nil
+	}
+
+	req := &request{
+		Method:     string(MethodPost),
+		Path:       revokeSelfPath,
+		Parameters: make(map[string]string),
+	}
+
+	if err := a.tryMakingRequest(ctx, req); err != nil {
+		return ErrAPIError.WithDetails("failed to revoke token").WithCause(err)
+	}
+
+	a.Token = ""
+	a.TokenLease = nil
+	a.AuthValidUntil = time.Time{}
+
+	return nil
+}
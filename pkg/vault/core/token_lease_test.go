@@ -0,0 +1,193 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testLeaseDuration = 60
+
+type testLoginProvider struct {
+	logins int32
+}
+
+func (p *testLoginProvider) Authenticate(_ context.Context, _ API) (*AuthResponse, error) {
+	logins := atomic.AddInt32(&p.logins, 1)
+
+	return &AuthResponse{
+		Auth: AuthData{
+			ClientToken:   fmt.Sprintf("token-%d", logins),
+			LeaseDuration: testLeaseDuration,
+			Renewable:     true,
+		},
+	}, nil
+}
+
+type testRenewalNode struct {
+	Renewals  int32
+	LastToken atomic.Value
+}
+
+// newTestRenewalNode starts a Vault node which answers renew-self requests with
+// the passed lease duration, or with the passed status code if the duration is 0.
+func newTestRenewalNode(t *testing.T, renewedLease int, renewStatus int) (*httptest.Server, *testRenewalNode) {
+	t.Helper()
+
+	node := &testRenewalNode{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != renewSelfPath {
+			node.LastToken.Store(r.Header.Get("X-Vault-Token"))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		atomic.AddInt32(&node.Renewals, 1)
+		if renewedLease == 0 {
+			w.WriteHeader(renewStatus)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&AuthResponse{
+			Auth: AuthData{
+				ClientToken:   r.Header.Get("X-Vault-Token"),
+				LeaseDuration: renewedLease,
+				Renewable:     true,
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, node
+}
+
+func newTestLoginAPI(t *testing.T, address string) (*api, *testLoginProvider) {
+	t.Helper()
+
+	provider := &testLoginProvider{}
+	instance, err := NewCoreAPI(address, WithAuthProvider(provider))
+	if err != nil {
+		t.Fatalf("NewCoreAPI() error = %v", err)
+	}
+
+	return instance.(*api), provider
+}
+
+func expireAuth(instance *api) {
+	instance.AuthValidUntil = time.Now().Add(-time.Second)
+}
+
+func TestAPI_RenewsTokenOnceAuthExpired(t *testing.T) {
+	server, node := newTestRenewalNode(t, testLeaseDuration, 0)
+	instance, provider := newTestLoginAPI(t, server.URL)
+
+	if err := instance.MakeRequest(context.Background(), MethodGet, "/v1/secret/data/test", nil, nil); err != nil {
+		t.Fatalf("MakeRequest() error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&node.Renewals); got != 0 {
+		t.Errorf("renewals before the lease passed = %d, want 0", got)
+	}
+
+	expireAuth(instance)
+
+	if err := instance.MakeRequest(context.Background(), MethodGet, "/v1/secret/data/test", nil, nil); err != nil {
+		t.Fatalf("MakeRequest() error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&node.Renewals); got != 1 {
+		t.Errorf("renewals = %d, want 1", got)
+	}
+
+	if got := atomic.LoadInt32(&provider.logins); got != 1 {
+		t.Errorf("logins = %d, want 1", got)
+	}
+
+	if !instance.AuthValidUntil.After(time.Now()) {
+		t.Errorf("auth valid until = %v, want a time in the future", instance.AuthValidUntil)
+	}
+
+	if got := node.LastToken.Load(); got != "token-1" {
+		t.Errorf("token = %v, want token-1", got)
+	}
+}
+
+func TestAPI_StopsRenewingOnceMaxTTLReached(t *testing.T) {
+	server, node := newTestRenewalNode(t, testLeaseDuration/2, 0)
+	instance, provider := newTestLoginAPI(t, server.URL)
+
+	expireAuth(instance)
+
+	if err := instance.MakeRequest(context.Background(), MethodGet, "/v1/secret/data/test", nil, nil); err != nil {
+		t.Fatalf("MakeRequest() error = %v", err)
+	}
+
+	if !instance.TokenLease.MaxTTLReached {
+		t.Fatalf("max TTL reached = false, want true")
+	}
+
+	expireAuth(instance)
+
+	if err := instance.MakeRequest(context.Background(), MethodGet, "/v1/secret/data/test", nil, nil); err != nil {
+		t.Fatalf("MakeRequest() error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&node.Renewals); got != 1 {
+		t.Errorf("renewals = %d, want 1", got)
+	}
+
+	if got := atomic.LoadInt32(&provider.logins); got != 2 {
+		t.Errorf("logins = %d, want 2", got)
+	}
+
+	if got := node.LastToken.Load(); got != "token-2" {
+		t.Errorf("token = %v, want token-2", got)
+	}
+}
+
+func TestAPI_LogsInWhenRenewalFails(t *testing.T) {
+	tests := []struct {
+		name        string
+		renewStatus int
+	}{
+		{
+			name:        "permission denied",
+			renewStatus: http.StatusForbidden,
+		},
+		{
+			name:        "server error",
+			renewStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, node := newTestRenewalNode(t, 0, tt.renewStatus)
+			instance, provider := newTestLoginAPI(t, server.URL)
+
+			expireAuth(instance)
+
+			if err := instance.MakeRequest(context.Background(), MethodGet, "/v1/secret/data/test", nil, nil); err != nil {
+				t.Fatalf("MakeRequest() error = %v", err)
+			}
+
+			if got := atomic.LoadInt32(&node.Renewals); got != 1 {
+				t.Errorf("renewals = %d, want 1", got)
+			}
+
+			if got := atomic.LoadInt32(&provider.logins); got != 2 {
+				t.Errorf("logins = %d, want 2", got)
+			}
+
+			if got := node.LastToken.Load(); got != "token-2" {
+				t.Errorf("token = %v, want token-2", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package e2e_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/youniqx/heist/pkg/vault/approleauth"
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/jwtauth"
+	. "github.com/youniqx/heist/pkg/vault/matchers"
+)
+
+var _ = Describe("AppRoleAuthAPI", func() {
+	method := core.MountPath("managed/approle")
+
+	AfterEach(func() {
+		Expect(vaultAPI.DeleteAuthMethod(context.TODO(), method)).To(Succeed())
+	})
+
+	It("Should be able to log in using an approle", func() {
+		By("Creating a new approle auth method")
+		Expect(vaultAPI.UpdateAppRoleAuthMethod(context.TODO(), method)).To(Succeed())
+		vaultEnv.AuthMethod(method).Should(HaveAuthType(auth.MethodAppRole))
+
+		By("Not throwing an error if the auth method already exists")
+		Expect(vaultAPI.UpdateAppRoleAuthMethod(context.TODO(), method)).To(Succeed())
+
+		By("Creating a role")
+		role := &approleauth.Role{
+			Name:     "some-role",
+			Policies: []core.PolicyName{"default"},
+		}
+		Expect(vaultAPI.UpdateAppRoleAuthRole(context.TODO(), method, role)).To(Succeed())
+
+		By("Reading the role id and creating a secret id")
+		roleID, err := vaultAPI.ReadAppRoleRoleID(context.TODO(), method, role)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleID).NotTo(BeEmpty())
+
+		secretID, err := vaultAPI.CreateAppRoleSecretID(context.TODO(), method, role)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secretID).NotTo(BeEmpty())
+
+		By("Logging in with the role id and secret id")
+		response, err := vaultAPI.LoginWithAppRoleAuth(context.TODO(), method, roleID, secretID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Auth.ClientToken).NotTo(BeEmpty())
+		Expect(response.Auth.Policies).To(ContainElement("default"))
+
+		By("Failing to log in with an invalid secret id")
+		_, err = vaultAPI.LoginWithAppRoleAuth(context.TODO(), method, roleID, "invalid")
+		Expect(err).To(HaveOccurred())
+
+		By("Deleting the role")
+		Expect(vaultAPI.DeleteAppRoleAuthRole(context.TODO(), method, role)).To(Succeed())
+		_, err = vaultAPI.ReadAppRoleRoleID(context.TODO(), method, role)
+		Expect(err).To(MatchError(core.ErrDoesNotExist))
+	})
+})
+
+var _ = Describe("JWTAuthAPI", func() {
+	method := &jwtauth.Method{
+		Path: "managed/jwt",
+		Config: &jwtauth.Config{
+			JWTValidationPubKeys: []string{"-----BEGIN PUBLIC KEY-----\nMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAoGdpfQA9y+2KP2rwyeZD\nwW9o8yWJQpSSnVr2thpdxYSO4U4Phv+DvgW8LIu8+nRUzX1GbyIaGIqSDEOdJsEE\ne8EywIxM4TWTXnu3mf9lxYx3+RLqirmGKecDZ17m5gRnnokjmoJi3GoJOYzlrfBC\n3srBVwew5d/yrFEBPdM/JHnfE+J6J5HASThrF/WxNjR/HrUREgUUGxxfj0OkCJqX\njs2Fm24jSZzummSCHlzxOh/jWcZgvWuOUi+LauKOXQcc7HQcMgakrnfGHyGqIxVY\nX/C7CPynMWzkmaf9SxsrdgCC6eJS8VqyCq2qk4T2Oyvcvfxg7JBmxyHzmmNwyoYK\njwIDAQAB\n-----END PUBLIC KEY-----\n"},
+			BoundIssuer:          "https://kubernetes.default.svc.cluster.local",
+		},
+	}
+
+	AfterEach(func() {
+		Expect(vaultAPI.DeleteAuthMethod(context.TODO(), method)).To(Succeed())
+	})
+
+	It("Should be able to manage jwt auth methods and roles", func() {
+		By("Creating a new jwt auth method")
+		Expect(vaultAPI.UpdateJWTAuthMethod(context.TODO(), method)).To(Succeed())
+		vaultEnv.AuthMethod(method).Should(HaveAuthType(auth.MethodJWT))
+
+		By("Not throwing an error if nothing has changed")
+		Expect(vaultAPI.UpdateJWTAuthMethod(context.TODO(), method)).To(Succeed())
+
+		By("Creating a role bound to a custom audience")
+		role := &jwtauth.Role{
+			Name:           "some-role",
+			Policies:       []core.PolicyName{"default"},
+			BoundAudiences: []string{"vault"},
+			BoundSubject:   "system:serviceaccount:default:some-service-account",
+		}
+		Expect(vaultAPI.UpdateJWTAuthRole(context.TODO(), method, role)).To(Succeed())
+
+		By("Failing to log in with an invalid jwt")
+		_, err := vaultAPI.LoginWithJWTAuth(context.TODO(), method, role, "invalid")
+		Expect(err).To(HaveOccurred())
+
+		By("Deleting the role")
+		Expect(vaultAPI.DeleteJWTAuthRole(context.TODO(), method, role)).To(Succeed())
+	})
+})
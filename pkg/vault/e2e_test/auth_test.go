@@ -1,6 +1,8 @@
 package e2e_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/youniqx/heist/pkg/vault/auth"
@@ -16,17 +18,17 @@ var _ = Describe("Auth API", func() {
 		}
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteAuthMethod(k8sAuthMethod)).To(Succeed())
+			Expect(vaultAPI.DeleteAuthMethod(context.TODO(), k8sAuthMethod)).To(Succeed())
 		})
 
 		It("Should be able to enable a new kubernetes auth method", func() {
-			Expect(vaultAPI.CreateAuthMethod(k8sAuthMethod)).To(Succeed())
+			Expect(vaultAPI.CreateAuthMethod(context.TODO(), k8sAuthMethod)).To(Succeed())
 			vaultEnv.AuthMethod(k8sAuthMethod).Should(HavePath("managed/k8s"))
 			vaultEnv.AuthMethod(k8sAuthMethod).Should(HaveAuthType(auth.MethodKubernetes))
 		})
 
 		It("Should not list the auth methods if they have not been created yet", func() {
-			methods, err := vaultAPI.ListAuthMethods()
+			methods, err := vaultAPI.ListAuthMethods(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(methods).NotTo(ContainElements(
 				k8sAuthMethod,
@@ -41,54 +43,54 @@ var _ = Describe("Auth API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.CreateAuthMethod(authMethod)).To(Succeed())
+			Expect(vaultAPI.CreateAuthMethod(context.TODO(), authMethod)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteAuthMethod(authMethod)).To(Succeed())
+			Expect(vaultAPI.DeleteAuthMethod(context.TODO(), authMethod)).To(Succeed())
 		})
 
 		It("Should throw an error when trying to create an auth method at a path that is already in use", func() {
-			Expect(vaultAPI.CreateAuthMethod(authMethod)).NotTo(Succeed())
+			Expect(vaultAPI.CreateAuthMethod(context.TODO(), authMethod)).NotTo(Succeed())
 		})
 
 		It("Should be able to read an existing auth method", func() {
-			method, err := vaultAPI.ReadAuthMethod(authMethod)
+			method, err := vaultAPI.ReadAuthMethod(context.TODO(), authMethod)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(method).To(Equal(authMethod))
 		})
 
 		It("Should throw an error when trying to read a non-existing auth method", func() {
-			method, err := vaultAPI.ReadAuthMethod(core.MountPath("does/not/exist"))
+			method, err := vaultAPI.ReadAuthMethod(context.TODO(), core.MountPath("does/not/exist"))
 			Expect(err).To(MatchError(core.ErrDoesNotExist))
 			Expect(method).To(BeNil())
 		})
 
 		It("Should include the existing auth methods when listing auth methods", func() {
-			methods, err := vaultAPI.ListAuthMethods()
+			methods, err := vaultAPI.ListAuthMethods(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(methods).To(ContainElement(authMethod))
 		})
 
 		It("Should return true when checking if an existing auth method exists", func() {
-			exists, err := vaultAPI.HasAuthMethod(authMethod)
+			exists, err := vaultAPI.HasAuthMethod(context.TODO(), authMethod)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(exists).To(BeTrue())
 		})
 
 		It("Should return false when checking if a non-existing auth method exists", func() {
-			exists, err := vaultAPI.HasAuthMethod(core.MountPath("does/not/exist"))
+			exists, err := vaultAPI.HasAuthMethod(context.TODO(), core.MountPath("does/not/exist"))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(exists).To(BeFalse())
 		})
 
 		It("Should be able to delete an existing auth method", func() {
-			Expect(vaultAPI.DeleteAuthMethod(authMethod)).To(Succeed())
+			Expect(vaultAPI.DeleteAuthMethod(context.TODO(), authMethod)).To(Succeed())
 			vaultEnv.AuthMethod(authMethod).Should(BeNil())
 		})
 
 		It("Should not throw an error when trying to delete a non-existing auth method", func() {
-			Expect(vaultAPI.DeleteAuthMethod(core.MountPath("does/not/exist"))).To(Succeed())
+			Expect(vaultAPI.DeleteAuthMethod(context.TODO(), core.MountPath("does/not/exist"))).To(Succeed())
 			vaultEnv.AuthMethod(core.MountPath("does/not/exist")).Should(BeNil())
 		})
 	})
@@ -1,6 +1,8 @@
 package e2e_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/youniqx/heist/pkg/vault/auth"
@@ -24,7 +26,7 @@ var _ = Describe("KubernetesRolesAPI", func() {
 				DisableLocalCAJWT:    false,
 			},
 		}
-		Expect(vaultAPI.UpdateKubernetesAuthMethod(method)).To(Succeed())
+		Expect(vaultAPI.UpdateKubernetesAuthMethod(context.TODO(), method)).To(Succeed())
 		vaultEnv.AuthMethod(method).Should(HavePath("managed/test-auth"))
 		vaultEnv.AuthMethod(method).Should(HaveAuthType(auth.MethodKubernetes))
 
@@ -38,7 +40,7 @@ var _ = Describe("KubernetesRolesAPI", func() {
 				"some-other-policy",
 			},
 		}
-		Expect(vaultAPI.UpdateKubernetesAuthRole(method, role)).To(Succeed())
+		Expect(vaultAPI.UpdateKubernetesAuthRole(context.TODO(), method, role)).To(Succeed())
 		vaultEnv.KubernetesAuthRole(method, role).Should(HaveName("some-name"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToNamespaces("some-namespace"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToServiceAccounts("some-service-account"))
@@ -48,7 +50,7 @@ var _ = Describe("KubernetesRolesAPI", func() {
 		))
 
 		By("Not making an update if nothing has changed")
-		Expect(vaultAPI.UpdateKubernetesAuthRole(method, role)).To(Succeed())
+		Expect(vaultAPI.UpdateKubernetesAuthRole(context.TODO(), method, role)).To(Succeed())
 		vaultEnv.KubernetesAuthRole(method, role).Should(HaveName("some-name"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToNamespaces("some-namespace"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToServiceAccounts("some-service-account"))
@@ -59,7 +61,7 @@ var _ = Describe("KubernetesRolesAPI", func() {
 
 		By("Updating it if policy changes")
 		role.Policies[1] = "some-new-policy"
-		Expect(vaultAPI.UpdateKubernetesAuthRole(method, role)).To(Succeed())
+		Expect(vaultAPI.UpdateKubernetesAuthRole(context.TODO(), method, role)).To(Succeed())
 		vaultEnv.KubernetesAuthRole(method, role).Should(HaveName("some-name"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToNamespaces("some-namespace"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToServiceAccounts("some-service-account"))
@@ -70,7 +72,7 @@ var _ = Describe("KubernetesRolesAPI", func() {
 
 		By("Updating it if serviceAccount changes")
 		role.BoundServiceAccounts[0] = "some-new-service-account"
-		Expect(vaultAPI.UpdateKubernetesAuthRole(method, role)).To(Succeed())
+		Expect(vaultAPI.UpdateKubernetesAuthRole(context.TODO(), method, role)).To(Succeed())
 		vaultEnv.KubernetesAuthRole(method, role).Should(HaveName("some-name"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToNamespaces("some-namespace"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToServiceAccounts("some-new-service-account"))
@@ -81,7 +83,7 @@ var _ = Describe("KubernetesRolesAPI", func() {
 
 		By("Updating it if serviceAccountNamespace changes")
 		role.BoundNamespaces[0] = "some-new-namespace"
-		Expect(vaultAPI.UpdateKubernetesAuthRole(method, role)).To(Succeed())
+		Expect(vaultAPI.UpdateKubernetesAuthRole(context.TODO(), method, role)).To(Succeed())
 		vaultEnv.KubernetesAuthRole(method, role).Should(HaveName("some-name"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToNamespaces("some-new-namespace"))
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeBoundToServiceAccounts("some-new-service-account"))
@@ -91,11 +93,11 @@ var _ = Describe("KubernetesRolesAPI", func() {
 		))
 
 		By("Deleting it")
-		Expect(vaultAPI.DeleteKubernetesAuthRole(method, role)).To(Succeed())
+		Expect(vaultAPI.DeleteKubernetesAuthRole(context.TODO(), method, role)).To(Succeed())
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeNil())
 
 		By("Not throwing an error when trying to delete a non existent one")
-		Expect(vaultAPI.DeleteKubernetesAuthRole(method, role)).To(Succeed())
+		Expect(vaultAPI.DeleteKubernetesAuthRole(context.TODO(), method, role)).To(Succeed())
 		vaultEnv.KubernetesAuthRole(method, role).Should(BeNil())
 	})
 })
@@ -17,6 +17,8 @@ limitations under the License.
 package e2e_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/youniqx/heist/pkg/vault/core"
@@ -39,7 +41,7 @@ var _ = Describe("KV Engine API", func() {
 			},
 		}
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
 		})
 
 		It("Should not exist initially", func() {
@@ -47,7 +49,7 @@ var _ = Describe("KV Engine API", func() {
 		})
 
 		It("Should be created with the intended config", func() {
-			Expect(vaultAPI.UpdateKvEngine(engine)).To(Succeed())
+			Expect(vaultAPI.UpdateKvEngine(context.TODO(), engine)).To(Succeed())
 			vaultEnv.KvEngine(engine).Should(HaveConfig(&kvengine.Config{
 				MaxVersions:        10,
 				CasRequired:        true,
@@ -56,7 +58,7 @@ var _ = Describe("KV Engine API", func() {
 		})
 
 		It("Should throw an error when trying to read the kv engine before creating it is completed", func() {
-			info, err := vaultAPI.ReadKvEngine(engine)
+			info, err := vaultAPI.ReadKvEngine(context.TODO(), engine)
 			Expect(err).To(MatchError(core.ErrDoesNotExist))
 			Expect(info).To(BeNil())
 		})
@@ -72,7 +74,7 @@ var _ = Describe("KV Engine API", func() {
 			},
 		}
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateKvEngine(engine)).To(Succeed())
+			Expect(vaultAPI.UpdateKvEngine(context.TODO(), engine)).To(Succeed())
 			vaultEnv.KvEngine(engine).ShouldNot(BeNil())
 			vaultEnv.KvEngine(engine).Should(HaveConfig(&kvengine.Config{
 				MaxVersions:        10,
@@ -81,7 +83,7 @@ var _ = Describe("KV Engine API", func() {
 			}))
 		})
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		It("Should have the intended config", func() {
@@ -93,11 +95,12 @@ var _ = Describe("KV Engine API", func() {
 		})
 
 		It("Should be possible to read the kv engine", func() {
-			info, err := vaultAPI.ReadKvEngine(engine)
+			info, err := vaultAPI.ReadKvEngine(context.TODO(), engine)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).To(Equal(&kvengine.KvEngine{
-				Path:   engine.Path,
-				Config: engine.Config,
+				Path:    engine.Path,
+				Version: kvengine.VersionV2,
+				Config:  engine.Config,
 			}))
 		})
 
@@ -110,7 +113,7 @@ var _ = Describe("KV Engine API", func() {
 					DeleteVersionAfter: "0s",
 				},
 			}
-			Expect(vaultAPI.UpdateKvEngine(engineWithDifferentConfig)).To(Succeed())
+			Expect(vaultAPI.UpdateKvEngine(context.TODO(), engineWithDifferentConfig)).To(Succeed())
 			vaultEnv.KvEngine(engine).Should(HaveConfig(&kvengine.Config{
 				MaxVersions:        20,
 				CasRequired:        false,
@@ -118,21 +121,32 @@ var _ = Describe("KV Engine API", func() {
 			}))
 		})
 
+		It("Should not be able to change the engine version", func() {
+			engineWithDifferentVersion := &kvengine.KvEngine{
+				Path:    engine.Path,
+				Version: kvengine.VersionV1,
+			}
+			Expect(vaultAPI.UpdateKvEngine(context.TODO(), engineWithDifferentVersion)).NotTo(Succeed())
+			info, err := vaultAPI.ReadKvEngine(context.TODO(), engine)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Version).To(Equal(kvengine.VersionV2))
+		})
+
 		It("Should list zero secrets", func() {
-			secrets, err := vaultAPI.ListKvSecrets(engine)
+			secrets, err := vaultAPI.ListKvSecrets(context.TODO(), engine)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(secrets).To(HaveLen(0))
 		})
 
 		It("Should be able to be deleted", func() {
-			Expect(vaultAPI.DeleteEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
 			vaultEnv.KvEngine(engine).Should(BeNil())
 		})
 
 		It("Should not throw an error when deleting an already deleted engine", func() {
-			Expect(vaultAPI.DeleteEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
 			vaultEnv.KvEngine(engine).Should(BeNil())
-			Expect(vaultAPI.DeleteEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
 		})
 	})
 
@@ -158,12 +172,12 @@ var _ = Describe("KV Engine API", func() {
 			},
 		}
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateKvEngine(engine)).To(Succeed())
-			Expect(vaultAPI.UpdateKvSecret(engine, secret)).To(Succeed())
-			Expect(vaultAPI.UpdateKvSecret(engine, nestedSecret)).To(Succeed())
+			Expect(vaultAPI.UpdateKvEngine(context.TODO(), engine)).To(Succeed())
+			Expect(vaultAPI.UpdateKvSecret(context.TODO(), engine, secret)).To(Succeed())
+			Expect(vaultAPI.UpdateKvSecret(context.TODO(), engine, nestedSecret)).To(Succeed())
 		})
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		It("Should have the intended config", func() {
@@ -187,7 +201,7 @@ var _ = Describe("KV Engine API", func() {
 		})
 
 		It("Should list the secrets it contains", func() {
-			secrets, err := vaultAPI.ListKvSecrets(engine)
+			secrets, err := vaultAPI.ListKvSecrets(context.TODO(), engine)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(secrets).To(HaveLen(2))
 			Expect(secrets).To(Equal([]core.SecretPath{
@@ -197,12 +211,12 @@ var _ = Describe("KV Engine API", func() {
 		})
 
 		It("Should be able to be deleted", func() {
-			Expect(vaultAPI.DeleteEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
 			vaultEnv.KvEngine(engine).Should(BeNil())
 		})
 
 		It("Should delete the secret along with the engine", func() {
-			Expect(vaultAPI.DeleteEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
 			vaultEnv.KvEngine(engine).Should(BeNil())
 			vaultEnv.KvSecret(engine, secret).Should(BeNil())
 			vaultEnv.KvSecret(engine, nestedSecret).Should(BeNil())
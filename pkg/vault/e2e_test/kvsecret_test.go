@@ -1,6 +1,8 @@
 package e2e_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/youniqx/heist/pkg/vault/core"
@@ -27,11 +29,11 @@ var _ = Describe("KV Secret API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateKvEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.UpdateKvEngine(context.TODO(), engine)).Should(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
 		})
 
 		It("Should not exist before creating it", func() {
@@ -39,7 +41,7 @@ var _ = Describe("KV Secret API", func() {
 		})
 
 		It("Should be able to create a secret in an existing engine", func() {
-			Expect(vaultAPI.UpdateKvSecret(engine, secret)).To(Succeed())
+			Expect(vaultAPI.UpdateKvSecret(context.TODO(), engine, secret)).To(Succeed())
 			vaultEnv.KvSecret(engine, secret).Should(HavePath("some-secret"))
 			vaultEnv.KvSecret(engine, secret).Should(HaveKvSecretFields(map[string]string{
 				"some-field": "some-value",
@@ -47,7 +49,7 @@ var _ = Describe("KV Secret API", func() {
 		})
 
 		It("Should throw an error if the engine does not exist", func() {
-			Expect(vaultAPI.UpdateKvSecret(core.MountPath("does/not/exist"), secret)).NotTo(Succeed())
+			Expect(vaultAPI.UpdateKvSecret(context.TODO(), core.MountPath("does/not/exist"), secret)).NotTo(Succeed())
 			vaultEnv.KvSecret(engine, secret).Should(BeNil())
 		})
 	})
@@ -69,45 +71,197 @@ var _ = Describe("KV Secret API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateKvEngine(engine)).Should(Succeed())
-			Expect(vaultAPI.UpdateKvSecret(engine, secret)).Should(Succeed())
+			Expect(vaultAPI.UpdateKvEngine(context.TODO(), engine)).Should(Succeed())
+			Expect(vaultAPI.UpdateKvSecret(context.TODO(), engine, secret)).Should(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
 		})
 
 		It("Should be able to read an existing secret", func() {
-			info, err := vaultAPI.ReadKvSecret(engine, secret)
+			info, err := vaultAPI.ReadKvSecret(context.TODO(), engine, secret)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).To(Equal(secret))
 		})
 
+		It("Should only write the secret if the expected version matches", func() {
+			updatedSecret := &kvsecret.KvSecret{
+				Path: "some-secret",
+				Fields: map[string]string{
+					"some-field": "some-other-value",
+				},
+			}
+
+			_, err := vaultAPI.UpdateKvSecretCAS(context.TODO(), engine, updatedSecret, 2)
+			Expect(err).To(MatchError(kvsecret.ErrCASConflict))
+			vaultEnv.KvSecret(engine, secret).Should(HaveKvSecretFieldWithValue("some-field", "some-value"))
+
+			version, err := vaultAPI.UpdateKvSecretCAS(context.TODO(), engine, updatedSecret, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal(2))
+			vaultEnv.KvSecret(engine, secret).Should(HaveKvSecretFieldWithValue("some-field", "some-other-value"))
+		})
+
 		It("Should throw an error when reading a non-existing secret", func() {
-			info, err := vaultAPI.ReadKvSecret(engine, core.SecretPath("does/not/exist"))
+			info, err := vaultAPI.ReadKvSecret(context.TODO(), engine, core.SecretPath("does/not/exist"))
 			Expect(err).To(MatchError(core.ErrDoesNotExist))
 			Expect(info).To(BeNil())
 		})
 
 		It("Should throw an error when reading a secret in a non existing engine", func() {
-			info, err := vaultAPI.ReadKvSecret(core.MountPath("does/not/exist"), secret)
+			info, err := vaultAPI.ReadKvSecret(context.TODO(), core.MountPath("does/not/exist"), secret)
 			Expect(err).To(MatchError(core.ErrDoesNotExist))
 			Expect(info).To(BeNil())
 		})
 
 		It("Should be able to delete an existing secret", func() {
-			Expect(vaultAPI.DeleteKvSecret(engine, secret)).To(Succeed())
+			Expect(vaultAPI.DeleteKvSecret(context.TODO(), engine, secret)).To(Succeed())
 			vaultEnv.KvSecret(engine, secret).Should(BeNil())
 		})
 
 		It("Should not throw an error when deleting a non-existing secret in an existing engine", func() {
-			Expect(vaultAPI.DeleteKvSecret(engine, core.SecretPath("does/not/exist"))).To(Succeed())
+			Expect(vaultAPI.DeleteKvSecret(context.TODO(), engine, core.SecretPath("does/not/exist"))).To(Succeed())
 			vaultEnv.KvSecret(engine, core.SecretPath("does/not/exist")).Should(BeNil())
 		})
 
 		It("Should not throw an error when deleting an existing secret in a non-existing engine", func() {
-			Expect(vaultAPI.DeleteKvSecret(core.MountPath("does/not/exist"), secret)).To(Succeed())
+			Expect(vaultAPI.DeleteKvSecret(context.TODO(), core.MountPath("does/not/exist"), secret)).To(Succeed())
 			vaultEnv.KvSecret(core.MountPath("does/not/exist"), secret).Should(BeNil())
 		})
 	})
+
+	When("Managing the versions of a secret", func() {
+		engine := &kvengine.KvEngine{
+			Path: "managed/kv/some-engine",
+			Config: &kvengine.Config{
+				MaxVersions:        10,
+				CasRequired:        true,
+				DeleteVersionAfter: "0s",
+			},
+		}
+		firstVersion := &kvsecret.KvSecret{
+			Path: "some-secret",
+			Fields: map[string]string{
+				"some-field": "some-value",
+			},
+		}
+		secondVersion := &kvsecret.KvSecret{
+			Path: "some-secret",
+			Fields: map[string]string{
+				"some-field": "some-other-value",
+			},
+		}
+
+		BeforeEach(func() {
+			Expect(vaultAPI.UpdateKvEngine(context.TODO(), engine)).Should(Succeed())
+			Expect(vaultAPI.UpdateKvSecret(context.TODO(), engine, firstVersion)).Should(Succeed())
+			Expect(vaultAPI.UpdateKvSecret(context.TODO(), engine, secondVersion)).Should(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
+		})
+
+		It("Should list all versions of the secret", func() {
+			metadata, err := vaultAPI.ListKvSecretVersions(context.TODO(), engine, firstVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.CurrentVersion).To(Equal(2))
+			Expect(metadata.Versions).To(HaveLen(2))
+			Expect(metadata.Versions[0].Version).To(Equal(1))
+			Expect(metadata.Versions[1].Version).To(Equal(2))
+		})
+
+		It("Should be able to read a previous version", func() {
+			info, err := vaultAPI.ReadKvSecretVersion(context.TODO(), engine, firstVersion, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info).To(Equal(firstVersion))
+		})
+
+		It("Should be able to delete and undelete a version", func() {
+			Expect(vaultAPI.DeleteKvSecretVersions(context.TODO(), engine, firstVersion, []int{1})).To(Succeed())
+			metadata, err := vaultAPI.ListKvSecretVersions(context.TODO(), engine, firstVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.GetVersion(1).IsAvailable()).To(BeFalse())
+
+			Expect(vaultAPI.UndeleteKvSecretVersions(context.TODO(), engine, firstVersion, []int{1})).To(Succeed())
+			metadata, err = vaultAPI.ListKvSecretVersions(context.TODO(), engine, firstVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.GetVersion(1).IsAvailable()).To(BeTrue())
+		})
+
+		It("Should be able to destroy a version", func() {
+			Expect(vaultAPI.DestroyKvSecretVersions(context.TODO(), engine, firstVersion, []int{1})).To(Succeed())
+			metadata, err := vaultAPI.ListKvSecretVersions(context.TODO(), engine, firstVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.GetVersion(1).Destroyed).To(BeTrue())
+		})
+
+		It("Should reject version operations on kv v1 engines", func() {
+			v1Engine := &kvengine.KvEngine{
+				Path:    "managed/kv/some-v1-engine",
+				Version: kvengine.VersionV1,
+			}
+			_, err := vaultAPI.ListKvSecretVersions(context.TODO(), v1Engine, firstVersion)
+			Expect(err).To(MatchError(kvsecret.ErrNotVersioned))
+		})
+	})
+
+	When("Managing secrets in a kv v1 engine", func() {
+		engine := &kvengine.KvEngine{
+			Path:    "managed/kv/some-v1-engine",
+			Version: kvengine.VersionV1,
+		}
+		secret := &kvsecret.KvSecret{
+			Path: "some-secret",
+			Fields: map[string]string{
+				"some-field": "some-value",
+			},
+		}
+
+		BeforeEach(func() {
+			Expect(vaultAPI.UpdateKvEngine(context.TODO(), engine)).Should(Succeed())
+			Expect(vaultAPI.UpdateKvSecret(context.TODO(), engine, secret)).Should(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
+		})
+
+		It("Should be able to read an existing secret", func() {
+			info, err := vaultAPI.ReadKvSecret(context.TODO(), engine, secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info).To(Equal(secret))
+		})
+
+		It("Should detect the engine version when only the mount path is known", func() {
+			info, err := vaultAPI.ReadKvSecret(context.TODO(), core.MountPath(engine.Path), secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info).To(Equal(secret))
+		})
+
+		It("Should be able to update an existing secret", func() {
+			updatedSecret := &kvsecret.KvSecret{
+				Path: "some-secret",
+				Fields: map[string]string{
+					"some-field": "some-other-value",
+				},
+			}
+			Expect(vaultAPI.UpdateKvSecret(context.TODO(), engine, updatedSecret)).To(Succeed())
+			vaultEnv.KvSecret(engine, secret).Should(HaveKvSecretFields(map[string]string{
+				"some-field": "some-other-value",
+			}))
+		})
+
+		It("Should be able to list secrets", func() {
+			secrets, err := vaultAPI.ListKvSecrets(context.TODO(), engine)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(ConsistOf(core.SecretPath("some-secret")))
+		})
+
+		It("Should be able to delete an existing secret", func() {
+			Expect(vaultAPI.DeleteKvSecret(context.TODO(), engine, secret)).To(Succeed())
+			vaultEnv.KvSecret(engine, secret).Should(BeNil())
+		})
+	})
 })
@@ -1,6 +1,8 @@
 package e2e_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/youniqx/heist/pkg/vault/core"
@@ -37,13 +39,13 @@ var _ = Describe("Mount API", func() {
 		}
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(kvv1Engine)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(kvv2Engine)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(transitEngine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), kvv1Engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), kvv2Engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), transitEngine)).Should(Succeed())
 		})
 
 		It("Should be able to mount a new kv-v2 engine when setting version 2 explicitly", func() {
-			Expect(vaultAPI.MountEngine(kvv2Engine)).Should(Succeed())
+			Expect(vaultAPI.MountEngine(context.TODO(), kvv2Engine)).Should(Succeed())
 			vaultEnv.Mount(kvv2Engine).Should(HavePath("another/path"))
 			vaultEnv.Mount(kvv2Engine).Should(HaveMountType(mount.TypeKVV2))
 		})
@@ -54,31 +56,31 @@ var _ = Describe("Mount API", func() {
 				Type:   kvv2Engine.Type,
 				Config: nil,
 			}
-			Expect(vaultAPI.MountEngine(engineWithoutConfig)).Should(Succeed())
+			Expect(vaultAPI.MountEngine(context.TODO(), engineWithoutConfig)).Should(Succeed())
 			vaultEnv.Mount(engineWithoutConfig).Should(HavePath("another/path"))
 			vaultEnv.Mount(engineWithoutConfig).Should(HaveMountType(mount.TypeKVV2))
 		})
 
 		It("Should be able to mount a new kv-v1 engine", func() {
-			Expect(vaultAPI.MountEngine(kvv1Engine)).Should(Succeed())
+			Expect(vaultAPI.MountEngine(context.TODO(), kvv1Engine)).Should(Succeed())
 			vaultEnv.Mount(kvv1Engine).Should(HavePath("some/path"))
 			vaultEnv.Mount(kvv1Engine).Should(HaveMountType(mount.TypeKVV1))
 		})
 
 		It("Should be able to mount a new transit engine", func() {
-			Expect(vaultAPI.MountEngine(transitEngine)).Should(Succeed())
+			Expect(vaultAPI.MountEngine(context.TODO(), transitEngine)).Should(Succeed())
 			vaultEnv.Mount(transitEngine).Should(HavePath("yet-another/path"))
 			vaultEnv.Mount(transitEngine).Should(HaveMountType(mount.TypeTransit))
 		})
 
 		It("Should be able to mount a new pki engine", func() {
-			Expect(vaultAPI.MountEngine(pkiEngine)).Should(Succeed())
+			Expect(vaultAPI.MountEngine(context.TODO(), pkiEngine)).Should(Succeed())
 			vaultEnv.Mount(pkiEngine).Should(HavePath("yet-another-new/path"))
 			vaultEnv.Mount(pkiEngine).Should(HaveMountType(mount.TypePKI))
 		})
 
 		It("Should be able to list preexisting mounts", func() {
-			mounts, err := vaultAPI.ListMounts()
+			mounts, err := vaultAPI.ListMounts(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(mounts).NotTo(ContainElements(
 				kvv1Engine,
@@ -116,17 +118,17 @@ var _ = Describe("Mount API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.MountEngine(kvEngine)).Should(Succeed())
-			Expect(vaultAPI.MountEngine(transitEngine)).Should(Succeed())
+			Expect(vaultAPI.MountEngine(context.TODO(), kvEngine)).Should(Succeed())
+			Expect(vaultAPI.MountEngine(context.TODO(), transitEngine)).Should(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(kvEngine)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(transitEngine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), kvEngine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), transitEngine)).Should(Succeed())
 		})
 
 		It("Should include the mounted engine in the returned mount list", func() {
-			mounts, err := vaultAPI.ListMounts()
+			mounts, err := vaultAPI.ListMounts(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
 			Expect(mounts).To(ContainElements(
 				kvEngine,
@@ -135,16 +137,16 @@ var _ = Describe("Mount API", func() {
 		})
 
 		It("Should throw an error when trying to mount something at a path that is already in use", func() {
-			Expect(vaultAPI.MountEngine(kvEngine)).NotTo(Succeed())
+			Expect(vaultAPI.MountEngine(context.TODO(), kvEngine)).NotTo(Succeed())
 		})
 
 		It("Should be able to tune the engine", func() {
-			Expect(vaultAPI.TuneEngine(kvEngine, tuneConfig)).To(Succeed())
+			Expect(vaultAPI.TuneEngine(context.TODO(), kvEngine, tuneConfig)).To(Succeed())
 			vaultEnv.TuneConfig(kvEngine).Should(Equal(tuneConfig))
 		})
 
 		It("Should be able read the engine tune config", func() {
-			tuneConfig, err := vaultAPI.ReadTuneConfig(kvEngine)
+			tuneConfig, err := vaultAPI.ReadTuneConfig(context.TODO(), kvEngine)
 			Expect(tuneConfig).To(Equal(&mount.TuneConfig{
 				DefaultLeaseTTL: core.NewTTL(32 * core.Day),
 				MaxLeaseTTL:     core.NewTTL(32 * core.Day),
@@ -153,40 +155,40 @@ var _ = Describe("Mount API", func() {
 		})
 
 		It("Should return true when checking if an existing mount exists", func() {
-			exists, err := vaultAPI.HasEngine(kvEngine)
+			exists, err := vaultAPI.HasEngine(context.TODO(), kvEngine)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(exists).To(BeTrue())
 		})
 
 		It("Should be able to read an existing mount", func() {
-			engine, err := vaultAPI.ReadMount(kvEngine)
+			engine, err := vaultAPI.ReadMount(context.TODO(), kvEngine)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(engine).To(Equal(kvEngine))
 		})
 
 		It("Should be able to delete an existing mount", func() {
-			Expect(vaultAPI.DeleteEngine(kvEngine)).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), kvEngine)).To(Succeed())
 			vaultEnv.Mount(kvEngine).Should(BeNil())
 		})
 
 		It("Should return false when checking if a non-existing mount exists", func() {
-			exists, err := vaultAPI.HasEngine(core.MountPath("some/non-existing/engine"))
+			exists, err := vaultAPI.HasEngine(context.TODO(), core.MountPath("some/non-existing/engine"))
 			Expect(err).NotTo(HaveOccurred())
 			Expect(exists).To(BeFalse())
 		})
 
 		It("Should throw an error when trying to read a non-existing mount", func() {
-			engine, err := vaultAPI.ReadMount(core.MountPath("some/non-existing/engine"))
+			engine, err := vaultAPI.ReadMount(context.TODO(), core.MountPath("some/non-existing/engine"))
 			Expect(err).To(MatchError(core.ErrDoesNotExist))
 			Expect(engine).To(BeNil())
 		})
 
 		It("Should not throw an error when trying to delete a non-existing mount", func() {
-			Expect(vaultAPI.DeleteEngine(core.MountPath("some/non-existing/engine"))).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), core.MountPath("some/non-existing/engine"))).To(Succeed())
 		})
 
 		It("Should be able to reload the transit plugin backend", func() {
-			Expect(vaultAPI.ReloadPluginBackends(mount.PluginTransit)).To(Succeed())
+			Expect(vaultAPI.ReloadPluginBackends(context.TODO(), mount.PluginTransit)).To(Succeed())
 		})
 	})
 })
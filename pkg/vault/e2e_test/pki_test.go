@@ -1,6 +1,7 @@
 package e2e_test
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -35,7 +36,7 @@ var _ = Describe("PKI API", func() {
 		}
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).Should(Succeed())
 		})
 
 		It("Should not exist before creating it", func() {
@@ -43,7 +44,7 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should be able to create a pki engine", func() {
-			Expect(vaultAPI.UpdatePKIEngine(engine)).To(Succeed())
+			Expect(vaultAPI.UpdatePKIEngine(context.TODO(), engine)).To(Succeed())
 			vaultEnv.Mount(engine).Should(Equal(&mount.Mount{
 				Path: "managed/pki/some-engine",
 				Type: "pki",
@@ -72,7 +73,7 @@ var _ = Describe("PKI API", func() {
 		}
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should not exist before creating it", func() {
@@ -80,10 +81,10 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should be able to be created implicitly with an update", func() {
-			_, err := vaultAPI.ReadCACertificatePEM(root)
+			_, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).To(HaveOccurred())
 
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 			vaultEnv.Mount(root).Should(Equal(&mount.Mount{
 				Path: "managed/pki/some-root",
 				Type: "pki",
@@ -93,13 +94,13 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(root)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).NotTo(BeEmpty())
 		})
 
 		It("Should be able to be created explicitly in internal mode", func() {
-			info, err := vaultAPI.CreateRootCA(pki.ModeInternal, root)
+			info, err := vaultAPI.CreateRootCA(context.TODO(), pki.ModeInternal, root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-root"))
@@ -110,7 +111,7 @@ var _ = Describe("PKI API", func() {
 			Expect(info.CertificateChain).NotTo(BeEmpty())
 			Expect(info.IssuingCertificateAuthority).To(Equal(info.Certificate))
 
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 			vaultEnv.Mount(root).Should(Equal(&mount.Mount{
 				Path: "managed/pki/some-root",
 				Type: "pki",
@@ -120,13 +121,13 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(root)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(info.Certificate))
 		})
 
 		It("Should be able to be created explicitly in exported mode", func() {
-			info, err := vaultAPI.CreateRootCA(pki.ModeExported, root)
+			info, err := vaultAPI.CreateRootCA(context.TODO(), pki.ModeExported, root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-root"))
@@ -137,7 +138,7 @@ var _ = Describe("PKI API", func() {
 			Expect(info.CertificateChain).NotTo(BeEmpty())
 			Expect(info.IssuingCertificateAuthority).To(Equal(info.Certificate))
 
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 			vaultEnv.Mount(root).Should(Equal(&mount.Mount{
 				Path: "managed/pki/some-root",
 				Type: "pki",
@@ -147,19 +148,19 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(root)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(info.Certificate))
 		})
 
 		It("Should not modify an existing ca", func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
-			certA, err := vaultAPI.ReadCACertificatePEM(root)
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
+			certA, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certA).NotTo(BeEmpty())
 
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
-			certB, err := vaultAPI.ReadCACertificatePEM(root)
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
+			certB, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certB).NotTo(BeEmpty())
 
@@ -184,11 +185,11 @@ var _ = Describe("PKI API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should exist", func() {
@@ -196,13 +197,13 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should throw an error if I tried to create it again", func() {
-			info, err := vaultAPI.CreateRootCA(pki.ModeInternal, root)
+			info, err := vaultAPI.CreateRootCA(context.TODO(), pki.ModeInternal, root)
 			Expect(err).To(HaveOccurred())
 			Expect(info).To(BeNil())
 		})
 
 		It("Should be readable", func() {
-			ca, err := vaultAPI.ReadCA(root)
+			ca, err := vaultAPI.ReadCA(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(ca).To(Equal(&pki.CA{
 				Path:     "managed/pki/some-root",
@@ -224,11 +225,11 @@ var _ = Describe("PKI API", func() {
 				TidyRevokedCerts: true,
 				SafetyBuffer:     core.NewTTL(3 * core.Day),
 			}
-			Expect(vaultAPI.Tidy(root, settings)).To(Succeed())
+			Expect(vaultAPI.Tidy(context.TODO(), root, settings)).To(Succeed())
 		})
 
 		It("Should be possible to rotate CRLs", func() {
-			Expect(vaultAPI.RotateCRLs(root)).To(Succeed())
+			Expect(vaultAPI.RotateCRLs(context.TODO(), root)).To(Succeed())
 		})
 	})
 
@@ -253,7 +254,7 @@ var _ = Describe("PKI API", func() {
 		}
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should not exist before creating it", func() {
@@ -261,10 +262,10 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should be able to be created implicitly with an update", func() {
-			_, err := vaultAPI.ReadCACertificatePEM(root)
+			_, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).To(HaveOccurred())
 
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 			vaultEnv.Mount(root).Should(Equal(&mount.Mount{
 				Path: "managed/pki/some-root",
 				Type: "pki",
@@ -274,13 +275,13 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(root)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(rootCertificate))
 		})
 
 		It("Should be able to be created explicitly in internal mode", func() {
-			info, err := vaultAPI.CreateRootCA(pki.ModeInternal, root)
+			info, err := vaultAPI.CreateRootCA(context.TODO(), pki.ModeInternal, root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-root"))
@@ -291,7 +292,7 @@ var _ = Describe("PKI API", func() {
 			Expect(info.CertificateChain).To(Equal(rootCertificate))
 			Expect(info.IssuingCertificateAuthority).To(Equal(rootCertificate))
 
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 			vaultEnv.Mount(root).Should(Equal(&mount.Mount{
 				Path: "managed/pki/some-root",
 				Type: "pki",
@@ -301,13 +302,13 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(root)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(rootCertificate))
 		})
 
 		It("Should be able to be created explicitly in exported mode", func() {
-			info, err := vaultAPI.CreateRootCA(pki.ModeExported, root)
+			info, err := vaultAPI.CreateRootCA(context.TODO(), pki.ModeExported, root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-root"))
@@ -318,7 +319,7 @@ var _ = Describe("PKI API", func() {
 			Expect(info.CertificateChain).To(Equal(rootCertificate))
 			Expect(info.IssuingCertificateAuthority).To(Equal(rootCertificate))
 
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 			vaultEnv.Mount(root).Should(Equal(&mount.Mount{
 				Path: "managed/pki/some-root",
 				Type: "pki",
@@ -328,19 +329,19 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(root)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(info.Certificate))
 		})
 
 		It("Should not modify an existing ca", func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
-			certA, err := vaultAPI.ReadCACertificatePEM(root)
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
+			certA, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certA).To(Equal(rootCertificate))
 
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
-			certB, err := vaultAPI.ReadCACertificatePEM(root)
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
+			certB, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certB).To(Equal(rootCertificate))
 		})
@@ -367,11 +368,11 @@ var _ = Describe("PKI API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should exist", func() {
@@ -379,19 +380,19 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should throw an error if I tried to create it again", func() {
-			info, err := vaultAPI.CreateRootCA(pki.ModeInternal, root)
+			info, err := vaultAPI.CreateRootCA(context.TODO(), pki.ModeInternal, root)
 			Expect(err).To(HaveOccurred())
 			Expect(info).To(BeNil())
 		})
 
 		It("Should have the imported cert", func() {
-			pem, err := vaultAPI.ReadCACertificatePEM(root)
+			pem, err := vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pem).To(Equal(rootCertificate))
 		})
 
 		It("Should be readable", func() {
-			ca, err := vaultAPI.ReadCA(root)
+			ca, err := vaultAPI.ReadCA(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(ca).To(Equal(&pki.CA{
 				Path:     "managed/pki/some-root",
@@ -417,11 +418,11 @@ var _ = Describe("PKI API", func() {
 				TidyRevokedCerts: true,
 				SafetyBuffer:     core.NewTTL(3 * core.Day),
 			}
-			Expect(vaultAPI.Tidy(root, settings)).To(Succeed())
+			Expect(vaultAPI.Tidy(context.TODO(), root, settings)).To(Succeed())
 		})
 
 		It("Should be possible to rotate CRLs", func() {
-			Expect(vaultAPI.RotateCRLs(root)).To(Succeed())
+			Expect(vaultAPI.RotateCRLs(context.TODO(), root)).To(Succeed())
 		})
 	})
 
@@ -474,17 +475,17 @@ var _ = Describe("PKI API", func() {
 		var rootCACert string
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 			var err error
-			rootCACert, err = vaultAPI.ReadCACertificatePEM(root)
+			rootCACert, err = vaultAPI.ReadCACertificatePEM(context.TODO(), root)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(rootCACert).NotTo(BeEmpty())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(intermediate)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(intermediate2)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), intermediate)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), intermediate2)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should not exist before creating it", func() {
@@ -492,7 +493,7 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should be able to be created implicitly with an update", func() {
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
 			vaultEnv.Mount(intermediate).Should(Equal(&mount.Mount{
 				Path: "managed/pki/some-intermediate",
 				Type: "pki",
@@ -502,13 +503,13 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).NotTo(BeEmpty())
 		})
 
 		It("Should be able to be created explicitly in internal mode", func() {
-			info, err := vaultAPI.CreateIntermediateCA(pki.ModeInternal, root, intermediate)
+			info, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeInternal, root, intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-intermediate"))
@@ -531,13 +532,13 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(info.Certificate))
 		})
 
 		It("Should be able to be a longer chain in internal mode", func() {
-			info, err := vaultAPI.CreateIntermediateCA(pki.ModeInternal, root, intermediate)
+			info, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeInternal, root, intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-intermediate"))
@@ -560,11 +561,11 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(info.Certificate))
 
-			info2, err := vaultAPI.CreateIntermediateCA(pki.ModeInternal, intermediate, intermediate2)
+			info2, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeInternal, intermediate, intermediate2)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info2).NotTo(BeNil())
 			Expect(info2.Path).To(Equal("managed/pki/some-intermediate-2"))
@@ -585,13 +586,13 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err = vaultAPI.ReadCACertificatePEM(intermediate2)
+			cert, err = vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate2)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(info2.Certificate))
 		})
 
 		It("Should be able to be created explicitly in exported mode", func() {
-			info, err := vaultAPI.CreateIntermediateCA(pki.ModeExported, root, intermediate)
+			info, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeExported, root, intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-intermediate"))
@@ -614,13 +615,13 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(info.Certificate))
 		})
 
 		It("Should be able to be a longer chain in exported mode", func() {
-			info, err := vaultAPI.CreateIntermediateCA(pki.ModeExported, root, intermediate)
+			info, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeExported, root, intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-intermediate"))
@@ -643,11 +644,11 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(info.Certificate))
 
-			info2, err := vaultAPI.CreateIntermediateCA(pki.ModeExported, intermediate, intermediate2)
+			info2, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeExported, intermediate, intermediate2)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info2).NotTo(BeNil())
 			Expect(info2.Path).To(Equal("managed/pki/some-intermediate-2"))
@@ -668,19 +669,19 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err = vaultAPI.ReadCACertificatePEM(intermediate2)
+			cert, err = vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate2)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(info2.Certificate))
 		})
 
 		It("Should not modify an already existing CA", func() {
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
-			certA, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
+			certA, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certA).NotTo(BeEmpty())
 
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
-			certB, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
+			certB, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certB).NotTo(BeEmpty())
 
@@ -720,13 +721,13 @@ var _ = Describe("PKI API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(intermediate)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), intermediate)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should exist", func() {
@@ -734,13 +735,13 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should throw an error if I tried to create it again", func() {
-			info, err := vaultAPI.CreateIntermediateCA(pki.ModeInternal, root, intermediate)
+			info, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeInternal, root, intermediate)
 			Expect(err).To(HaveOccurred())
 			Expect(info).To(BeNil())
 		})
 
 		It("Should be readable", func() {
-			ca, err := vaultAPI.ReadCA(intermediate)
+			ca, err := vaultAPI.ReadCA(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(ca).To(Equal(&pki.CA{
 				Path:     "managed/pki/some-intermediate",
@@ -762,11 +763,11 @@ var _ = Describe("PKI API", func() {
 				TidyRevokedCerts: true,
 				SafetyBuffer:     core.NewTTL(3 * core.Day),
 			}
-			Expect(vaultAPI.Tidy(intermediate, settings)).To(Succeed())
+			Expect(vaultAPI.Tidy(context.TODO(), intermediate, settings)).To(Succeed())
 		})
 
 		It("Should be possible to rotate CRLs", func() {
-			Expect(vaultAPI.RotateCRLs(intermediate)).To(Succeed())
+			Expect(vaultAPI.RotateCRLs(context.TODO(), intermediate)).To(Succeed())
 		})
 	})
 
@@ -810,12 +811,12 @@ var _ = Describe("PKI API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(intermediate)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), intermediate)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should not exist before creating it", func() {
@@ -823,7 +824,7 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should be able to be created explicitly in internal mode", func() {
-			info, err := vaultAPI.CreateIntermediateCA(pki.ModeInternal, root, intermediate)
+			info, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeInternal, root, intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-intermediate"))
@@ -843,13 +844,13 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(intermediateCertificate))
 		})
 
 		It("Should be able to be created explicitly in exported mode", func() {
-			info, err := vaultAPI.CreateIntermediateCA(pki.ModeExported, root, intermediate)
+			info, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeExported, root, intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).NotTo(BeNil())
 			Expect(info.Path).To(Equal("managed/pki/some-intermediate"))
@@ -869,19 +870,19 @@ var _ = Describe("PKI API", func() {
 				},
 			}))
 
-			cert, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			cert, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cert).To(Equal(intermediateCertificate))
 		})
 
 		It("Should not modify an already existing CA", func() {
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
-			certA, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
+			certA, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certA).To(Equal(intermediateCertificate))
 
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
-			certB, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
+			certB, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certB).To(Equal(intermediateCertificate))
 		})
@@ -927,13 +928,13 @@ var _ = Describe("PKI API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(intermediate)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), intermediate)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should exist", func() {
@@ -941,19 +942,19 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should throw an error if I tried to create it again", func() {
-			info, err := vaultAPI.CreateIntermediateCA(pki.ModeInternal, root, intermediate)
+			info, err := vaultAPI.CreateIntermediateCA(context.TODO(), pki.ModeInternal, root, intermediate)
 			Expect(err).To(HaveOccurred())
 			Expect(info).To(BeNil())
 		})
 
 		It("should have its imported cert", func() {
-			pem, err := vaultAPI.ReadCACertificatePEM(intermediate)
+			pem, err := vaultAPI.ReadCACertificatePEM(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(pem).To(Equal(intermediateCertificate))
 		})
 
 		It("Should be readable", func() {
-			ca, err := vaultAPI.ReadCA(intermediate)
+			ca, err := vaultAPI.ReadCA(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(ca).To(Equal(&pki.CA{
 				Path:     "managed/pki/some-intermediate",
@@ -977,11 +978,11 @@ var _ = Describe("PKI API", func() {
 				TidyRevokedCerts: true,
 				SafetyBuffer:     core.NewTTL(3 * core.Day),
 			}
-			Expect(vaultAPI.Tidy(intermediate, settings)).To(Succeed())
+			Expect(vaultAPI.Tidy(context.TODO(), intermediate, settings)).To(Succeed())
 		})
 
 		It("Should be possible to rotate CRLs", func() {
-			Expect(vaultAPI.RotateCRLs(intermediate)).To(Succeed())
+			Expect(vaultAPI.RotateCRLs(context.TODO(), intermediate)).To(Succeed())
 		})
 	})
 
@@ -1030,17 +1031,17 @@ var _ = Describe("PKI API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(intermediate)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), intermediate)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should be able to create a certificate role", func() {
-			Expect(vaultAPI.UpdateCertificateRole(intermediate, role)).To(Succeed())
+			Expect(vaultAPI.UpdateCertificateRole(context.TODO(), intermediate, role)).To(Succeed())
 		})
 	})
 
@@ -1092,18 +1093,18 @@ var _ = Describe("PKI API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
-			Expect(vaultAPI.UpdateCertificateRole(intermediate, role)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
+			Expect(vaultAPI.UpdateCertificateRole(context.TODO(), intermediate, role)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(intermediate)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), intermediate)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should be able to delete non-existing certificate roles", func() {
-			Expect(vaultAPI.DeleteCertificateRole(intermediate, core.RoleName("some-nonexisting-role"))).To(Succeed())
+			Expect(vaultAPI.DeleteCertificateRole(context.TODO(), intermediate, core.RoleName("some-nonexisting-role"))).To(Succeed())
 		})
 
 		It("Should exist", func() {
@@ -1111,7 +1112,7 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should be able to issue a certificate", func() {
-			cert, err := vaultAPI.IssueCertificate(intermediate, role, &pki.IssueCertOptions{
+			cert, err := vaultAPI.IssueCertificate(context.TODO(), intermediate, role, &pki.IssueCertOptions{
 				CommonName:        "example.com",
 				DNSSans:           nil,
 				ExcludeCNFromSans: false,
@@ -1152,7 +1153,7 @@ var _ = Describe("PKI API", func() {
 				Type: "CERTIFICATE REQUEST", Bytes: csrBytes,
 			})
 
-			cert, err := vaultAPI.SignCertificateSigningRequest(intermediate, role, &pki.SignCsr{
+			cert, err := vaultAPI.SignCertificateSigningRequest(context.TODO(), intermediate, role, &pki.SignCsr{
 				CSR:        string(csr),
 				CommonName: "test.example.com",
 			})
@@ -1162,12 +1163,12 @@ var _ = Describe("PKI API", func() {
 
 		It("Should be able to be deleted", func() {
 			vaultEnv.CertificateRole(intermediate, role).ShouldNot(BeNil())
-			Expect(vaultAPI.DeleteCertificateRole(intermediate, role)).To(Succeed())
+			Expect(vaultAPI.DeleteCertificateRole(context.TODO(), intermediate, role)).To(Succeed())
 			vaultEnv.CertificateRole(intermediate, role).Should(BeNil())
 		})
 
 		It("Should be readable", func() {
-			certRole, err := vaultAPI.ReadCertificateRole(intermediate, role)
+			certRole, err := vaultAPI.ReadCertificateRole(context.TODO(), intermediate, role)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certRole).To(Equal(&pki.CertificateRole{
 				Name: "some-role",
@@ -1271,11 +1272,11 @@ var _ = Describe("PKI API", func() {
 		var certificate *pki.Certificate
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateRootCA(root)).To(Succeed())
-			Expect(vaultAPI.UpdateIntermediateCA(root, intermediate)).To(Succeed())
-			Expect(vaultAPI.UpdateCertificateRole(intermediate, role)).To(Succeed())
+			Expect(vaultAPI.UpdateRootCA(context.TODO(), root)).To(Succeed())
+			Expect(vaultAPI.UpdateIntermediateCA(context.TODO(), root, intermediate)).To(Succeed())
+			Expect(vaultAPI.UpdateCertificateRole(context.TODO(), intermediate, role)).To(Succeed())
 			var err error
-			certificate, err = vaultAPI.IssueCertificate(intermediate, role, &pki.IssueCertOptions{
+			certificate, err = vaultAPI.IssueCertificate(context.TODO(), intermediate, role, &pki.IssueCertOptions{
 				CommonName:        "example.com",
 				DNSSans:           nil,
 				ExcludeCNFromSans: false,
@@ -1285,8 +1286,8 @@ var _ = Describe("PKI API", func() {
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(intermediate)).Should(Succeed())
-			Expect(vaultAPI.DeleteEngine(root)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), intermediate)).Should(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), root)).Should(Succeed())
 		})
 
 		It("Should be properly configured", func() {
@@ -1314,11 +1315,11 @@ var _ = Describe("PKI API", func() {
 		})
 
 		It("Should be able to be revoked", func() {
-			Expect(vaultAPI.RevokeCertificate(intermediate, certificate)).To(Succeed())
+			Expect(vaultAPI.RevokeCertificate(context.TODO(), intermediate, certificate)).To(Succeed())
 		})
 
 		It("Should appear in the the list of certificates of the CA", func() {
-			certs, err := vaultAPI.ListCerts(intermediate)
+			certs, err := vaultAPI.ListCerts(context.TODO(), intermediate)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(certs).To(ContainElement(certificate.SerialNumber))
 		})
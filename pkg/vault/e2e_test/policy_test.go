@@ -17,6 +17,8 @@ limitations under the License.
 package e2e_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/youniqx/heist/pkg/vault/kvengine"
@@ -37,7 +39,7 @@ var _ = Describe("PolicyAPI", func() {
 				DeleteVersionAfter: "0s",
 			},
 		}
-		Expect(vaultAPI.UpdateKvEngine(engine)).To(Succeed())
+		Expect(vaultAPI.UpdateKvEngine(context.TODO(), engine)).To(Succeed())
 		vaultEnv.KvEngine(engine).Should(HavePath("managed/engine"))
 		vaultEnv.KvEngine(engine).Should(HaveConfig(&kvengine.Config{
 			MaxVersions:        10,
@@ -57,7 +59,7 @@ var _ = Describe("PolicyAPI", func() {
 				},
 			},
 		}
-		Expect(vaultAPI.UpdatePolicy(newPolicy)).To(Succeed())
+		Expect(vaultAPI.UpdatePolicy(context.TODO(), newPolicy)).To(Succeed())
 		vaultEnv.Policy(newPolicy).Should(HaveName("managed.some-namespace.some-secret"))
 		vaultEnv.Policy(newPolicy).Should(HaveRules(
 			&policy.Rule{
@@ -69,7 +71,7 @@ var _ = Describe("PolicyAPI", func() {
 		))
 
 		By("Not making an update if nothing has changed")
-		Expect(vaultAPI.UpdatePolicy(newPolicy)).To(Succeed())
+		Expect(vaultAPI.UpdatePolicy(context.TODO(), newPolicy)).To(Succeed())
 		vaultEnv.Policy(newPolicy).Should(HaveName("managed.some-namespace.some-secret"))
 		vaultEnv.Policy(newPolicy).Should(HaveRules(
 			&policy.Rule{
@@ -82,7 +84,7 @@ var _ = Describe("PolicyAPI", func() {
 
 		By("Updating it if something changed")
 		newPolicy.Rules[0].Capabilities = append(newPolicy.Rules[0].Capabilities, policy.CreateCapability)
-		Expect(vaultAPI.UpdatePolicy(newPolicy)).To(Succeed())
+		Expect(vaultAPI.UpdatePolicy(context.TODO(), newPolicy)).To(Succeed())
 		vaultEnv.Policy(newPolicy).Should(HaveName("managed.some-namespace.some-secret"))
 		vaultEnv.Policy(newPolicy).Should(HaveRules(
 			&policy.Rule{
@@ -95,11 +97,11 @@ var _ = Describe("PolicyAPI", func() {
 		))
 
 		By("Deleting it")
-		Expect(vaultAPI.DeletePolicy(newPolicy)).To(Succeed())
+		Expect(vaultAPI.DeletePolicy(context.TODO(), newPolicy)).To(Succeed())
 		vaultEnv.Policy(newPolicy).Should(BeNil())
 
 		By("Not throwing an error when trying to delete a non existent one")
-		Expect(vaultAPI.DeletePolicy(newPolicy)).To(Succeed())
+		Expect(vaultAPI.DeletePolicy(context.TODO(), newPolicy)).To(Succeed())
 		vaultEnv.Policy(newPolicy).Should(BeNil())
 	})
 })
@@ -1,6 +1,8 @@
 package e2e_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -8,13 +10,13 @@ import (
 var _ = Describe("Random API", func() {
 	When("A random string is required", func() {
 		It("Can generate a random string with a given length", func() {
-			result, err := vaultAPI.GenerateRandomString(32)
+			result, err := vaultAPI.GenerateRandomString(context.TODO(), 32)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result).To(HaveLen(32))
 		})
 
 		It("Errors if the given length is 0", func() {
-			result, err := vaultAPI.GenerateRandomString(0)
+			result, err := vaultAPI.GenerateRandomString(context.TODO(), 0)
 			Expect(err).To(HaveOccurred())
 			Expect(result).To(BeEmpty())
 		})
@@ -22,13 +24,13 @@ var _ = Describe("Random API", func() {
 
 	When("A random byte array is required", func() {
 		It("Can generate a byte array with a given length", func() {
-			result, err := vaultAPI.GenerateRandomBytes(32)
+			result, err := vaultAPI.GenerateRandomBytes(context.TODO(), 32)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result).To(HaveLen(32))
 		})
 
 		It("Errors if the given length is 0", func() {
-			result, err := vaultAPI.GenerateRandomBytes(0)
+			result, err := vaultAPI.GenerateRandomBytes(context.TODO(), 0)
 			Expect(err).To(HaveOccurred())
 			Expect(result).To(BeNil())
 		})
@@ -0,0 +1,71 @@
+package e2e_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/youniqx/heist/pkg/vault"
+	"github.com/youniqx/heist/pkg/vault/approleauth"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/policy"
+)
+
+var _ = Describe("Token Lifecycle", func() {
+	method := core.MountPath("managed/token-lifecycle")
+	tokenPolicy := &policy.Policy{
+		Name: "managed.token-lifecycle",
+		Rules: []*policy.Rule{
+			{
+				Path: "sys/auth",
+				Capabilities: []policy.Capability{
+					policy.ReadCapability,
+				},
+			},
+		},
+	}
+
+	AfterEach(func() {
+		Expect(vaultAPI.DeleteAuthMethod(context.TODO(), method)).To(Succeed())
+		Expect(vaultAPI.DeletePolicy(context.TODO(), tokenPolicy)).To(Succeed())
+	})
+
+	It("Should revoke the token of a login and log in again when it is used afterwards", func() {
+		By("Creating an approle to log in with")
+		Expect(vaultAPI.UpdatePolicy(context.TODO(), tokenPolicy)).To(Succeed())
+		Expect(vaultAPI.UpdateAppRoleAuthMethod(context.TODO(), method)).To(Succeed())
+
+		role := &approleauth.Role{
+			Name:     "token-lifecycle",
+			Policies: []core.PolicyName{"managed.token-lifecycle"},
+		}
+		Expect(vaultAPI.UpdateAppRoleAuthRole(context.TODO(), method, role)).To(Succeed())
+
+		roleID, err := vaultAPI.ReadAppRoleRoleID(context.TODO(), method, role)
+		Expect(err).NotTo(HaveOccurred())
+		secretID, err := vaultAPI.CreateAppRoleSecretID(context.TODO(), method, role)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Logging in with the approle")
+		api, err := vault.NewAPI().
+			WithAddressFrom(core.Value(vaultAPI.GetAddress())).
+			WithAuthProvider(approleauth.AuthProvider(method, core.Value(roleID), core.Value(secretID))).
+			Complete()
+		Expect(err).NotTo(HaveOccurred())
+
+		exists, err := api.HasAuthMethod(context.TODO(), method)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		By("Revoking the token")
+		Expect(api.RevokeToken(context.TODO())).To(Succeed())
+
+		By("Not failing when there is no token left to revoke")
+		Expect(api.RevokeToken(context.TODO())).To(Succeed())
+
+		By("Logging in again on the next request")
+		exists, err = api.HasAuthMethod(context.TODO(), method)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeTrue())
+	})
+})
@@ -1,6 +1,8 @@
 package e2e_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/youniqx/heist/pkg/vault/core"
@@ -20,11 +22,11 @@ var _ = Describe("Transit API", func() {
 		}
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		It("Should create a new transit engine with the desired config", func() {
-			Expect(vaultAPI.UpdateTransitEngine(engine)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitEngine(context.TODO(), engine)).To(Succeed())
 			vaultEnv.TransitEngine(engine).Should(HavePath("some/path"))
 			vaultEnv.TransitEngine(engine).Should(HaveConfig(engine.Config))
 		})
@@ -41,11 +43,11 @@ var _ = Describe("Transit API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateTransitEngine(engine)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		It("Should have created the engine with the desired config", func() {
@@ -54,19 +56,19 @@ var _ = Describe("Transit API", func() {
 		})
 
 		It("Should be able to read from an existing engine", func() {
-			info, err := vaultAPI.ReadTransitEngine(engine)
+			info, err := vaultAPI.ReadTransitEngine(context.TODO(), engine)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).To(Equal(engine))
 		})
 
 		It("Should throw an error when trying to read from a non-existing engine", func() {
-			info, err := vaultAPI.ReadTransitEngine(core.MountPath("does/not/exist"))
+			info, err := vaultAPI.ReadTransitEngine(context.TODO(), core.MountPath("does/not/exist"))
 			Expect(err).To(MatchError(core.ErrDoesNotExist))
 			Expect(info).To(BeNil())
 		})
 
 		It("Should return an empty list when trying to list keys", func() {
-			keys, err := vaultAPI.ListKeys(engine)
+			keys, err := vaultAPI.ListKeys(context.TODO(), engine)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(keys).To(BeEmpty())
 		})
@@ -95,11 +97,11 @@ var _ = Describe("Transit API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateTransitEngine(engine)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		It("Should have created the engine with the desired config", func() {
@@ -108,14 +110,14 @@ var _ = Describe("Transit API", func() {
 		})
 
 		It("Should be able to create a new encryption key", func() {
-			Expect(vaultAPI.UpdateTransitKey(engine, key)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitKey(context.TODO(), engine, key)).To(Succeed())
 			vaultEnv.TransitKey(engine, key).Should(HaveName(key.Name))
 			vaultEnv.TransitKey(engine, key).Should(HaveKeyType(key.Type))
 			vaultEnv.TransitKey(engine, key).Should(HaveConfig(key.Config))
 		})
 
 		It("Should be able to create a new encryption key", func() {
-			Expect(vaultAPI.UpdateTransitKey(engine, key)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitKey(context.TODO(), engine, key)).To(Succeed())
 			vaultEnv.TransitKey(engine, key).Should(HaveName(key.Name))
 			vaultEnv.TransitKey(engine, key).Should(HaveKeyType(key.Type))
 			vaultEnv.TransitKey(engine, key).Should(HaveConfig(key.Config))
@@ -145,12 +147,12 @@ var _ = Describe("Transit API", func() {
 		}
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateTransitEngine(engine)).To(Succeed())
-			Expect(vaultAPI.UpdateTransitKey(engine, key)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitEngine(context.TODO(), engine)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitKey(context.TODO(), engine, key)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		It("Should have created the engine and key with the desired config", func() {
@@ -162,7 +164,7 @@ var _ = Describe("Transit API", func() {
 		})
 
 		It("Should be able to list the encryption key", func() {
-			keys, err := vaultAPI.ListKeys(engine)
+			keys, err := vaultAPI.ListKeys(context.TODO(), engine)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(keys).To(Equal([]transit.KeyName{
 				transit.KeyName(key.Name),
@@ -170,19 +172,19 @@ var _ = Describe("Transit API", func() {
 		})
 
 		It("Should be able to read the existing key", func() {
-			info, err := vaultAPI.ReadTransitKey(engine, key)
+			info, err := vaultAPI.ReadTransitKey(context.TODO(), engine, key)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(info).To(Equal(key))
 		})
 
 		It("Should throw an error when trying to read a non-existing key", func() {
-			info, err := vaultAPI.ReadTransitKey(engine, transit.KeyName("does-not-exist"))
+			info, err := vaultAPI.ReadTransitKey(context.TODO(), engine, transit.KeyName("does-not-exist"))
 			Expect(err).To(MatchError(core.ErrDoesNotExist))
 			Expect(info).To(BeNil())
 		})
 
 		It("Should be able to rotate the encryption key", func() {
-			Expect(vaultAPI.RotateTransitKey(engine, key)).To(Succeed())
+			Expect(vaultAPI.RotateTransitKey(context.TODO(), engine, key)).To(Succeed())
 			vaultEnv.TransitKey(engine, key).Should(HaveName(key.Name))
 			vaultEnv.TransitKey(engine, key).Should(HaveKeyType(key.Type))
 			vaultEnv.TransitKey(engine, key).Should(HaveConfig(key.Config))
@@ -200,14 +202,14 @@ var _ = Describe("Transit API", func() {
 					AllowPlaintextBackup:     false,
 				},
 			}
-			Expect(vaultAPI.UpdateTransitKey(engine, keyWithDifferentConfig)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitKey(context.TODO(), engine, keyWithDifferentConfig)).To(Succeed())
 			vaultEnv.TransitKey(engine, key).Should(HaveName(key.Name))
 			vaultEnv.TransitKey(engine, key).Should(HaveKeyType(key.Type))
 			vaultEnv.TransitKey(engine, key).Should(HaveConfig(keyWithDifferentConfig.Config))
 		})
 
 		It("Should throw an error when trying to delete a key which has DeletionAllowed set to false", func() {
-			Expect(vaultAPI.DeleteTransitKey(engine, key)).NotTo(Succeed())
+			Expect(vaultAPI.DeleteTransitKey(context.TODO(), engine, key)).NotTo(Succeed())
 			vaultEnv.TransitKey(engine, key).Should(HaveName(key.Name))
 			vaultEnv.TransitKey(engine, key).Should(HaveKeyType(key.Type))
 			vaultEnv.TransitKey(engine, key).Should(HaveConfig(key.Config))
@@ -225,11 +227,11 @@ var _ = Describe("Transit API", func() {
 					AllowPlaintextBackup:     false,
 				},
 			}
-			Expect(vaultAPI.UpdateTransitKey(engine, keyWithDeletionAllowed)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitKey(context.TODO(), engine, keyWithDeletionAllowed)).To(Succeed())
 			vaultEnv.TransitKey(engine, key).Should(HaveName(key.Name))
 			vaultEnv.TransitKey(engine, key).Should(HaveKeyType(key.Type))
 			vaultEnv.TransitKey(engine, key).Should(HaveConfig(keyWithDeletionAllowed.Config))
-			Expect(vaultAPI.DeleteTransitKey(engine, key)).To(Succeed())
+			Expect(vaultAPI.DeleteTransitKey(context.TODO(), engine, key)).To(Succeed())
 			vaultEnv.TransitKey(engine, key).Should(BeNil())
 		})
 	})
@@ -259,31 +261,31 @@ var _ = Describe("Transit API", func() {
 		inputPlainText := []byte("ASDF ASDF")
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateTransitEngine(engine)).To(Succeed())
-			Expect(vaultAPI.UpdateTransitKey(engine, key)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitEngine(context.TODO(), engine)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitKey(context.TODO(), engine, key)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		It("Should be able to encrypt and decrypt a value using the encryption key", func() {
-			cipherText, err := vaultAPI.TransitEncrypt(engine, key, inputPlainText)
+			cipherText, err := vaultAPI.TransitEncrypt(context.TODO(), engine, key, inputPlainText)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cipherText).NotTo(BeEmpty())
-			plainText, err := vaultAPI.TransitDecrypt(engine, key, cipherText)
+			plainText, err := vaultAPI.TransitDecrypt(context.TODO(), engine, key, cipherText)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(plainText).To(Equal(inputPlainText))
 		})
 
 		It("Should throw an error when trying to sign any input", func() {
-			signature, err := vaultAPI.TransitSign(engine, key, inputPlainText)
+			signature, err := vaultAPI.TransitSign(context.TODO(), engine, key, inputPlainText)
 			Expect(err).To(HaveOccurred())
 			Expect(signature).To(BeEmpty())
 		})
 
 		It("Should throw an error when trying to validate any signature", func() {
-			valid, err := vaultAPI.TransitVerify(engine, key, inputPlainText, "vault:v1:aaaaaaaaa")
+			valid, err := vaultAPI.TransitVerify(context.TODO(), engine, key, inputPlainText, "vault:v1:aaaaaaaaa")
 			Expect(err).To(HaveOccurred())
 			Expect(valid).To(BeFalse())
 		})
@@ -314,30 +316,52 @@ var _ = Describe("Transit API", func() {
 		inputPlainText := []byte("ASDF ASDF")
 
 		BeforeEach(func() {
-			Expect(vaultAPI.UpdateTransitEngine(engine)).To(Succeed())
-			Expect(vaultAPI.UpdateTransitKey(engine, key)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitEngine(context.TODO(), engine)).To(Succeed())
+			Expect(vaultAPI.UpdateTransitKey(context.TODO(), engine, key)).To(Succeed())
 		})
 
 		AfterEach(func() {
-			Expect(vaultAPI.DeleteEngine(engine)).To(Succeed())
+			Expect(vaultAPI.DeleteEngine(context.TODO(), engine)).To(Succeed())
 		})
 
 		It("Should be able to encrypt and decrypt a value using the encryption key", func() {
-			cipherText, err := vaultAPI.TransitEncrypt(engine, key, inputPlainText)
+			cipherText, err := vaultAPI.TransitEncrypt(context.TODO(), engine, key, inputPlainText)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cipherText).NotTo(BeEmpty())
-			plainText, err := vaultAPI.TransitDecrypt(engine, key, cipherText)
+			plainText, err := vaultAPI.TransitDecrypt(context.TODO(), engine, key, cipherText)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(plainText).To(Equal(inputPlainText))
 		})
 
 		It("Should be able to sign any input and verify those signatures", func() {
-			signature, err := vaultAPI.TransitSign(engine, key, inputPlainText)
+			signature, err := vaultAPI.TransitSign(context.TODO(), engine, key, inputPlainText)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(signature).NotTo(BeEmpty())
-			valid, err := vaultAPI.TransitVerify(engine, key, inputPlainText, signature)
+			valid, err := vaultAPI.TransitVerify(context.TODO(), engine, key, inputPlainText, signature)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(valid).To(BeTrue())
 		})
+
+		It("Should be able to generate a hmac of any input", func() {
+			hmac, err := vaultAPI.TransitHMAC(context.TODO(), engine, key, inputPlainText)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hmac).To(HavePrefix("vault:v1:"))
+		})
+
+		It("Should be able to rewrap a cipher text with the latest key version", func() {
+			cipherText, err := vaultAPI.TransitEncrypt(context.TODO(), engine, key, inputPlainText)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cipherText).To(HavePrefix("vault:v1:"))
+
+			Expect(vaultAPI.RotateTransitKey(context.TODO(), engine, key)).To(Succeed())
+
+			rewrapped, err := vaultAPI.TransitRewrap(context.TODO(), engine, key, cipherText)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rewrapped).To(HavePrefix("vault:v2:"))
+
+			plainText, err := vaultAPI.TransitDecrypt(context.TODO(), engine, key, rewrapped)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plainText).To(Equal(inputPlainText))
+		})
 	})
 })
@@ -0,0 +1,176 @@
+// Package fake provides an in-memory implementation of vault.API for unit tests.
+//
+// The fake keeps all state in memory and mimics the behaviour of the Vault
+// HTTP API closely enough for controllers and the agent to be tested without a
+// running Vault server: KV secrets are versioned, transit keys perform real
+// cryptographic operations and PKI engines issue real x509 certificates.
+// Errors can be injected per method to test failure handling.
+package fake
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/youniqx/heist/pkg/vault"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/passwordpolicy"
+)
+
+var _ vault.API = &API{}
+
+// DefaultAddress is the address returned by GetAddress if no address has been configured.
+const DefaultAddress = "http://127.0.0.1:8200"
+
+// API is an in-memory implementation of vault.API. It is safe for concurrent use.
+type API struct {
+	lock sync.Mutex
+
+	address   string
+	addresses []string
+	namespace string
+	caCerts   []string
+	clock     func() time.Time
+
+	calls  []string
+	faults map[string]*fault
+
+	mounts           map[string]*mountState
+	policies         map[string]*policyState
+	passwordPolicies map[string]*passwordpolicy.PasswordPolicy
+	authMethods      map[string]*authMethodState
+	tokens           map[string]*tokenState
+	tokenRevocation  int
+}
+
+// Option configures the fake API.
+type Option func(api *API)
+
+// WithAddress sets the addresses returned by GetAddress and GetAddresses.
+func WithAddress(addresses ...string) Option {
+	return func(api *API) {
+		if len(addresses) > 0 {
+			api.address = addresses[0]
+			api.addresses = addresses
+		}
+	}
+}
+
+// WithNamespace sets the namespace returned by GetNamespace.
+func WithNamespace(namespace string) Option {
+	return func(api *API) {
+		api.namespace = namespace
+	}
+}
+
+// WithCACerts sets the CA certificates returned by GetCACerts.
+func WithCACerts(caCerts ...string) Option {
+	return func(api *API) {
+		api.caCerts = caCerts
+	}
+}
+
+// WithClock replaces the clock used for timestamps, certificate validity and tidy operations.
+func WithClock(clock func() time.Time) Option {
+	return func(api *API) {
+		api.clock = clock
+	}
+}
+
+// New creates a new, empty fake Vault API.
+func New(options ...Option) *API {
+	api := &API{
+		address:          DefaultAddress,
+		addresses:        []string{DefaultAddress},
+		clock:            time.Now,
+		faults:           make(map[string]*fault),
+		mounts:           make(map[string]*mountState),
+		policies:         make(map[string]*policyState),
+		passwordPolicies: make(map[string]*passwordpolicy.PasswordPolicy),
+		authMethods:      make(map[string]*authMethodState),
+		tokens:           make(map[string]*tokenState),
+	}
+
+	for _, option := range options {
+		option(api)
+	}
+
+	return api
+}
+
+func (f *API) GetAddress() string {
+	return f.address
+}
+
+func (f *API) GetAddresses() []string {
+	return append([]string(nil), f.addresses...)
+}
+
+func (f *API) GetNamespace() string {
+	return f.namespace
+}
+
+func (f *API) GetCACerts() []string {
+	return append([]string(nil), f.caCerts...)
+}
+
+func (f *API) RevokeToken(ctx context.Context) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "RevokeToken"); err != nil {
+		return err
+	}
+
+	f.tokenRevocation++
+
+	return nil
+}
+
+func (f *API) now() time.Time {
+	return f.clock()
+}
+
+func normalizePath(path string) string {
+	return strings.Trim(path, "/")
+}
+
+func getMountPath(entity core.MountPathEntity) (string, error) {
+	path, err := entity.GetMountPath()
+	if err != nil {
+		return "", core.ErrAPIError.WithDetails("failed to get mount path").WithCause(err)
+	}
+
+	return normalizePath(path), nil
+}
+
+func getRoleName(entity core.RoleNameEntity) (string, error) {
+	name, err := entity.GetRoleName()
+	if err != nil {
+		return "", core.ErrAPIError.WithDetails("failed to get role name").WithCause(err)
+	}
+
+	return name, nil
+}
+
+func copySlice[T any](values []T) []T {
+	if values == nil {
+		return nil
+	}
+
+	return append([]T{}, values...)
+}
+
+func copyStringMap(values map[string]string) map[string]string {
+	if values == nil {
+		return nil
+	}
+
+	result := make(map[string]string, len(values))
+	for key, value := range values {
+		result[key] = value
+	}
+
+	return result
+}
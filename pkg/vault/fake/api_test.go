@@ -0,0 +1,380 @@
+package fake
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+	"github.com/youniqx/heist/pkg/vault/kvsecret"
+	"github.com/youniqx/heist/pkg/vault/passwordpolicy"
+	"github.com/youniqx/heist/pkg/vault/pki"
+	"github.com/youniqx/heist/pkg/vault/policy"
+	"github.com/youniqx/heist/pkg/vault/transit"
+)
+
+func TestAPI_KvSecret(t *testing.T) {
+	ctx := context.Background()
+	api := New()
+	engine := &kvengine.KvEngine{Path: "kv", Version: kvengine.VersionV2}
+	secret := &kvsecret.KvSecret{Path: "app/config", Fields: map[string]string{"password": "first"}}
+
+	if err := api.UpdateKvEngine(ctx, engine); err != nil {
+		t.Fatalf("UpdateKvEngine() error = %v", err)
+	}
+
+	if _, err := api.ReadKvSecret(ctx, engine, secret); !errors.Is(err, core.ErrDoesNotExist) {
+		t.Fatalf("ReadKvSecret() error = %v, want %v", err, core.ErrDoesNotExist)
+	}
+
+	if version, err := api.UpdateKvSecretCAS(ctx, engine, secret, 0); err != nil || version != 1 {
+		t.Fatalf("UpdateKvSecretCAS() = %v, %v, want 1, nil", version, err)
+	}
+
+	secret.Fields = map[string]string{"password": "second"}
+	if _, err := api.UpdateKvSecretCAS(ctx, engine, secret, 0); !errors.Is(err, kvsecret.ErrCASConflict) {
+		t.Fatalf("UpdateKvSecretCAS() error = %v, want %v", err, kvsecret.ErrCASConflict)
+	}
+
+	if version, err := api.UpdateKvSecretCAS(ctx, engine, secret, 1); err != nil || version != 2 {
+		t.Fatalf("UpdateKvSecretCAS() = %v, %v, want 2, nil", version, err)
+	}
+
+	if err := api.DeleteKvSecretVersions(ctx, engine, secret, []int{2}); err != nil {
+		t.Fatalf("DeleteKvSecretVersions() error = %v", err)
+	}
+
+	if _, err := api.ReadKvSecret(ctx, engine, secret); !errors.Is(err, core.ErrDoesNotExist) {
+		t.Fatalf("ReadKvSecret() error = %v, want %v", err, core.ErrDoesNotExist)
+	}
+
+	previous, err := api.ReadKvSecretVersion(ctx, engine, secret, 1)
+	if err != nil || previous.Fields["password"] != "first" {
+		t.Fatalf("ReadKvSecretVersion() = %v, %v, want first version", previous, err)
+	}
+
+	metadata, err := api.ListKvSecretVersions(ctx, engine, secret)
+	if err != nil {
+		t.Fatalf("ListKvSecretVersions() error = %v", err)
+	}
+
+	if metadata.CurrentVersion != 2 || metadata.OldestVersion != 1 || metadata.GetVersion(2).IsAvailable() {
+		t.Errorf("ListKvSecretVersions() = %+v, want version 2 to be deleted", metadata)
+	}
+
+	if fields, ok := api.KvSecret("/kv/", "app/config"); ok {
+		t.Errorf("KvSecret() = %v, want deleted secret to be unavailable", fields)
+	}
+}
+
+func TestAPI_KvSecretV1(t *testing.T) {
+	ctx := context.Background()
+	api := New()
+	engine := &kvengine.KvEngine{Path: "kv-v1", Version: kvengine.VersionV1}
+	secret := &kvsecret.KvSecret{Path: "app", Fields: map[string]string{"key": "value"}}
+
+	if err := api.UpdateKvEngine(ctx, engine); err != nil {
+		t.Fatalf("UpdateKvEngine() error = %v", err)
+	}
+
+	if err := api.UpdateKvSecret(ctx, engine, secret); err != nil {
+		t.Fatalf("UpdateKvSecret() error = %v", err)
+	}
+
+	if fields, ok := api.KvSecret("kv-v1", "app"); !ok || !reflect.DeepEqual(fields, secret.Fields) {
+		t.Errorf("KvSecret() = %v, %v, want %v", fields, ok, secret.Fields)
+	}
+
+	if _, err := api.ListKvSecretVersions(ctx, engine, secret); !errors.Is(err, kvsecret.ErrNotVersioned) {
+		t.Errorf("ListKvSecretVersions() error = %v, want %v", err, kvsecret.ErrNotVersioned)
+	}
+
+	if err := api.UpdateKvEngine(ctx, &kvengine.KvEngine{Path: "kv-v1", Version: kvengine.VersionV2}); err == nil {
+		t.Errorf("UpdateKvEngine() expected error when changing the engine version")
+	}
+}
+
+func TestAPI_Transit(t *testing.T) {
+	tests := []struct {
+		name     string
+		keyType  transit.KeyType
+		encrypts bool
+		signs    bool
+	}{
+		{name: "aes128-gcm96", keyType: transit.TypeAes128Gcm96, encrypts: true},
+		{name: "aes256-gcm96", keyType: transit.TypeAes256Gcm96, encrypts: true},
+		{name: "chacha20-poly1305", keyType: transit.TypeChacha20Poly1305, encrypts: true},
+		{name: "ed25519", keyType: transit.TypeED25519, signs: true},
+		{name: "ecdsa-p256", keyType: transit.TypeEcdsaP256, signs: true},
+		{name: "rsa-2048", keyType: transit.TypeRSA2048, encrypts: true, signs: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			api := New()
+			engine := &transit.Engine{Path: "transit"}
+			key := &transit.Key{Name: "key", Type: tt.keyType}
+			input := []byte("some data")
+
+			if err := api.UpdateTransitEngine(ctx, engine); err != nil {
+				t.Fatalf("UpdateTransitEngine() error = %v", err)
+			}
+
+			if err := api.UpdateTransitKey(ctx, engine, key); err != nil {
+				t.Fatalf("UpdateTransitKey() error = %v", err)
+			}
+
+			cipherText, err := api.TransitEncrypt(ctx, engine, key, input)
+			if (err != nil) == tt.encrypts {
+				t.Fatalf("TransitEncrypt() error = %v, want error %v", err, !tt.encrypts)
+			}
+
+			signature, err := api.TransitSign(ctx, engine, key, input)
+			if (err != nil) == tt.signs {
+				t.Fatalf("TransitSign() error = %v, want error %v", err, !tt.signs)
+			}
+
+			if err := api.RotateTransitKey(ctx, engine, key); err != nil {
+				t.Fatalf("RotateTransitKey() error = %v", err)
+			}
+
+			if tt.encrypts {
+				rewrapped, err := api.TransitRewrap(ctx, engine, key, cipherText)
+				if err != nil {
+					t.Fatalf("TransitRewrap() error = %v", err)
+				}
+
+				for _, value := range []string{cipherText, rewrapped} {
+					plainText, err := api.TransitDecrypt(ctx, engine, key, value)
+					if err != nil || string(plainText) != string(input) {
+						t.Errorf("TransitDecrypt(%s) = %s, %v, want %s", value, plainText, err, input)
+					}
+				}
+			}
+
+			if tt.signs {
+				if valid, err := api.TransitVerify(ctx, engine, key, input, signature); err != nil || !valid {
+					t.Errorf("TransitVerify() = %v, %v, want true", valid, err)
+				}
+
+				if valid, err := api.TransitVerify(ctx, engine, key, []byte("other data"), signature); err != nil || valid {
+					t.Errorf("TransitVerify() = %v, %v, want false", valid, err)
+				}
+			}
+
+			first, err := api.TransitHMAC(ctx, engine, key, input)
+			if err != nil {
+				t.Fatalf("TransitHMAC() error = %v", err)
+			}
+
+			second, err := api.TransitHMAC(ctx, engine, key, input)
+			if err != nil || first != second {
+				t.Errorf("TransitHMAC() = %s, %v, want %s", second, err, first)
+			}
+
+			if versions, _ := api.TransitKeyVersions("transit", "key"); versions != 2 {
+				t.Errorf("TransitKeyVersions() = %d, want 2", versions)
+			}
+
+			if err := api.DeleteTransitKey(ctx, engine, key); err == nil {
+				t.Errorf("DeleteTransitKey() expected error if deletion is not allowed")
+			}
+		})
+	}
+}
+
+func TestAPI_PKI(t *testing.T) {
+	ctx := context.Background()
+	api := New()
+	root := &pki.CA{Path: "pki/root", Subject: &pki.Subject{CommonName: "Root CA"}}
+	intermediate := &pki.CA{
+		Path:     "pki/intermediate",
+		Subject:  &pki.Subject{CommonName: "Intermediate CA"},
+		Settings: &pki.CASettings{KeyType: pki.KeyTypeEC, TTL: core.NewTTL(core.Year)},
+	}
+	role := &pki.CertificateRole{
+		Name: "web",
+		Settings: &pki.RoleSettings{
+			AllowedDomains:  []string{"example.com"},
+			AllowSubdomains: true,
+			ServerFlag:      true,
+			KeyType:         pki.KeyTypeEC,
+		},
+	}
+
+	if _, err := api.CreateRootCA(ctx, pki.ModeInternal, root); err != nil {
+		t.Fatalf("CreateRootCA() error = %v", err)
+	}
+
+	info, err := api.CreateIntermediateCA(ctx, pki.ModeExported, root, intermediate)
+	if err != nil {
+		t.Fatalf("CreateIntermediateCA() error = %v", err)
+	}
+
+	if info.PrivateKey == "" || info.PrivateKeyType != pki.KeyTypeEC {
+		t.Errorf("CreateIntermediateCA() did not export the private key")
+	}
+
+	if err := api.UpdateCertificateRole(ctx, intermediate, role); err != nil {
+		t.Fatalf("UpdateCertificateRole() error = %v", err)
+	}
+
+	if _, err := api.IssueCertificate(ctx, intermediate, role, &pki.IssueCertOptions{CommonName: "example.org"}); err == nil {
+		t.Errorf("IssueCertificate() expected error for a name that is not allowed")
+	}
+
+	certificate, err := api.IssueCertificate(ctx, intermediate, role, &pki.IssueCertOptions{CommonName: "www.example.com"})
+	if err != nil {
+		t.Fatalf("IssueCertificate() error = %v", err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM([]byte(info.IssuingCertificateAuthority))
+
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM([]byte(certificate.IssuingCA))
+
+	block, _ := pem.Decode([]byte(certificate.Certificate))
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("failed to parse issued certificate: %v", err)
+	}
+
+	if _, err := leaf.Verify(x509.VerifyOptions{DNSName: "www.example.com", Roots: roots, Intermediates: intermediates}); err != nil {
+		t.Errorf("issued certificate could not be verified: %v", err)
+	}
+
+	if err := api.RevokeCertificate(ctx, intermediate, certificate); err != nil {
+		t.Fatalf("RevokeCertificate() error = %v", err)
+	}
+
+	if !api.IsRevoked("pki/intermediate", certificate.SerialNumber) {
+		t.Errorf("IsRevoked() = false, want true")
+	}
+}
+
+func TestAPI_KubernetesAuth(t *testing.T) {
+	ctx := context.Background()
+	api := New()
+	method := &kubernetesauth.Method{Path: "kubernetes"}
+	role := &kubernetesauth.Role{
+		Name:                 "app",
+		Policies:             []core.PolicyName{"app"},
+		BoundNamespaces:      []string{"default"},
+		BoundServiceAccounts: []string{"app"},
+	}
+
+	if err := api.UpdateKubernetesAuthMethod(ctx, method); err != nil {
+		t.Fatalf("UpdateKubernetesAuthMethod() error = %v", err)
+	}
+
+	if err := api.UpdateKubernetesAuthRole(ctx, method, role); err != nil {
+		t.Fatalf("UpdateKubernetesAuthRole() error = %v", err)
+	}
+
+	if current, err := api.ReadKubernetesAuthRole(ctx, method, role); err != nil || !reflect.DeepEqual(current, role) {
+		t.Errorf("ReadKubernetesAuthRole() = %v, %v, want %v", current, err, role)
+	}
+
+	response, err := api.LoginWithKubernetesAuth(ctx, method, role, "token")
+	if err != nil {
+		t.Fatalf("LoginWithKubernetesAuth() error = %v", err)
+	}
+
+	if policies, _ := api.TokenPolicies(response.Auth.ClientToken); !reflect.DeepEqual(policies, []string{"default", "app"}) {
+		t.Errorf("TokenPolicies() = %v, want [default app]", policies)
+	}
+}
+
+func TestAPI_PasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	api := New()
+	entity := &passwordpolicy.PasswordPolicy{
+		Name:   "digits",
+		Length: 12,
+		Rules: []*passwordpolicy.CharsetRule{
+			{Charset: "abc"},
+			{Charset: "0123456789", MinChars: 4},
+		},
+	}
+
+	if _, err := api.GeneratePassword(ctx, entity); err == nil {
+		t.Fatalf("GeneratePassword() error = nil, want error for missing policy")
+	}
+
+	if err := api.UpdatePasswordPolicy(ctx, entity); err != nil {
+		t.Fatalf("UpdatePasswordPolicy() error = %v", err)
+	}
+
+	password, err := api.GeneratePassword(ctx, entity)
+	if err != nil {
+		t.Fatalf("GeneratePassword() error = %v", err)
+	}
+
+	if len(password) != 12 || strings.Trim(password, "abc0123456789") != "" {
+		t.Errorf("GeneratePassword() = %s, want 12 characters of the configured charsets", password)
+	}
+
+	digits := 0
+	for _, char := range password {
+		if strings.ContainsRune("0123456789", char) {
+			digits++
+		}
+	}
+
+	if digits < 4 {
+		t.Errorf("GeneratePassword() = %s, want at least 4 digits", password)
+	}
+
+	entity.Rules[1].MinChars = 13
+	if err := api.UpdatePasswordPolicy(ctx, entity); err == nil {
+		t.Errorf("UpdatePasswordPolicy() error = nil, want error for unsatisfiable policy")
+	}
+
+	if err := api.DeletePasswordPolicy(ctx, entity); err != nil {
+		t.Fatalf("DeletePasswordPolicy() error = %v", err)
+	}
+
+	if _, err := api.ReadPasswordPolicy(ctx, entity); !errors.Is(err, core.ErrDoesNotExist) {
+		t.Errorf("ReadPasswordPolicy() error = %v, want %v", err, core.ErrDoesNotExist)
+	}
+}
+
+func TestAPI_InjectError(t *testing.T) {
+	ctx := context.Background()
+	api := New()
+	injected := errors.New("injected")
+	entity := &policy.Policy{Name: "policy", Rules: []*policy.Rule{{Path: "kv/*", Capabilities: []policy.Capability{policy.ReadCapability}}}}
+
+	api.InjectErrorOnce("UpdatePolicy", injected)
+
+	if err := api.UpdatePolicy(ctx, entity); !errors.Is(err, injected) {
+		t.Fatalf("UpdatePolicy() error = %v, want %v", err, injected)
+	}
+
+	if err := api.UpdatePolicy(ctx, entity); err != nil {
+		t.Fatalf("UpdatePolicy() error = %v", err)
+	}
+
+	api.InjectError(AnyMethod, injected)
+
+	if _, err := api.ReadPolicy(ctx, entity); !errors.Is(err, injected) {
+		t.Fatalf("ReadPolicy() error = %v, want %v", err, injected)
+	}
+
+	api.ClearErrors()
+
+	if current, err := api.ReadPolicy(ctx, entity); err != nil || !reflect.DeepEqual(current, entity) {
+		t.Errorf("ReadPolicy() = %v, %v, want %v", current, err, entity)
+	}
+
+	if count := api.CallCount("UpdatePolicy"); count != 2 {
+		t.Errorf("CallCount() = %d, want 2", count)
+	}
+}
@@ -0,0 +1,178 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/vault/approleauth"
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type appRoleState struct {
+	Name      string
+	Policies  []core.PolicyName
+	RoleID    string
+	SecretIDs map[string]bool
+}
+
+func (f *API) UpdateAppRoleAuthMethod(ctx context.Context, method core.MountPathEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateAppRoleAuthMethod"); err != nil {
+		return err
+	}
+
+	_, err := f.ensureAuthMethod(method, auth.MethodAppRole)
+
+	return err
+}
+
+func (f *API) UpdateAppRoleAuthRole(ctx context.Context, method core.MountPathEntity, role approleauth.RoleEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateAppRoleAuthRole"); err != nil {
+		return err
+	}
+
+	state, err := f.getAuthMethod(method, auth.MethodAppRole)
+	if err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	policies, err := role.GetRolePolicies()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get approle policies").WithCause(err)
+	}
+
+	current, exists := state.AppRoles[roleName]
+	if !exists {
+		roleID, err := randomID("")
+		if err != nil {
+			return err
+		}
+
+		current = &appRoleState{
+			Name:      roleName,
+			RoleID:    roleID,
+			SecretIDs: make(map[string]bool),
+		}
+		state.AppRoles[roleName] = current
+	}
+
+	current.Policies = copySlice(policies)
+
+	return nil
+}
+
+func (f *API) DeleteAppRoleAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteAppRoleAuthRole"); err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	if state, err := f.getAuthMethod(method, auth.MethodAppRole); err == nil {
+		delete(state.AppRoles, roleName)
+	}
+
+	return nil
+}
+
+func (f *API) ReadAppRoleRoleID(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadAppRoleRoleID"); err != nil {
+		return "", err
+	}
+
+	current, err := f.getAppRole(method, role)
+	if err != nil {
+		return "", err
+	}
+
+	return current.RoleID, nil
+}
+
+func (f *API) CreateAppRoleSecretID(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "CreateAppRoleSecretID"); err != nil {
+		return "", err
+	}
+
+	current, err := f.getAppRole(method, role)
+	if err != nil {
+		return "", err
+	}
+
+	secretID, err := randomID("")
+	if err != nil {
+		return "", err
+	}
+
+	current.SecretIDs[secretID] = true
+
+	return secretID, nil
+}
+
+func (f *API) LoginWithAppRoleAuth(ctx context.Context, method core.MountPathEntity, roleID string, secretID string) (*core.AuthResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "LoginWithAppRoleAuth"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getAuthMethod(method, auth.MethodAppRole)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := getMountPath(method)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, current := range state.AppRoles {
+		if current.RoleID == roleID && current.SecretIDs[secretID] {
+			return f.issueToken(path, current.Name, current.Policies)
+		}
+	}
+
+	return nil, core.ErrAPIError.WithDetails("permission denied: invalid role or secret ID")
+}
+
+func (f *API) getAppRole(method core.MountPathEntity, role core.RoleNameEntity) (*appRoleState, error) {
+	state, err := f.getAuthMethod(method, auth.MethodAppRole)
+	if err != nil {
+		return nil, err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := state.AppRoles[roleName]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("approle %s does not exist", roleName))
+	}
+
+	return current, nil
+}
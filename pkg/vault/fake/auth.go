@@ -0,0 +1,196 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/certauth"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/jwtauth"
+	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
+)
+
+type authMethodState struct {
+	Type auth.Type
+
+	KubernetesConfig *kubernetesauth.Config
+	KubernetesRoles  map[string]*kubernetesauth.Role
+	AppRoles         map[string]*appRoleState
+	JWTConfig        *jwtauth.Config
+	JWTRoles         map[string]*jwtauth.Role
+	CertRoles        map[string]*certauth.Role
+}
+
+func (f *API) HasAuthMethod(ctx context.Context, method core.MountPathEntity) (bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "HasAuthMethod"); err != nil {
+		return false, err
+	}
+
+	path, err := getMountPath(method)
+	if err != nil {
+		return false, err
+	}
+
+	_, exists := f.authMethods[path]
+
+	return exists, nil
+}
+
+func (f *API) ListAuthMethods(ctx context.Context) ([]*auth.Method, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ListAuthMethods"); err != nil {
+		return nil, err
+	}
+
+	result := make([]*auth.Method, 0, len(f.authMethods))
+	for path, state := range f.authMethods {
+		result = append(result, &auth.Method{Path: path, Type: state.Type})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result, nil
+}
+
+func (f *API) DeleteAuthMethod(ctx context.Context, method core.MountPathEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteAuthMethod"); err != nil {
+		return err
+	}
+
+	path, err := getMountPath(method)
+	if err != nil {
+		return err
+	}
+
+	delete(f.authMethods, path)
+
+	return nil
+}
+
+func (f *API) CreateAuthMethod(ctx context.Context, method auth.MethodEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "CreateAuthMethod"); err != nil {
+		return err
+	}
+
+	path, err := getMountPath(method)
+	if err != nil {
+		return err
+	}
+
+	if _, exists := f.authMethods[path]; exists {
+		return core.ErrAPIError.WithDetails(fmt.Sprintf("path is already in use at %s/", path))
+	}
+
+	methodType, err := method.GetMethod()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get auth method type").WithCause(err)
+	}
+
+	f.authMethods[path] = newAuthMethodState(methodType)
+
+	return nil
+}
+
+func (f *API) ReadAuthMethod(ctx context.Context, method core.MountPathEntity) (*auth.Method, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadAuthMethod"); err != nil {
+		return nil, err
+	}
+
+	path, err := getMountPath(method)
+	if err != nil {
+		return nil, err
+	}
+
+	state, ok := f.authMethods[path]
+	if !ok {
+		return nil, core.ErrDoesNotExist
+	}
+
+	return &auth.Method{Path: path, Type: state.Type}, nil
+}
+
+func (f *API) EnsureAuthMethod(ctx context.Context, method auth.MethodEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "EnsureAuthMethod"); err != nil {
+		return err
+	}
+
+	methodType, err := method.GetMethod()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get auth method type").WithCause(err)
+	}
+
+	_, err = f.ensureAuthMethod(method, methodType)
+
+	return err
+}
+
+// ensureAuthMethod returns the auth method mounted at the path of the entity
+// and enables it first if it doesn't exist yet.
+func (f *API) ensureAuthMethod(method core.MountPathEntity, methodType auth.Type) (*authMethodState, error) {
+	path, err := getMountPath(method)
+	if err != nil {
+		return nil, err
+	}
+
+	state, exists := f.authMethods[path]
+	if !exists {
+		state = newAuthMethodState(methodType)
+		f.authMethods[path] = state
+	}
+
+	if state.Type != methodType {
+		return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("auth method at %s is of type %s, expected %s", path, state.Type, methodType))
+	}
+
+	return state, nil
+}
+
+// getAuthMethod returns the auth method mounted at the path of the entity if it is of the given type.
+func (f *API) getAuthMethod(method core.MountPathEntity, methodType auth.Type) (*authMethodState, error) {
+	path, err := getMountPath(method)
+	if err != nil {
+		return nil, err
+	}
+
+	state, ok := f.authMethods[path]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("no auth method enabled at %s", path))
+	}
+
+	if state.Type != methodType {
+		return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("auth method at %s is of type %s, expected %s", path, state.Type, methodType))
+	}
+
+	return state, nil
+}
+
+func newAuthMethodState(methodType auth.Type) *authMethodState {
+	return &authMethodState{
+		Type:            methodType,
+		KubernetesRoles: make(map[string]*kubernetesauth.Role),
+		AppRoles:        make(map[string]*appRoleState),
+		JWTRoles:        make(map[string]*jwtauth.Role),
+		CertRoles:       make(map[string]*certauth.Role),
+	}
+}
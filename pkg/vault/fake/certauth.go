@@ -0,0 +1,117 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/certauth"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (f *API) UpdateCertAuthMethod(ctx context.Context, method core.MountPathEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateCertAuthMethod"); err != nil {
+		return err
+	}
+
+	_, err := f.ensureAuthMethod(method, auth.MethodCert)
+
+	return err
+}
+
+func (f *API) UpdateCertAuthRole(ctx context.Context, method core.MountPathEntity, role certauth.RoleEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateCertAuthRole"); err != nil {
+		return err
+	}
+
+	state, err := f.getAuthMethod(method, auth.MethodCert)
+	if err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	policies, err := role.GetRolePolicies()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get cert role policies").WithCause(err)
+	}
+
+	certificate, err := role.GetCertificate()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get cert role certificate").WithCause(err)
+	}
+
+	allowedCommonNames, err := role.GetAllowedCommonNames()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get allowed common names").WithCause(err)
+	}
+
+	state.CertRoles[roleName] = &certauth.Role{
+		Name:               roleName,
+		Policies:           copySlice(policies),
+		Certificate:        certificate,
+		AllowedCommonNames: copySlice(allowedCommonNames),
+	}
+
+	return nil
+}
+
+func (f *API) DeleteCertAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteCertAuthRole"); err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	if state, err := f.getAuthMethod(method, auth.MethodCert); err == nil {
+		delete(state.CertRoles, roleName)
+	}
+
+	return nil
+}
+
+func (f *API) LoginWithCertAuth(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (*core.AuthResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "LoginWithCertAuth"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getAuthMethod(method, auth.MethodCert)
+	if err != nil {
+		return nil, err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := state.CertRoles[roleName]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("cert auth role %s does not exist", roleName))
+	}
+
+	path, err := getMountPath(method)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.issueToken(path, current.Name, current.Policies)
+}
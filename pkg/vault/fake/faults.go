@@ -0,0 +1,95 @@
+package fake
+
+import (
+	"context"
+)
+
+// AnyMethod can be passed to InjectError and InjectErrorOnce to make every method fail.
+const AnyMethod = "*"
+
+type fault struct {
+	err  error
+	once bool
+}
+
+// InjectError makes every following call to the method return err until
+// ClearErrors is called. The method is identified by its name as defined in
+// vault.API, e.g. "ReadKvSecret".
+func (f *API) InjectError(method string, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.faults[method] = &fault{err: err}
+}
+
+// InjectErrorOnce makes only the next call to the method return err.
+func (f *API) InjectErrorOnce(method string, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.faults[method] = &fault{err: err, once: true}
+}
+
+// ClearErrors removes all injected errors.
+func (f *API) ClearErrors() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.faults = make(map[string]*fault)
+}
+
+// Calls returns the names of all methods called so far in the order they were called.
+func (f *API) Calls() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]string(nil), f.calls...)
+}
+
+// CallCount returns how often the method has been called.
+func (f *API) CallCount(method string) int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	count := 0
+	for _, call := range f.calls {
+		if call == method {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ResetCalls forgets all recorded calls.
+func (f *API) ResetCalls() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.calls = nil
+}
+
+// call records a call to the method and returns the error that should be
+// returned instead of performing it, if any. The lock has to be held.
+func (f *API) call(ctx context.Context, method string) error {
+	f.calls = append(f.calls, method)
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range []string{method, AnyMethod} {
+		injected, ok := f.faults[key]
+		if !ok {
+			continue
+		}
+
+		if injected.once {
+			delete(f.faults, key)
+		}
+
+		return injected.err
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package fake
+
+import (
+	"crypto/x509"
+	"sort"
+
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
+	"github.com/youniqx/heist/pkg/vault/mount"
+	"github.com/youniqx/heist/pkg/vault/passwordpolicy"
+	"github.com/youniqx/heist/pkg/vault/policy"
+)
+
+// The methods in this file allow tests to inspect the state of the fake
+// without going through vault.API. They neither record calls nor are they
+// affected by injected errors.
+
+// Mount returns the secret engine mounted at the path.
+func (f *API) Mount(path string) (*mount.Mount, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	path = normalizePath(path)
+
+	state, ok := f.mounts[path]
+	if !ok {
+		return nil, false
+	}
+
+	return state.toMount(path), true
+}
+
+// KvSecret returns the fields of the current version of a KV secret.
+func (f *API) KvSecret(enginePath string, secretPath string) (map[string]string, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.mounts[normalizePath(enginePath)]
+	if !ok {
+		return nil, false
+	}
+
+	secret, err := readKvSecretVersion(state, core.SecretPath(secretPath), 0)
+	if err != nil {
+		return nil, false
+	}
+
+	return secret.Fields, true
+}
+
+// KvSecretVersion returns the current version of a KV secret. It is always
+// zero for secrets stored in KV v1 engines.
+func (f *API) KvSecretVersion(enginePath string, secretPath string) (int, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.mounts[normalizePath(enginePath)]
+	if !ok {
+		return 0, false
+	}
+
+	secret, ok := state.Secrets[normalizePath(secretPath)]
+	if !ok {
+		return 0, false
+	}
+
+	return secret.CurrentVersion, true
+}
+
+// Policy returns the rules of a policy.
+func (f *API) Policy(name string) ([]*policy.Rule, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.policies[name]
+	if !ok {
+		return nil, false
+	}
+
+	return copyRules(state.Rules), true
+}
+
+// PolicyNames returns the sorted names of all policies.
+func (f *API) PolicyNames() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	result := make([]string, 0, len(f.policies))
+	for name := range f.policies {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// PasswordPolicy returns a password policy.
+func (f *API) PasswordPolicy(name string) (*passwordpolicy.PasswordPolicy, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	policy, ok := f.passwordPolicies[name]
+	if !ok {
+		return nil, false
+	}
+
+	return copyPasswordPolicy(name, policy), true
+}
+
+// TransitKeyVersions returns the number of versions of a transit key.
+func (f *API) TransitKeyVersions(enginePath string, keyName string) (int, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.mounts[normalizePath(enginePath)]
+	if !ok {
+		return 0, false
+	}
+
+	key, ok := state.TransitKeys[keyName]
+	if !ok {
+		return 0, false
+	}
+
+	return len(key.Versions), true
+}
+
+// AuthMethod returns the type of the auth method enabled at the path.
+func (f *API) AuthMethod(path string) (auth.Type, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.authMethods[normalizePath(path)]
+	if !ok {
+		return "", false
+	}
+
+	return state.Type, true
+}
+
+// KubernetesAuthRole returns a role of the kubernetes auth method enabled at the path.
+func (f *API) KubernetesAuthRole(methodPath string, roleName string) (*kubernetesauth.Role, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.authMethods[normalizePath(methodPath)]
+	if !ok {
+		return nil, false
+	}
+
+	role, ok := state.KubernetesRoles[roleName]
+	if !ok {
+		return nil, false
+	}
+
+	return copyKubernetesRole(role), true
+}
+
+// IssuedCertificates returns all certificates issued by the pki engine at the
+// path which haven't been removed by Tidy, sorted by serial number.
+func (f *API) IssuedCertificates(enginePath string) []*x509.Certificate {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.mounts[normalizePath(enginePath)]
+	if !ok {
+		return nil
+	}
+
+	serialNumbers := make([]string, 0, len(state.PKI.Issued))
+	for serialNumber := range state.PKI.Issued {
+		serialNumbers = append(serialNumbers, serialNumber)
+	}
+
+	sort.Strings(serialNumbers)
+
+	result := make([]*x509.Certificate, 0, len(serialNumbers))
+	for _, serialNumber := range serialNumbers {
+		result = append(result, state.PKI.Issued[serialNumber].Certificate)
+	}
+
+	return result
+}
+
+// IsRevoked returns true if the certificate with the serial number issued by
+// the pki engine at the path has been revoked.
+func (f *API) IsRevoked(enginePath string, serialNumber string) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.mounts[normalizePath(enginePath)]
+	if !ok {
+		return false
+	}
+
+	issued, ok := state.PKI.Issued[normalizeSerialNumber(serialNumber)]
+
+	return ok && issued.Revoked
+}
+
+// TokenPolicies returns the policies attached to a token issued by one of the login methods.
+func (f *API) TokenPolicies(token string) ([]string, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.tokens[token]
+	if !ok {
+		return nil, false
+	}
+
+	return copySlice(state.Policies), true
+}
+
+// TokenRevocations returns how often RevokeToken has been called successfully.
+func (f *API) TokenRevocations() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.tokenRevocation
+}
@@ -0,0 +1,141 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/jwtauth"
+)
+
+func (f *API) UpdateJWTAuthMethod(ctx context.Context, method jwtauth.MethodEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateJWTAuthMethod"); err != nil {
+		return err
+	}
+
+	config, err := method.GetMethodConfig()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get jwt auth config").WithCause(err)
+	}
+
+	state, err := f.ensureAuthMethod(method, auth.MethodJWT)
+	if err != nil {
+		return err
+	}
+
+	if config != nil {
+		configCopy := *config
+		configCopy.JWTValidationPubKeys = copySlice(config.JWTValidationPubKeys)
+		state.JWTConfig = &configCopy
+	}
+
+	return nil
+}
+
+func (f *API) UpdateJWTAuthRole(ctx context.Context, method core.MountPathEntity, role jwtauth.RoleEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateJWTAuthRole"); err != nil {
+		return err
+	}
+
+	state, err := f.getAuthMethod(method, auth.MethodJWT)
+	if err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	policies, err := role.GetRolePolicies()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get jwt role policies").WithCause(err)
+	}
+
+	boundAudiences, err := role.GetBoundAudiences()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get bound audiences").WithCause(err)
+	}
+
+	boundSubject, err := role.GetBoundSubject()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get bound subject").WithCause(err)
+	}
+
+	userClaim, err := role.GetUserClaim()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get user claim").WithCause(err)
+	}
+
+	state.JWTRoles[roleName] = &jwtauth.Role{
+		Name:           roleName,
+		Policies:       copySlice(policies),
+		BoundAudiences: copySlice(boundAudiences),
+		BoundSubject:   boundSubject,
+		UserClaim:      userClaim,
+	}
+
+	return nil
+}
+
+func (f *API) DeleteJWTAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteJWTAuthRole"); err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	if state, err := f.getAuthMethod(method, auth.MethodJWT); err == nil {
+		delete(state.JWTRoles, roleName)
+	}
+
+	return nil
+}
+
+func (f *API) LoginWithJWTAuth(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity, jwt string) (*core.AuthResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "LoginWithJWTAuth"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getAuthMethod(method, auth.MethodJWT)
+	if err != nil {
+		return nil, err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := state.JWTRoles[roleName]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("jwt auth role %s does not exist", roleName))
+	}
+
+	if jwt == "" {
+		return nil, core.ErrAPIError.WithDetails("permission denied: missing jwt")
+	}
+
+	path, err := getMountPath(method)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.issueToken(path, current.Name, current.Policies)
+}
@@ -0,0 +1,169 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kubernetesauth"
+)
+
+func (f *API) UpdateKubernetesAuthMethod(ctx context.Context, method kubernetesauth.MethodEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateKubernetesAuthMethod"); err != nil {
+		return err
+	}
+
+	config, err := method.GetMethodConfig()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get kubernetes auth config").WithCause(err)
+	}
+
+	state, err := f.ensureAuthMethod(method, auth.MethodKubernetes)
+	if err != nil {
+		return err
+	}
+
+	if config != nil {
+		configCopy := *config
+		configCopy.PemKeys = copySlice(config.PemKeys)
+		state.KubernetesConfig = &configCopy
+	}
+
+	return nil
+}
+
+func (f *API) UpdateKubernetesAuthRole(ctx context.Context, method core.MountPathEntity, role kubernetesauth.RoleEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateKubernetesAuthRole"); err != nil {
+		return err
+	}
+
+	state, err := f.getAuthMethod(method, auth.MethodKubernetes)
+	if err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	policies, err := role.GetRolePolicies()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get k8s role policies").WithCause(err)
+	}
+
+	boundNamespaces, err := role.GetBoundNamespaces()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to list bound namespaces").WithCause(err)
+	}
+
+	boundServiceAccounts, err := role.GetBoundServiceAccounts()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to list bound service accounts").WithCause(err)
+	}
+
+	state.KubernetesRoles[roleName] = copyKubernetesRole(&kubernetesauth.Role{
+		Name:                 roleName,
+		Policies:             policies,
+		BoundNamespaces:      boundNamespaces,
+		BoundServiceAccounts: boundServiceAccounts,
+	})
+
+	return nil
+}
+
+func (f *API) DeleteKubernetesAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteKubernetesAuthRole"); err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	if state, err := f.getAuthMethod(method, auth.MethodKubernetes); err == nil {
+		delete(state.KubernetesRoles, roleName)
+	}
+
+	return nil
+}
+
+func (f *API) ReadKubernetesAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (*kubernetesauth.Role, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadKubernetesAuthRole"); err != nil {
+		return nil, err
+	}
+
+	current, err := f.getKubernetesAuthRole(method, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyKubernetesRole(current), nil
+}
+
+func (f *API) LoginWithKubernetesAuth(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity, jwt string) (*core.AuthResponse, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "LoginWithKubernetesAuth"); err != nil {
+		return nil, err
+	}
+
+	current, err := f.getKubernetesAuthRole(method, role)
+	if err != nil {
+		return nil, err
+	}
+
+	if jwt == "" {
+		return nil, core.ErrAPIError.WithDetails("permission denied: missing service account token")
+	}
+
+	path, err := getMountPath(method)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.issueToken(path, current.Name, current.Policies)
+}
+
+func (f *API) getKubernetesAuthRole(method core.MountPathEntity, role core.RoleNameEntity) (*kubernetesauth.Role, error) {
+	state, err := f.getAuthMethod(method, auth.MethodKubernetes)
+	if err != nil {
+		return nil, err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := state.KubernetesRoles[roleName]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("kubernetes auth role %s does not exist", roleName))
+	}
+
+	return current, nil
+}
+
+func copyKubernetesRole(role *kubernetesauth.Role) *kubernetesauth.Role {
+	return &kubernetesauth.Role{
+		Name:                 role.Name,
+		Policies:             copySlice(role.Policies),
+		BoundNamespaces:      copySlice(role.BoundNamespaces),
+		BoundServiceAccounts: copySlice(role.BoundServiceAccounts),
+	}
+}
@@ -0,0 +1,159 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+	"github.com/youniqx/heist/pkg/vault/mount"
+)
+
+func (f *API) UpdateKvEngine(ctx context.Context, engine kvengine.Entity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateKvEngine"); err != nil {
+		return err
+	}
+
+	path, err := getMountPath(engine)
+	if err != nil {
+		return err
+	}
+
+	version, err := engine.GetKvEngineVersion()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get engine version").WithCause(err)
+	}
+
+	config, err := engine.GetKvEngineConfig()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get engine config").WithCause(err)
+	}
+
+	if _, exists := f.mounts[path]; exists {
+		state, err := f.getMountOfType(engine, mount.TypeKVV1, mount.TypeKVV2)
+		if err != nil {
+			return err
+		}
+
+		if currentVersion := state.kvVersion(); currentVersion != version {
+			return core.ErrAPIError.WithDetails(fmt.Sprintf("kv engine is already mounted as %s, cannot change it to %s", currentVersion, version))
+		}
+	} else {
+		var mountType mount.Type
+
+		switch version {
+		case kvengine.VersionV1:
+			mountType = mount.TypeKVV1
+		case kvengine.VersionV2:
+			mountType = mount.TypeKVV2
+		default:
+			return core.ErrAPIError.WithDetails(fmt.Sprintf("unsupported kv engine version: %s", version))
+		}
+
+		options := map[string]string{"version": string(version)[1:]}
+		if err := f.createMount(path, mountType, options, nil); err != nil {
+			return err
+		}
+	}
+
+	// KV v1 engines don't have a config endpoint
+	if version == kvengine.VersionV2 && config != nil {
+		configCopy := *config
+		f.mounts[path].KvConfig = &configCopy
+	}
+
+	return nil
+}
+
+func (f *API) ListKvSecrets(ctx context.Context, engine core.MountPathEntity) ([]core.SecretPath, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ListKvSecrets"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypeKVV1, mount.TypeKVV2)
+	if err != nil {
+		return nil, nil
+	}
+
+	result := make([]core.SecretPath, 0, len(state.Secrets))
+	for path := range state.Secrets {
+		result = append(result, core.SecretPath(path))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return result, nil
+}
+
+func (f *API) ReadKvEngine(ctx context.Context, engine core.MountPathEntity) (*kvengine.KvEngine, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadKvEngine"); err != nil {
+		return nil, err
+	}
+
+	path, err := engine.GetMountPath()
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypeKVV1, mount.TypeKVV2)
+	if err != nil {
+		return nil, err
+	}
+
+	version := state.kvVersion()
+
+	// KV v1 engines don't have a config endpoint
+	if version == kvengine.VersionV1 {
+		return &kvengine.KvEngine{
+			Path:    path,
+			Version: version,
+		}, nil
+	}
+
+	config := *state.KvConfig
+
+	return &kvengine.KvEngine{
+		Path:    path,
+		Version: version,
+		Config:  &config,
+	}, nil
+}
+
+func (f *API) ReadKvEngineVersion(ctx context.Context, engine core.MountPathEntity) (kvengine.Version, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadKvEngineVersion"); err != nil {
+		return "", err
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypeKVV1, mount.TypeKVV2)
+	if err != nil {
+		return "", err
+	}
+
+	return state.kvVersion(), nil
+}
+
+func (m *mountState) kvVersion() kvengine.Version {
+	switch {
+	case m.Type == mount.TypeKVV2:
+		return kvengine.VersionV2
+	case m.Options["version"] == "2":
+		return kvengine.VersionV2
+	default:
+		return kvengine.VersionV1
+	}
+}
@@ -0,0 +1,344 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+	"github.com/youniqx/heist/pkg/vault/kvsecret"
+	"github.com/youniqx/heist/pkg/vault/mount"
+)
+
+// defaultMaxVersions is the number of versions Vault keeps if max_versions is not configured.
+const defaultMaxVersions = 10
+
+type kvSecretState struct {
+	CurrentVersion int
+	Versions       map[int]*kvVersionState
+}
+
+type kvVersionState struct {
+	Fields       map[string]string
+	CreatedTime  time.Time
+	DeletionTime time.Time
+	Destroyed    bool
+}
+
+func (v *kvVersionState) isAvailable() bool {
+	return v.DeletionTime.IsZero() && !v.Destroyed
+}
+
+func (f *API) UpdateKvSecret(ctx context.Context, engine core.MountPathEntity, secret kvsecret.Entity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateKvSecret"); err != nil {
+		return err
+	}
+
+	_, err := f.updateKvSecret(engine, secret, kvsecret.AnyVersion)
+
+	return err
+}
+
+func (f *API) UpdateKvSecretCAS(ctx context.Context, engine core.MountPathEntity, secret kvsecret.Entity, expectedVersion int) (int, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateKvSecretCAS"); err != nil {
+		return 0, err
+	}
+
+	return f.updateKvSecret(engine, secret, expectedVersion)
+}
+
+func (f *API) updateKvSecret(engine core.MountPathEntity, secret kvsecret.Entity, expectedVersion int) (int, error) {
+	state, err := f.getMountOfType(engine, mount.TypeKVV1, mount.TypeKVV2)
+	if err != nil {
+		return 0, err
+	}
+
+	path, err := getSecretPath(secret)
+	if err != nil {
+		return 0, err
+	}
+
+	fields, err := secret.GetFields()
+	if err != nil {
+		return 0, core.ErrAPIError.WithDetails("failed to get secret fields").WithCause(err)
+	}
+
+	current, exists := state.Secrets[path]
+	if !exists {
+		current = &kvSecretState{Versions: make(map[int]*kvVersionState)}
+	}
+
+	latest, hasLatest := current.Versions[current.CurrentVersion]
+	if hasLatest && latest.isAvailable() && reflect.DeepEqual(latest.Fields, fields) {
+		return current.CurrentVersion, nil
+	}
+
+	version := state.kvVersion()
+
+	// KV v1 engines are not versioned, so there is nothing to compare against
+	if version == kvengine.VersionV1 {
+		current.Versions[0] = &kvVersionState{Fields: copyStringMap(fields), CreatedTime: f.now()}
+		state.Secrets[path] = current
+
+		return 0, nil
+	}
+
+	if expectedVersion != kvsecret.AnyVersion && expectedVersion != current.CurrentVersion {
+		return current.CurrentVersion, kvsecret.ErrCASConflict.WithDetails(fmt.Sprintf("expected version %d of the secret but found version %d", expectedVersion, current.CurrentVersion))
+	}
+
+	current.CurrentVersion++
+	current.Versions[current.CurrentVersion] = &kvVersionState{Fields: copyStringMap(fields), CreatedTime: f.now()}
+
+	maxVersions := state.KvConfig.MaxVersions
+	if maxVersions <= 0 {
+		maxVersions = defaultMaxVersions
+	}
+
+	for number := range current.Versions {
+		if number <= current.CurrentVersion-maxVersions {
+			delete(current.Versions, number)
+		}
+	}
+
+	state.Secrets[path] = current
+
+	return current.CurrentVersion, nil
+}
+
+func (f *API) DeleteKvSecret(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteKvSecret"); err != nil {
+		return err
+	}
+
+	path, err := getSecretPath(secret)
+	if err != nil {
+		return err
+	}
+
+	if state, err := f.getMountOfType(engine, mount.TypeKVV1, mount.TypeKVV2); err == nil {
+		delete(state.Secrets, path)
+	}
+
+	return nil
+}
+
+func (f *API) ReadKvSecret(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity) (*kvsecret.KvSecret, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadKvSecret"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypeKVV1, mount.TypeKVV2)
+	if err != nil {
+		return nil, err
+	}
+
+	return readKvSecretVersion(state, secret, -1)
+}
+
+func (f *API) ReadKvSecretVersion(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, version int) (*kvsecret.KvSecret, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadKvSecretVersion"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getVersionedKvEngine(engine)
+	if err != nil {
+		return nil, err
+	}
+
+	return readKvSecretVersion(state, secret, version)
+}
+
+// readKvSecretVersion returns the given version of the secret. The current
+// version is returned if version is zero or negative.
+func readKvSecretVersion(state *mountState, secret core.SecretPathEntity, version int) (*kvsecret.KvSecret, error) {
+	path, err := getSecretPath(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := state.Secrets[path]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("secret %s does not exist", path))
+	}
+
+	if version <= 0 {
+		version = current.CurrentVersion
+	}
+
+	data, ok := current.Versions[version]
+	if !ok || !data.isAvailable() {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("version %d of secret %s does not exist", version, path))
+	}
+
+	secretPath, err := secret.GetSecretPath()
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to get secret path").WithCause(err)
+	}
+
+	return &kvsecret.KvSecret{
+		Path:   secretPath,
+		Fields: copyStringMap(data.Fields),
+	}, nil
+}
+
+func (f *API) ListKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity) (*kvsecret.Metadata, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ListKvSecretVersions"); err != nil {
+		return nil, err
+	}
+
+	current, err := f.getVersionedKvSecret(engine, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &kvsecret.Metadata{
+		CurrentVersion: current.CurrentVersion,
+		Versions:       make([]*kvsecret.VersionMetadata, 0, len(current.Versions)),
+	}
+
+	for number, version := range current.Versions {
+		info := &kvsecret.VersionMetadata{
+			Version:     number,
+			CreatedTime: version.CreatedTime.UTC().Format(time.RFC3339Nano),
+			Destroyed:   version.Destroyed,
+		}
+
+		if !version.DeletionTime.IsZero() {
+			info.DeletionTime = version.DeletionTime.UTC().Format(time.RFC3339Nano)
+		}
+
+		metadata.Versions = append(metadata.Versions, info)
+	}
+
+	sort.Slice(metadata.Versions, func(i, j int) bool {
+		return metadata.Versions[i].Version < metadata.Versions[j].Version
+	})
+
+	if len(metadata.Versions) > 0 {
+		metadata.OldestVersion = metadata.Versions[0].Version
+	}
+
+	return metadata, nil
+}
+
+func (f *API) DeleteKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, versions []int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteKvSecretVersions"); err != nil {
+		return err
+	}
+
+	return f.updateKvSecretVersions(engine, secret, versions, func(version *kvVersionState) {
+		if version.isAvailable() {
+			version.DeletionTime = f.now()
+		}
+	})
+}
+
+func (f *API) UndeleteKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, versions []int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UndeleteKvSecretVersions"); err != nil {
+		return err
+	}
+
+	return f.updateKvSecretVersions(engine, secret, versions, func(version *kvVersionState) {
+		if !version.Destroyed {
+			version.DeletionTime = time.Time{}
+		}
+	})
+}
+
+func (f *API) DestroyKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, versions []int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DestroyKvSecretVersions"); err != nil {
+		return err
+	}
+
+	return f.updateKvSecretVersions(engine, secret, versions, func(version *kvVersionState) {
+		version.Destroyed = true
+		version.Fields = nil
+	})
+}
+
+func (f *API) updateKvSecretVersions(engine core.MountPathEntity, secret core.SecretPathEntity, versions []int, update func(version *kvVersionState)) error {
+	current, err := f.getVersionedKvSecret(engine, secret)
+	if err != nil {
+		return err
+	}
+
+	for _, number := range versions {
+		if version, ok := current.Versions[number]; ok {
+			update(version)
+		}
+	}
+
+	return nil
+}
+
+func (f *API) getVersionedKvEngine(engine core.MountPathEntity) (*mountState, error) {
+	state, err := f.getMountOfType(engine, mount.TypeKVV1, mount.TypeKVV2)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.kvVersion() != kvengine.VersionV2 {
+		return nil, kvsecret.ErrNotVersioned
+	}
+
+	return state, nil
+}
+
+func (f *API) getVersionedKvSecret(engine core.MountPathEntity, secret core.SecretPathEntity) (*kvSecretState, error) {
+	state, err := f.getVersionedKvEngine(engine)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := getSecretPath(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := state.Secrets[path]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("secret %s does not exist", path))
+	}
+
+	return current, nil
+}
+
+func getSecretPath(secret core.SecretPathEntity) (string, error) {
+	path, err := secret.GetSecretPath()
+	if err != nil {
+		return "", core.ErrAPIError.WithDetails("failed to get secret path").WithCause(err)
+	}
+
+	return normalizePath(path), nil
+}
@@ -0,0 +1,266 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+	"github.com/youniqx/heist/pkg/vault/mount"
+	"github.com/youniqx/heist/pkg/vault/transit"
+)
+
+type mountState struct {
+	Type    mount.Type
+	Options map[string]string
+	Config  *mount.TuneConfig
+
+	KvConfig      *kvengine.Config
+	Secrets       map[string]*kvSecretState
+	TransitConfig *transit.EngineConfig
+	TransitKeys   map[string]*transitKeyState
+	PKI           *pkiState
+}
+
+func (f *API) HasEngine(ctx context.Context, engine core.MountPathEntity) (bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "HasEngine"); err != nil {
+		return false, err
+	}
+
+	path, err := getMountPath(engine)
+	if err != nil {
+		return false, err
+	}
+
+	_, exists := f.mounts[path]
+
+	return exists, nil
+}
+
+func (f *API) ListMounts(ctx context.Context) ([]*mount.Mount, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ListMounts"); err != nil {
+		return nil, err
+	}
+
+	result := make([]*mount.Mount, 0, len(f.mounts))
+	for path, state := range f.mounts {
+		result = append(result, state.toMount(path))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result, nil
+}
+
+func (f *API) DeleteEngine(ctx context.Context, engine core.MountPathEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteEngine"); err != nil {
+		return err
+	}
+
+	path, err := getMountPath(engine)
+	if err != nil {
+		return err
+	}
+
+	delete(f.mounts, path)
+
+	return nil
+}
+
+func (f *API) MountEngine(ctx context.Context, engine mount.Entity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "MountEngine"); err != nil {
+		return err
+	}
+
+	return f.mountEngine(engine)
+}
+
+func (f *API) mountEngine(engine mount.Entity) error {
+	path, err := getMountPath(engine)
+	if err != nil {
+		return err
+	}
+
+	mountType, err := engine.GetMountType()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get mount type").WithCause(err)
+	}
+
+	options, err := engine.GetMountOptions()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get mount options").WithCause(err)
+	}
+
+	config, err := engine.GetMountConfig()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get mount config").WithCause(err)
+	}
+
+	return f.createMount(path, mountType, options, config)
+}
+
+func (f *API) createMount(path string, mountType mount.Type, options map[string]string, config *mount.TuneConfig) error {
+	if _, exists := f.mounts[path]; exists {
+		return core.ErrAPIError.WithDetails(fmt.Sprintf("path is already in use at %s/", path))
+	}
+
+	f.mounts[path] = &mountState{
+		Type:          mountType,
+		Options:       copyStringMap(options),
+		Config:        copyTuneConfig(config),
+		KvConfig:      &kvengine.Config{},
+		Secrets:       make(map[string]*kvSecretState),
+		TransitConfig: &transit.EngineConfig{},
+		TransitKeys:   make(map[string]*transitKeyState),
+		PKI:           newPKIState(),
+	}
+
+	return nil
+}
+
+func (f *API) ReadMount(ctx context.Context, engine core.MountPathEntity) (*mount.Mount, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadMount"); err != nil {
+		return nil, err
+	}
+
+	path, err := getMountPath(engine)
+	if err != nil {
+		return nil, err
+	}
+
+	state, ok := f.mounts[path]
+	if !ok {
+		return nil, core.ErrDoesNotExist
+	}
+
+	return state.toMount(path), nil
+}
+
+func (f *API) ReloadPluginBackends(ctx context.Context, plugin mount.Plugin) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.call(ctx, "ReloadPluginBackends")
+}
+
+func (f *API) TuneEngine(ctx context.Context, engine core.MountPathEntity, config *mount.TuneConfig) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "TuneEngine"); err != nil {
+		return err
+	}
+
+	state, err := f.getMount(engine)
+	if err != nil {
+		return err
+	}
+
+	state.Config = copyTuneConfig(config)
+
+	return nil
+}
+
+func (f *API) ReadTuneConfig(ctx context.Context, engine core.MountPathEntity) (*mount.TuneConfig, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadTuneConfig"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getMount(engine)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyTuneConfig(state.Config), nil
+}
+
+func (f *API) getMount(engine core.MountPathEntity) (*mountState, error) {
+	path, err := getMountPath(engine)
+	if err != nil {
+		return nil, err
+	}
+
+	state, ok := f.mounts[path]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("no secret engine mounted at %s", path))
+	}
+
+	return state, nil
+}
+
+// getMountOfType returns the engine mounted at the path of the entity if it is
+// of one of the given types. Engines mounted using a custom plugin name are
+// accepted as long as the plugin name doesn't belong to a different engine type.
+func (f *API) getMountOfType(engine core.MountPathEntity, types ...mount.Type) (*mountState, error) {
+	state, err := f.getMount(engine)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mountType := range types {
+		if state.Type == mountType {
+			return state, nil
+		}
+	}
+
+	switch state.Type {
+	case mount.TypeKVV1, mount.TypeKVV2, mount.TypeTransit, mount.TypePKI:
+		return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("secret engine is of type %s, expected one of %v", state.Type, types))
+	default:
+		return state, nil
+	}
+}
+
+func (m *mountState) toMount(path string) *mount.Mount {
+	return &mount.Mount{
+		Path:    path,
+		Type:    m.Type,
+		Options: copyStringMap(m.Options),
+		Config:  copyTuneConfig(m.Config),
+	}
+}
+
+func copyTuneConfig(config *mount.TuneConfig) *mount.TuneConfig {
+	if config == nil {
+		return nil
+	}
+
+	result := *config
+	result.DefaultLeaseTTL = copyTTL(config.DefaultLeaseTTL)
+	result.MaxLeaseTTL = copyTTL(config.MaxLeaseTTL)
+	result.AuditNonHmacRequestKeys = copySlice(config.AuditNonHmacRequestKeys)
+	result.AuditNonHmacResponseKeys = copySlice(config.AuditNonHmacResponseKeys)
+	result.PassthroughRequestHeaders = copySlice(config.PassthroughRequestHeaders)
+	result.AllowedResponseHeaders = copySlice(config.AllowedResponseHeaders)
+
+	return &result
+}
+
+func copyTTL(ttl *core.VaultTTL) *core.VaultTTL {
+	if ttl == nil {
+		return nil
+	}
+
+	return core.NewTTL(ttl.TTL)
+}
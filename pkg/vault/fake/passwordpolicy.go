@@ -0,0 +1,183 @@
+package fake
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/passwordpolicy"
+)
+
+func (f *API) UpdatePasswordPolicy(ctx context.Context, entity passwordpolicy.Entity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdatePasswordPolicy"); err != nil {
+		return err
+	}
+
+	name, err := getPolicyName(entity)
+	if err != nil {
+		return err
+	}
+
+	policy, err := entity.GetPasswordPolicy()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get password policy").WithCause(err)
+	}
+
+	if err := validatePasswordPolicy(policy); err != nil {
+		return err
+	}
+
+	f.passwordPolicies[name] = copyPasswordPolicy(name, policy)
+
+	return nil
+}
+
+func (f *API) DeletePasswordPolicy(ctx context.Context, entity core.PolicyNameEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeletePasswordPolicy"); err != nil {
+		return err
+	}
+
+	name, err := getPolicyName(entity)
+	if err != nil {
+		return err
+	}
+
+	delete(f.passwordPolicies, name)
+
+	return nil
+}
+
+func (f *API) ReadPasswordPolicy(ctx context.Context, entity core.PolicyNameEntity) (*passwordpolicy.PasswordPolicy, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadPasswordPolicy"); err != nil {
+		return nil, err
+	}
+
+	name, err := getPolicyName(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, ok := f.passwordPolicies[name]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("password policy %s does not exist", name))
+	}
+
+	return copyPasswordPolicy(name, policy), nil
+}
+
+func (f *API) GeneratePassword(ctx context.Context, entity core.PolicyNameEntity) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "GeneratePassword"); err != nil {
+		return "", err
+	}
+
+	name, err := getPolicyName(entity)
+	if err != nil {
+		return "", err
+	}
+
+	policy, ok := f.passwordPolicies[name]
+	if !ok {
+		return "", core.ErrAPIError.WithDetails(fmt.Sprintf("password policy %s does not exist", name))
+	}
+
+	return generatePassword(policy)
+}
+
+func validatePasswordPolicy(policy *passwordpolicy.PasswordPolicy) error {
+	if len(policy.Rules) == 0 {
+		return core.ErrAPIError.WithDetails("password policy must contain at least one charset rule")
+	}
+
+	minChars := 0
+	for _, rule := range policy.Rules {
+		if rule.Charset == "" {
+			return core.ErrAPIError.WithDetails("charset rule must not have an empty charset")
+		}
+		minChars += rule.MinChars
+	}
+
+	if policy.Length <= 0 || minChars > policy.Length {
+		return core.ErrAPIError.WithDetails(fmt.Sprintf("password policy length %d cannot satisfy the minimum characters of its rules", policy.Length))
+	}
+
+	return nil
+}
+
+// generatePassword picks the minimum number of characters of each rule first
+// and fills the remaining length from all charsets, like Vault does.
+func generatePassword(policy *passwordpolicy.PasswordPolicy) (string, error) {
+	password := make([]rune, 0, policy.Length)
+	var allChars []rune
+
+	for _, rule := range policy.Rules {
+		charset := []rune(rule.Charset)
+		allChars = append(allChars, charset...)
+
+		for i := 0; i < rule.MinChars; i++ {
+			char, err := randomRune(charset)
+			if err != nil {
+				return "", err
+			}
+			password = append(password, char)
+		}
+	}
+
+	for len(password) < policy.Length {
+		char, err := randomRune(allChars)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, char)
+	}
+
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", core.ErrAPIError.WithDetails("failed to shuffle password").WithCause(err)
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+
+	return string(password), nil
+}
+
+func randomRune(charset []rune) (rune, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+	if err != nil {
+		return 0, core.ErrAPIError.WithDetails("failed to pick random character").WithCause(err)
+	}
+
+	return charset[index.Int64()], nil
+}
+
+func copyPasswordPolicy(name string, policy *passwordpolicy.PasswordPolicy) *passwordpolicy.PasswordPolicy {
+	result := &passwordpolicy.PasswordPolicy{
+		Name:   name,
+		Length: policy.Length,
+		Rules:  make([]*passwordpolicy.CharsetRule, 0, len(policy.Rules)),
+	}
+
+	for _, rule := range policy.Rules {
+		result.Rules = append(result.Rules, &passwordpolicy.CharsetRule{
+			Type:     passwordpolicy.CharsetRuleType,
+			Charset:  rule.Charset,
+			MinChars: rule.MinChars,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,509 @@
+package fake
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/mount"
+	"github.com/youniqx/heist/pkg/vault/pki"
+)
+
+// defaultCATTL is the validity of CA certificates if no TTL has been requested.
+const defaultCATTL = 10 * core.Year
+
+type pkiState struct {
+	Certificate *x509.Certificate
+	PrivateKey  crypto.Signer
+	// Chain contains the PEM encoded CA certificate followed by the certificates of its issuers.
+	Chain  []string
+	Roles  map[string]*pki.CertificateRole
+	Issued map[string]*issuedCertificate
+}
+
+type issuedCertificate struct {
+	Certificate *x509.Certificate
+	Revoked     bool
+}
+
+func newPKIState() *pkiState {
+	return &pkiState{
+		Roles:  make(map[string]*pki.CertificateRole),
+		Issued: make(map[string]*issuedCertificate),
+	}
+}
+
+func (p *pkiState) isInitialized() bool {
+	return p.Certificate != nil
+}
+
+func (p *pkiState) certificatePEM() string {
+	if !p.isInitialized() {
+		return ""
+	}
+
+	return p.Chain[0]
+}
+
+func (f *API) UpdatePKIEngine(ctx context.Context, engine pki.EngineEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdatePKIEngine"); err != nil {
+		return err
+	}
+
+	_, err := f.updatePKIEngine(engine)
+
+	return err
+}
+
+func (f *API) updatePKIEngine(engine pki.EngineEntity) (*mountState, error) {
+	path, err := getMountPath(engine)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := engine.GetPKIEngineConfig()
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to get desired pki mount config").WithCause(err)
+	}
+
+	pluginName, err := engine.GetPluginName()
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to fetch plugin name").WithCause(err)
+	}
+
+	if pluginName == "" {
+		pluginName = string(mount.TypePKI)
+	}
+
+	if _, exists := f.mounts[path]; !exists {
+		if err := f.createMount(path, mount.Type(pluginName), nil, config); err != nil {
+			return nil, err
+		}
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypePKI)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Config = copyTuneConfig(config)
+
+	return state, nil
+}
+
+func (f *API) ReadPKIEngine(ctx context.Context, engine core.MountPathEntity) (*pki.Engine, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadPKIEngine"); err != nil {
+		return nil, err
+	}
+
+	path, err := engine.GetMountPath()
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to get pki engine path").WithCause(err)
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypePKI)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pki.Engine{
+		Path:       path,
+		PluginName: string(state.Type),
+		Config:     copyTuneConfig(state.Config),
+	}, nil
+}
+
+func (f *API) ListCerts(ctx context.Context, engine core.MountPathEntity) ([]string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ListCerts"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypePKI)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(state.PKI.Issued)+1)
+	if state.PKI.isInitialized() {
+		result = append(result, formatSerialNumber(state.PKI.Certificate))
+	}
+
+	for serial := range state.PKI.Issued {
+		result = append(result, serial)
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+func (f *API) ReadCACertificatePEM(ctx context.Context, ca core.MountPathEntity) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadCACertificatePEM"); err != nil {
+		return "", err
+	}
+
+	state, err := f.getMountOfType(ca, mount.TypePKI)
+	if err != nil {
+		return "", err
+	}
+
+	return state.PKI.certificatePEM(), nil
+}
+
+func (f *API) IsPKIEngineInitialized(ctx context.Context, ca core.MountPathEntity) (bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "IsPKIEngineInitialized"); err != nil {
+		return false, err
+	}
+
+	return f.isPKIEngineInitialized(ca)
+}
+
+func (f *API) isPKIEngineInitialized(ca core.MountPathEntity) (bool, error) {
+	path, err := getMountPath(ca)
+	if err != nil {
+		return false, err
+	}
+
+	if _, exists := f.mounts[path]; !exists {
+		return false, nil
+	}
+
+	state, err := f.getMountOfType(ca, mount.TypePKI)
+	if err != nil {
+		return false, err
+	}
+
+	return state.PKI.isInitialized(), nil
+}
+
+func (f *API) CreateRootCA(ctx context.Context, mode pki.Mode, ca pki.CAEntity) (*pki.CAInfo, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "CreateRootCA"); err != nil {
+		return nil, err
+	}
+
+	return f.createCA(mode, nil, ca)
+}
+
+func (f *API) UpdateRootCA(ctx context.Context, ca pki.CAEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateRootCA"); err != nil {
+		return err
+	}
+
+	return f.updateCA(nil, ca)
+}
+
+func (f *API) CreateIntermediateCA(ctx context.Context, mode pki.Mode, issuer core.MountPathEntity, ca pki.CAEntity) (*pki.CAInfo, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "CreateIntermediateCA"); err != nil {
+		return nil, err
+	}
+
+	return f.createCA(mode, issuer, ca)
+}
+
+func (f *API) UpdateIntermediateCA(ctx context.Context, issuer core.MountPathEntity, ca pki.CAEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateIntermediateCA"); err != nil {
+		return err
+	}
+
+	return f.updateCA(issuer, ca)
+}
+
+func (f *API) updateCA(issuer core.MountPathEntity, ca pki.CAEntity) error {
+	initialized, err := f.isPKIEngineInitialized(ca)
+	if err != nil {
+		return err
+	}
+
+	if !initialized {
+		_, err := f.createCA(pki.ModeInternal, issuer, ca)
+		return err
+	}
+
+	_, err = f.updatePKIEngine(ca)
+
+	return err
+}
+
+// createCA creates a new CA in the pki engine of the entity. The CA is self
+// signed if issuer is nil, otherwise it is signed by the CA of the issuer.
+//
+//nolint:cyclop
+func (f *API) createCA(mode pki.Mode, issuer core.MountPathEntity, ca pki.CAEntity) (*pki.CAInfo, error) {
+	switch mode {
+	case pki.ModeInternal, pki.ModeExported:
+	default:
+		return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("unknown ca mode setting: %s", mode))
+	}
+
+	initialized, err := f.isPKIEngineInitialized(ca)
+	if err != nil {
+		return nil, err
+	}
+
+	if initialized {
+		return nil, core.ErrAPIError.WithDetails("ca already exists, cannot create it again")
+	}
+
+	var issuerState *pkiState
+
+	if issuer != nil {
+		issuerMount, err := f.getMountOfType(issuer, mount.TypePKI)
+		if err != nil {
+			return nil, err
+		}
+
+		if !issuerMount.PKI.isInitialized() {
+			return nil, core.ErrAPIError.WithDetails("issuer ca has not been initialized")
+		}
+
+		issuerState = issuerMount.PKI
+	}
+
+	importedCert, err := ca.GetImportedCert()
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to get imported cert from ca entity").WithCause(err)
+	}
+
+	var (
+		certificate *x509.Certificate
+		privateKey  crypto.Signer
+	)
+
+	if importedCert != nil {
+		certificate, privateKey, err = parseImportedCert(importedCert)
+	} else {
+		certificate, privateKey, err = f.generateCA(issuerState, ca)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPEM, privateKeyType, err := encodePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := f.updatePKIEngine(ca)
+	if err != nil {
+		return nil, err
+	}
+
+	state.PKI.Certificate = certificate
+	state.PKI.PrivateKey = privateKey
+	state.PKI.Chain = []string{encodeCertificate(certificate)}
+
+	issuingCA := state.PKI.Chain[0]
+	if issuerState != nil {
+		issuingCA = issuerState.certificatePEM()
+		state.PKI.Chain = append(state.PKI.Chain, issuerState.Chain...)
+	}
+
+	path, err := ca.GetMountPath()
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to get pki engine path").WithCause(err)
+	}
+
+	info := &pki.CAInfo{
+		Path:                        path,
+		SerialNumber:                formatSerialNumber(certificate),
+		IssuingCertificateAuthority: issuingCA,
+		CertificateChain:            strings.Join(state.PKI.Chain, "\n"),
+		Certificate:                 state.PKI.Chain[0],
+	}
+
+	if mode == pki.ModeExported {
+		info.PrivateKey = privateKeyPEM
+		info.PrivateKeyType = privateKeyType
+	}
+
+	return info, nil
+}
+
+func (f *API) generateCA(issuer *pkiState, ca pki.CAEntity) (*x509.Certificate, crypto.Signer, error) {
+	settings, err := ca.GetSettings()
+	if err != nil {
+		return nil, nil, core.ErrAPIError.WithDetails("failed to get ca settings").WithCause(err)
+	}
+
+	if settings == nil {
+		settings = &pki.CASettings{}
+	}
+
+	subject, err := ca.GetSubject()
+	if err != nil {
+		return nil, nil, core.ErrAPIError.WithDetails("failed to get ca subject").WithCause(err)
+	}
+
+	if subject == nil {
+		subject = &pki.Subject{}
+	}
+
+	privateKey, err := generatePrivateKey(settings.KeyType, settings.KeyBits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ttl := defaultCATTL
+	if settings.TTL != nil && settings.TTL.TTL > 0 {
+		ttl = settings.TTL.TTL
+	}
+
+	template, err := f.newCertificateTemplate(subjectName(subject.CommonName, subject.SubjectSettings), ttl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template.Subject.SerialNumber = subject.SerialNumber
+	template.IsCA = true
+	template.BasicConstraintsValid = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+	template.PermittedDNSDomains = copySlice(settings.PermittedDNSDomains)
+
+	if err := addSubjectAlternativeNames(template, subject.CommonName, settings.ExcludeCNFromSans, settings.SubjectAlternativeNames, settings.IPSans, settings.URISans); err != nil {
+		return nil, nil, err
+	}
+
+	certificate, err := signCertificate(template, privateKey.Public(), issuer, privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certificate, privateKey, nil
+}
+
+func (f *API) ReadCA(ctx context.Context, ca core.MountPathEntity) (*pki.CA, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadCA"); err != nil {
+		return nil, err
+	}
+
+	path, err := ca.GetMountPath()
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to get pki engine path").WithCause(err)
+	}
+
+	state, err := f.getMountOfType(ca, mount.TypePKI)
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.PKI.isInitialized() {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("pki engine %s has no ca", path))
+	}
+
+	subject := state.PKI.Certificate.Subject
+
+	return &pki.CA{
+		Path: path,
+		Subject: &pki.Subject{
+			CommonName:   subject.CommonName,
+			SerialNumber: subject.SerialNumber,
+			SubjectSettings: &pki.SubjectSettings{
+				Organization:       subject.Organization,
+				OrganizationalUnit: subject.OrganizationalUnit,
+				Country:            subject.Country,
+				Locality:           subject.Locality,
+				Province:           subject.Province,
+				StreetAddress:      subject.StreetAddress,
+				PostalCode:         subject.PostalCode,
+			},
+		},
+		Config: copyTuneConfig(state.Config),
+	}, nil
+}
+
+func (f *API) RotateCRLs(ctx context.Context, ca core.MountPathEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "RotateCRLs"); err != nil {
+		return err
+	}
+
+	_, err := f.getInitializedPKI(ca)
+
+	return err
+}
+
+func (f *API) getInitializedPKI(ca core.MountPathEntity) (*mountState, error) {
+	state, err := f.getMountOfType(ca, mount.TypePKI)
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.PKI.isInitialized() {
+		return nil, core.ErrAPIError.WithDetails("pki engine has no ca")
+	}
+
+	return state, nil
+}
+
+// newCertificateTemplate returns a certificate template with a random serial
+// number which is valid from now until the TTL has passed.
+func (f *API) newCertificateTemplate(subject pkix.Name, ttl time.Duration) (*x509.Certificate, error) {
+	serialNumber, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := f.now()
+
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      subject,
+		NotBefore:    now.Add(-30 * time.Second),
+		NotAfter:     now.Add(ttl),
+	}, nil
+}
+
+func subjectName(commonName string, settings *pki.SubjectSettings) pkix.Name {
+	name := pkix.Name{CommonName: commonName}
+
+	if settings != nil {
+		name.Organization = copySlice(settings.Organization)
+		name.OrganizationalUnit = copySlice(settings.OrganizationalUnit)
+		name.Country = copySlice(settings.Country)
+		name.Locality = copySlice(settings.Locality)
+		name.Province = copySlice(settings.Province)
+		name.StreetAddress = copySlice(settings.StreetAddress)
+		name.PostalCode = copySlice(settings.PostalCode)
+	}
+
+	return name
+}
@@ -0,0 +1,527 @@
+package fake
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/mount"
+	"github.com/youniqx/heist/pkg/vault/pki"
+)
+
+const (
+	// defaultCertificateTTL is the system default lease TTL of Vault.
+	defaultCertificateTTL = 32 * core.Day
+	// defaultSafetyBuffer is the safety buffer Vault uses for tidy operations if none is configured.
+	defaultSafetyBuffer = 72 * time.Hour
+)
+
+var keyUsages = map[pki.KeyUsage]x509.KeyUsage{
+	pki.KeyUsageDigitalSignature:  x509.KeyUsageDigitalSignature,
+	pki.KeyUsageKeyAgreement:      x509.KeyUsageKeyAgreement,
+	pki.KeyUsageKeyEncipherment:   x509.KeyUsageKeyEncipherment,
+	pki.KeyUsageContentCommitment: x509.KeyUsageContentCommitment,
+	pki.KeyUsageDataEncipherment:  x509.KeyUsageDataEncipherment,
+	pki.KeyUsageCertSign:          x509.KeyUsageCertSign,
+	pki.KeyUsageCRLSign:           x509.KeyUsageCRLSign,
+	pki.KeyUsageEncipherOnly:      x509.KeyUsageEncipherOnly,
+	pki.KeyUsageDecipherOnly:      x509.KeyUsageDecipherOnly,
+}
+
+var extendedKeyUsages = map[pki.ExtendedKeyUsage]x509.ExtKeyUsage{
+	pki.ExtendedKeyUsageAny:                            x509.ExtKeyUsageAny,
+	pki.ExtendedKeyUsageServerAuth:                     x509.ExtKeyUsageServerAuth,
+	pki.ExtendedKeyUsageClientAuth:                     x509.ExtKeyUsageClientAuth,
+	pki.ExtendedKeyUsageCodeSigning:                    x509.ExtKeyUsageCodeSigning,
+	pki.ExtendedKeyUsageEmailProtection:                x509.ExtKeyUsageEmailProtection,
+	pki.ExtendedKeyUsageIPSECEndSystem:                 x509.ExtKeyUsageIPSECEndSystem,
+	pki.ExtendedKeyUsageIPSECTunnel:                    x509.ExtKeyUsageIPSECTunnel,
+	pki.ExtendedKeyUsageIPSECUser:                      x509.ExtKeyUsageIPSECUser,
+	pki.ExtendedKeyUsageTimeStamping:                   x509.ExtKeyUsageTimeStamping,
+	pki.ExtendedKeyUsageOCSPSigning:                    x509.ExtKeyUsageOCSPSigning,
+	pki.ExtendedKeyUsageMicrosoftServerGatedCrypto:     x509.ExtKeyUsageMicrosoftServerGatedCrypto,
+	pki.ExtendedKeyUsageNetscapeServerGatedCrypto:      x509.ExtKeyUsageNetscapeServerGatedCrypto,
+	pki.ExtendedKeyUsageMicrosoftCommercialCodeSigning: x509.ExtKeyUsageMicrosoftCommercialCodeSigning,
+	pki.ExtendedKeyUsageMicrosoftKernelCodeSigning:     x509.ExtKeyUsageMicrosoftKernelCodeSigning,
+}
+
+// certificateRequest contains everything needed to issue a certificate,
+// regardless of whether the key has been generated by Vault or provided as CSR.
+type certificateRequest struct {
+	CommonName        string
+	DNSSans           []string
+	IPSans            []string
+	URISans           []string
+	TTL               time.Duration
+	ExcludeCNFromSans bool
+	PublicKey         crypto.PublicKey
+}
+
+func (f *API) UpdateCertificateRole(ctx context.Context, ca core.MountPathEntity, role pki.CertificateRoleEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateCertificateRole"); err != nil {
+		return err
+	}
+
+	state, err := f.getMountOfType(ca, mount.TypePKI)
+	if err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	settings, err := role.GetSettings()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get role settings").WithCause(err)
+	}
+
+	subject, err := role.GetSubject()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get role subject").WithCause(err)
+	}
+
+	state.PKI.Roles[roleName] = copyCertificateRole(&pki.CertificateRole{
+		Name:     roleName,
+		Settings: settings,
+		Subject:  subject,
+	})
+
+	return nil
+}
+
+func (f *API) ReadCertificateRole(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity) (*pki.CertificateRole, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadCertificateRole"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getMountOfType(ca, mount.TypePKI)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := getCertificateRole(state, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyCertificateRole(current), nil
+}
+
+func (f *API) DeleteCertificateRole(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteCertificateRole"); err != nil {
+		return err
+	}
+
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return err
+	}
+
+	if state, err := f.getMountOfType(ca, mount.TypePKI); err == nil {
+		delete(state.PKI.Roles, roleName)
+	}
+
+	return nil
+}
+
+func (f *API) IssueCertificate(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity, options *pki.IssueCertOptions) (*pki.Certificate, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "IssueCertificate"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getInitializedPKI(ca)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := getCertificateRole(state, role)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := current.Settings
+	if settings == nil {
+		settings = &pki.RoleSettings{}
+	}
+
+	privateKey, err := generatePrivateKey(settings.KeyType, settings.KeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := f.issueCertificate(state, current, &certificateRequest{
+		CommonName:        options.CommonName,
+		DNSSans:           options.DNSSans,
+		IPSans:            options.IPSans,
+		URISans:           options.URISans,
+		TTL:               options.TTL,
+		ExcludeCNFromSans: options.ExcludeCNFromSans,
+		PublicKey:         privateKey.Public(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	certificate.PrivateKey, certificate.PrivateKeyType, err = encodePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return certificate, nil
+}
+
+func (f *API) SignCertificateSigningRequest(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity, request *pki.SignCsr) (*pki.Certificate, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "SignCertificateSigningRequest"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getInitializedPKI(ca)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := getCertificateRole(state, role)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode([]byte(request.CSR))
+	if block == nil {
+		return nil, core.ErrAPIError.WithDetails("failed to decode csr pem")
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to parse csr").WithCause(err)
+	}
+
+	if err := csr.CheckSignature(); err != nil {
+		return nil, core.ErrAPIError.WithDetails("csr signature is invalid").WithCause(err)
+	}
+
+	certificateRequest := &certificateRequest{
+		CommonName: request.CommonName,
+		DNSSans:    copySlice(request.AlternativeNames),
+		IPSans:     copySlice(request.IPSans),
+		URISans:    copySlice(request.URISans),
+		TTL:        request.TTL,
+		PublicKey:  csr.PublicKey,
+	}
+
+	if current.Settings != nil && current.Settings.UseCSRCommonName {
+		certificateRequest.CommonName = csr.Subject.CommonName
+	}
+
+	if current.Settings != nil && current.Settings.UseCSRSans {
+		certificateRequest.DNSSans = append(certificateRequest.DNSSans, csr.DNSNames...)
+		certificateRequest.DNSSans = append(certificateRequest.DNSSans, csr.EmailAddresses...)
+
+		for _, ip := range csr.IPAddresses {
+			certificateRequest.IPSans = append(certificateRequest.IPSans, ip.String())
+		}
+
+		for _, uri := range csr.URIs {
+			certificateRequest.URISans = append(certificateRequest.URISans, uri.String())
+		}
+	}
+
+	return f.issueCertificate(state, current, certificateRequest)
+}
+
+// issueCertificate validates the request against the role and signs the
+// certificate with the CA of the engine. Other SANs are not supported.
+//
+//nolint:cyclop
+func (f *API) issueCertificate(state *mountState, role *pki.CertificateRole, request *certificateRequest) (*pki.Certificate, error) {
+	settings := role.Settings
+	if settings == nil {
+		settings = &pki.RoleSettings{}
+	}
+
+	if request.CommonName == "" && settings.RequireCommonName {
+		return nil, core.ErrAPIError.WithDetails("the common_name field is required")
+	}
+
+	for _, name := range append([]string{request.CommonName}, request.DNSSans...) {
+		if name != "" && !isNameAllowed(settings, name) {
+			return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("%s is not an allowed common name or subject alternative name", name))
+		}
+	}
+
+	if len(request.IPSans) > 0 && !settings.AllowIPSans {
+		return nil, core.ErrAPIError.WithDetails("IP Subject Alternative Names are not allowed in this role")
+	}
+
+	for _, uri := range request.URISans {
+		if !matchesAny(settings.AllowedURISans, uri) {
+			return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("URI Subject Alternative Name %s is not allowed by this role", uri))
+		}
+	}
+
+	template, err := f.newCertificateTemplate(subjectName(request.CommonName, role.Subject), certificateTTL(state, settings, request.TTL))
+	if err != nil {
+		return nil, err
+	}
+
+	template.BasicConstraintsValid = settings.BasicConstraintsValidForNonCA
+	template.KeyUsage = certificateKeyUsage(settings)
+	template.ExtKeyUsage = certificateExtKeyUsage(settings)
+
+	if err := addSubjectAlternativeNames(template, request.CommonName, request.ExcludeCNFromSans, request.DNSSans, request.IPSans, request.URISans); err != nil {
+		return nil, err
+	}
+
+	certificate, err := signCertificate(template, request.PublicKey, state.PKI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber := formatSerialNumber(certificate)
+
+	if !settings.NoStore {
+		state.PKI.Issued[serialNumber] = &issuedCertificate{Certificate: certificate}
+	}
+
+	return &pki.Certificate{
+		Certificate:  encodeCertificate(certificate),
+		IssuingCA:    state.PKI.certificatePEM(),
+		CAChain:      copySlice(state.PKI.Chain),
+		SerialNumber: serialNumber,
+	}, nil
+}
+
+func (f *API) RevokeCertificate(ctx context.Context, ca core.MountPathEntity, serial pki.SerialNumberEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "RevokeCertificate"); err != nil {
+		return err
+	}
+
+	state, err := f.getInitializedPKI(ca)
+	if err != nil {
+		return err
+	}
+
+	serialNumber, err := serial.GetSerialNumber()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get serial number").WithCause(err)
+	}
+
+	issued, ok := state.PKI.Issued[normalizeSerialNumber(serialNumber)]
+	if !ok {
+		return core.ErrDoesNotExist.WithDetails(fmt.Sprintf("certificate with serial %s not found", serialNumber))
+	}
+
+	issued.Revoked = true
+
+	return nil
+}
+
+func (f *API) Tidy(ctx context.Context, ca core.MountPathEntity, settings *pki.TidySettings) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "Tidy"); err != nil {
+		return err
+	}
+
+	state, err := f.getMountOfType(ca, mount.TypePKI)
+	if err != nil {
+		return err
+	}
+
+	safetyBuffer := defaultSafetyBuffer
+	if settings.SafetyBuffer != nil {
+		safetyBuffer = settings.SafetyBuffer.TTL
+	}
+
+	now := f.now()
+
+	for serialNumber, issued := range state.PKI.Issued {
+		if !now.After(issued.Certificate.NotAfter.Add(safetyBuffer)) {
+			continue
+		}
+
+		if settings.TidyCertStore || (settings.TidyRevokedCerts && issued.Revoked) {
+			delete(state.PKI.Issued, serialNumber)
+		}
+	}
+
+	return nil
+}
+
+func getCertificateRole(state *mountState, role core.RoleNameEntity) (*pki.CertificateRole, error) {
+	roleName, err := getRoleName(role)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := state.PKI.Roles[roleName]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("certificate role %s does not exist", roleName))
+	}
+
+	return current, nil
+}
+
+// certificateTTL returns the validity of a new certificate. The requested TTL
+// falls back to the TTL of the role and the default lease TTL of the engine
+// and is limited by the max TTL of the role.
+func certificateTTL(state *mountState, settings *pki.RoleSettings, requested time.Duration) time.Duration {
+	ttl := requested
+
+	if ttl <= 0 && settings.TTL != nil {
+		ttl = settings.TTL.TTL
+	}
+
+	if ttl <= 0 && state.Config != nil && state.Config.DefaultLeaseTTL != nil {
+		ttl = state.Config.DefaultLeaseTTL.TTL
+	}
+
+	if ttl <= 0 {
+		ttl = defaultCertificateTTL
+	}
+
+	if settings.MaxTTL != nil && settings.MaxTTL.TTL > 0 && ttl > settings.MaxTTL.TTL {
+		ttl = settings.MaxTTL.TTL
+	}
+
+	return ttl
+}
+
+func certificateKeyUsage(settings *pki.RoleSettings) x509.KeyUsage {
+	if settings.KeyUsage == nil {
+		return x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement | x509.KeyUsageKeyEncipherment
+	}
+
+	var usage x509.KeyUsage
+	for _, value := range settings.KeyUsage {
+		usage |= keyUsages[value]
+	}
+
+	return usage
+}
+
+func certificateExtKeyUsage(settings *pki.RoleSettings) []x509.ExtKeyUsage {
+	var usages []x509.ExtKeyUsage
+
+	if settings.ServerFlag {
+		usages = append(usages, x509.ExtKeyUsageServerAuth)
+	}
+
+	if settings.ClientFlag {
+		usages = append(usages, x509.ExtKeyUsageClientAuth)
+	}
+
+	if settings.CodeSigningFlag {
+		usages = append(usages, x509.ExtKeyUsageCodeSigning)
+	}
+
+	if settings.EmailProtectionFlag {
+		usages = append(usages, x509.ExtKeyUsageEmailProtection)
+	}
+
+	for _, value := range settings.ExtendedKeyUsage {
+		if usage, ok := extendedKeyUsages[value]; ok {
+			usages = append(usages, usage)
+		}
+	}
+
+	return usages
+}
+
+// isNameAllowed checks a common name or DNS SAN against the domain settings of the role.
+func isNameAllowed(settings *pki.RoleSettings, name string) bool {
+	if settings.AllowAnyName {
+		return true
+	}
+
+	if settings.AllowLocalhost && (name == "localhost" || name == "localdomain") {
+		return true
+	}
+
+	if settings.AllowIPSans && net.ParseIP(name) != nil {
+		return true
+	}
+
+	for _, domain := range settings.AllowedDomains {
+		switch {
+		case settings.AllowBareDomains && name == domain:
+			return true
+		case settings.AllowSubdomains && strings.HasSuffix(name, "."+strings.TrimPrefix(domain, "*.")):
+			return true
+		case settings.AllowGlobDomains && matchesAny([]string{domain}, name):
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, value); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func copyCertificateRole(role *pki.CertificateRole) *pki.CertificateRole {
+	result := &pki.CertificateRole{Name: role.Name}
+
+	if role.Settings != nil {
+		settings := *role.Settings
+		settings.TTL = copyTTL(role.Settings.TTL)
+		settings.MaxTTL = copyTTL(role.Settings.MaxTTL)
+		settings.NotBeforeDuration = copyTTL(role.Settings.NotBeforeDuration)
+		settings.AllowedDomains = copySlice(role.Settings.AllowedDomains)
+		settings.AllowedURISans = copySlice(role.Settings.AllowedURISans)
+		settings.AllowedOtherSans = copySlice(role.Settings.AllowedOtherSans)
+		settings.KeyUsage = copySlice(role.Settings.KeyUsage)
+		settings.ExtendedKeyUsage = copySlice(role.Settings.ExtendedKeyUsage)
+		settings.ExtendedKeyUsageOids = copySlice(role.Settings.ExtendedKeyUsageOids)
+		settings.PolicyIdentifiers = copySlice(role.Settings.PolicyIdentifiers)
+		result.Settings = &settings
+	}
+
+	if role.Subject != nil {
+		subject := *role.Subject
+		subject.Organization = copySlice(role.Subject.Organization)
+		subject.OrganizationalUnit = copySlice(role.Subject.OrganizationalUnit)
+		subject.Country = copySlice(role.Subject.Country)
+		subject.Locality = copySlice(role.Subject.Locality)
+		subject.Province = copySlice(role.Subject.Province)
+		subject.StreetAddress = copySlice(role.Subject.StreetAddress)
+		subject.PostalCode = copySlice(role.Subject.PostalCode)
+		result.Subject = &subject
+	}
+
+	return result
+}
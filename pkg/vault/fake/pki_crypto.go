@@ -0,0 +1,233 @@
+package fake
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/pki"
+)
+
+const serialNumberBytes = 20
+
+func generatePrivateKey(keyType pki.KeyType, keyBits pki.KeyBits) (crypto.Signer, error) {
+	var (
+		key crypto.Signer
+		err error
+	)
+
+	switch keyType {
+	case pki.KeyTypeEC:
+		var curve elliptic.Curve
+
+		switch keyBits {
+		case pki.KeyBitsEC224:
+			curve = elliptic.P224()
+		case 0, pki.KeyBitsEC256:
+			curve = elliptic.P256()
+		case pki.KeyBitsEC384:
+			curve = elliptic.P384()
+		case pki.KeyBitsEC521:
+			curve = elliptic.P521()
+		default:
+			return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("unsupported bit length for ec key: %d", keyBits))
+		}
+
+		key, err = ecdsa.GenerateKey(curve, rand.Reader)
+	case "", pki.KeyTypeRSA, pki.KeyTypeAny:
+		switch keyBits {
+		case 0:
+			keyBits = pki.KeyBitsRSA2048
+		case pki.KeyBitsRSA2048, pki.KeyBitsRSA3072, pki.KeyBitsRSA4096:
+		default:
+			return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("unsupported bit length for rsa key: %d", keyBits))
+		}
+
+		key, err = rsa.GenerateKey(rand.Reader, int(keyBits))
+	default:
+		return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("unsupported key type: %s", keyType))
+	}
+
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to generate private key").WithCause(err)
+	}
+
+	return key, nil
+}
+
+// encodePrivateKey encodes the key in the same PEM format Vault uses.
+func encodePrivateKey(key crypto.Signer) (string, pki.KeyType, error) {
+	switch privateKey := key.(type) {
+	case *rsa.PrivateKey:
+		block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}
+		return strings.TrimSpace(string(pem.EncodeToMemory(block))), pki.KeyTypeRSA, nil
+	case *ecdsa.PrivateKey:
+		data, err := x509.MarshalECPrivateKey(privateKey)
+		if err != nil {
+			return "", "", core.ErrAPIError.WithDetails("failed to marshal private key").WithCause(err)
+		}
+
+		block := &pem.Block{Type: "EC PRIVATE KEY", Bytes: data}
+
+		return strings.TrimSpace(string(pem.EncodeToMemory(block))), pki.KeyTypeEC, nil
+	default:
+		return "", "", core.ErrAPIError.WithDetails("encountered unknown private key type")
+	}
+}
+
+func encodeCertificate(certificate *x509.Certificate) string {
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw}
+	return strings.TrimSpace(string(pem.EncodeToMemory(block)))
+}
+
+func parseImportedCert(cert *pki.ImportedCert) (*x509.Certificate, crypto.Signer, error) {
+	certificateBlock, _ := pem.Decode([]byte(cert.Certificate))
+	if certificateBlock == nil {
+		return nil, nil, core.ErrAPIError.WithDetails("failed to decode certificate pem")
+	}
+
+	certificate, err := x509.ParseCertificate(certificateBlock.Bytes)
+	if err != nil {
+		return nil, nil, core.ErrAPIError.WithDetails("failed to parse certificate data").WithCause(err)
+	}
+
+	privateKeyBlock, _ := pem.Decode([]byte(cert.PrivateKey))
+	if privateKeyBlock == nil {
+		return nil, nil, core.ErrAPIError.WithDetails("failed to decode private key pem")
+	}
+
+	var key interface{}
+
+	switch privateKeyBlock.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(privateKeyBlock.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(privateKeyBlock.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(privateKeyBlock.Bytes)
+	default:
+		return nil, nil, core.ErrAPIError.WithDetails("encountered unknown pem block type")
+	}
+
+	if err != nil {
+		return nil, nil, core.ErrAPIError.WithDetails("failed to parse private key").WithCause(err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, nil, core.ErrAPIError.WithDetails("encountered unknown private key type")
+	}
+
+	return certificate, signer, nil
+}
+
+// signCertificate creates the certificate described by the template. It is
+// signed by the CA of the issuer or self signed with selfSigningKey if there is no issuer.
+func signCertificate(template *x509.Certificate, publicKey crypto.PublicKey, issuer *pkiState, selfSigningKey crypto.Signer) (*x509.Certificate, error) {
+	parent, signingKey := template, selfSigningKey
+	if issuer != nil {
+		parent, signingKey = issuer.Certificate, issuer.PrivateKey
+
+		if template.NotAfter.After(parent.NotAfter) {
+			return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("cannot satisfy request, as TTL would result in notAfter %s that is beyond the expiration of the CA certificate at %s", template.NotAfter.UTC(), parent.NotAfter.UTC()))
+		}
+	}
+
+	data, err := x509.CreateCertificate(rand.Reader, template, parent, publicKey, signingKey)
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to create certificate").WithCause(err)
+	}
+
+	certificate, err := x509.ParseCertificate(data)
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to parse certificate data").WithCause(err)
+	}
+
+	return certificate, nil
+}
+
+// addSubjectAlternativeNames adds the requested names to the template. Like
+// Vault, the common name is added to the DNS or email SANs unless excluded.
+func addSubjectAlternativeNames(template *x509.Certificate, commonName string, excludeCommonName bool, altNames []string, ipSans []string, uriSans []string) error {
+	names := copySlice(altNames)
+	if commonName != "" && !excludeCommonName && net.ParseIP(commonName) == nil {
+		names = append([]string{commonName}, names...)
+	}
+
+	for _, name := range names {
+		if strings.Contains(name, "@") {
+			template.EmailAddresses = appendUnique(template.EmailAddresses, name)
+		} else {
+			template.DNSNames = appendUnique(template.DNSNames, name)
+		}
+	}
+
+	for _, value := range ipSans {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return core.ErrAPIError.WithDetails(fmt.Sprintf("the value %q is not a valid IP address", value))
+		}
+
+		template.IPAddresses = append(template.IPAddresses, ip)
+	}
+
+	for _, value := range uriSans {
+		uri, err := url.Parse(value)
+		if err != nil {
+			return core.ErrAPIError.WithDetails(fmt.Sprintf("the value %q is not a valid URI", value)).WithCause(err)
+		}
+
+		template.URIs = append(template.URIs, uri)
+	}
+
+	return nil
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
+
+func newSerialNumber() (*big.Int, error) {
+	data, err := randomBytes(serialNumberBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the number positive and the encoding at a fixed length
+	data[0] = data[0]&0x7f | 0x01
+
+	return new(big.Int).SetBytes(data), nil
+}
+
+// formatSerialNumber formats the serial number of the certificate as colon separated hex pairs like Vault does.
+func formatSerialNumber(certificate *x509.Certificate) string {
+	encoded := hex.EncodeToString(certificate.SerialNumber.Bytes())
+
+	pairs := make([]string, 0, len(encoded)/2)
+	for i := 0; i < len(encoded); i += 2 {
+		pairs = append(pairs, encoded[i:i+2])
+	}
+
+	return strings.Join(pairs, ":")
+}
+
+func normalizeSerialNumber(serial string) string {
+	return strings.ToLower(strings.ReplaceAll(serial, "-", ":"))
+}
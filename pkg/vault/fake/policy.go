@@ -0,0 +1,99 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/policy"
+)
+
+type policyState struct {
+	Rules []*policy.Rule
+}
+
+func (f *API) UpdatePolicy(ctx context.Context, entity policy.Entity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdatePolicy"); err != nil {
+		return err
+	}
+
+	name, err := getPolicyName(entity)
+	if err != nil {
+		return err
+	}
+
+	rules, err := entity.GetPolicyRules()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get policy rules").WithCause(err)
+	}
+
+	f.policies[name] = &policyState{Rules: copyRules(rules)}
+
+	return nil
+}
+
+func (f *API) DeletePolicy(ctx context.Context, entity core.PolicyNameEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeletePolicy"); err != nil {
+		return err
+	}
+
+	name, err := getPolicyName(entity)
+	if err != nil {
+		return err
+	}
+
+	delete(f.policies, name)
+
+	return nil
+}
+
+func (f *API) ReadPolicy(ctx context.Context, entity core.PolicyNameEntity) (*policy.Policy, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadPolicy"); err != nil {
+		return nil, err
+	}
+
+	name, err := getPolicyName(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	state, ok := f.policies[name]
+	if !ok {
+		return nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("policy %s does not exist", name))
+	}
+
+	return &policy.Policy{
+		Name:  name,
+		Rules: copyRules(state.Rules),
+	}, nil
+}
+
+func getPolicyName(entity core.PolicyNameEntity) (string, error) {
+	name, err := entity.GetPolicyName()
+	if err != nil {
+		return "", core.ErrAPIError.WithDetails("failed to get policy name").WithCause(err)
+	}
+
+	return name, nil
+}
+
+func copyRules(rules []*policy.Rule) []*policy.Rule {
+	result := make([]*policy.Rule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, &policy.Rule{
+			Path:         rule.Path,
+			Capabilities: copySlice(rule.Capabilities),
+		})
+	}
+
+	return result
+}
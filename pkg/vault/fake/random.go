@@ -0,0 +1,54 @@
+package fake
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (f *API) GenerateRandomBytes(ctx context.Context, length int) ([]byte, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "GenerateRandomBytes"); err != nil {
+		return nil, err
+	}
+
+	if length <= 0 {
+		return nil, core.ErrAPIError.WithDetails("tried to generate random byte slice with length 0")
+	}
+
+	return randomBytes(length)
+}
+
+func (f *API) GenerateRandomString(ctx context.Context, length int) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "GenerateRandomString"); err != nil {
+		return "", err
+	}
+
+	if length <= 0 {
+		return "", core.ErrAPIError.WithDetails("tried to generate random byte slice with length 0")
+	}
+
+	data, err := randomBytes(length)
+	if err != nil {
+		return "", err
+	}
+
+	// Vault returns the random bytes base64 encoded, the real API cuts that string to the requested length
+	return base64.StdEncoding.EncodeToString(data)[0:length], nil
+}
+
+func randomBytes(length int) ([]byte, error) {
+	data := make([]byte, length)
+	if _, err := rand.Read(data); err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to generate random bytes").WithCause(err)
+	}
+
+	return data, nil
+}
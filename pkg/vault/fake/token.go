@@ -0,0 +1,65 @@
+package fake
+
+import (
+	"encoding/hex"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+// TokenTTL is the lease duration of tokens issued by the fake login methods.
+const TokenTTL = 3600
+
+const tokenBytes = 12
+
+type tokenState struct {
+	Method   string
+	Role     string
+	Policies []string
+}
+
+// issueToken creates a new token for a successful login. Like Vault, the
+// default policy is always attached to the token.
+func (f *API) issueToken(method string, role string, policies []core.PolicyName) (*core.AuthResponse, error) {
+	token, err := randomID("hvs.")
+	if err != nil {
+		return nil, err
+	}
+
+	accessor, err := randomID("")
+	if err != nil {
+		return nil, err
+	}
+
+	tokenPolicies := []string{"default"}
+	for _, policyName := range policies {
+		tokenPolicies = append(tokenPolicies, string(policyName))
+	}
+
+	f.tokens[token] = &tokenState{
+		Method:   method,
+		Role:     role,
+		Policies: tokenPolicies,
+	}
+
+	return &core.AuthResponse{
+		Auth: core.AuthData{
+			ClientToken:   token,
+			Accessor:      accessor,
+			Policies:      copySlice(tokenPolicies),
+			LeaseDuration: TokenTTL,
+			Renewable:     true,
+			Metadata: core.AuthMetadata{
+				Role: role,
+			},
+		},
+	}, nil
+}
+
+func randomID(prefix string) (string, error) {
+	data, err := randomBytes(tokenBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + hex.EncodeToString(data), nil
+}
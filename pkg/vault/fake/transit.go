@@ -0,0 +1,600 @@
+package fake
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/mount"
+	"github.com/youniqx/heist/pkg/vault/transit"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	transitPrefix     = "vault:v"
+	transitKeyBytes   = 32
+	transitAES128Size = 16
+)
+
+type transitKeyState struct {
+	Type     transit.KeyType
+	Config   *transit.KeyConfig
+	Versions []*transitKeyVersion
+}
+
+type transitKeyVersion struct {
+	Key     []byte
+	HMACKey []byte
+	Signer  crypto.Signer
+}
+
+func (f *API) UpdateTransitEngine(ctx context.Context, engine transit.EngineEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateTransitEngine"); err != nil {
+		return err
+	}
+
+	path, err := getMountPath(engine)
+	if err != nil {
+		return err
+	}
+
+	pluginName, err := engine.GetPluginName()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get plugin name").WithCause(err)
+	}
+
+	if pluginName == "" {
+		pluginName = string(mount.TypeTransit)
+	}
+
+	config, err := engine.GetTransitEngineConfig()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get transit engine config").WithCause(err)
+	}
+
+	if _, exists := f.mounts[path]; !exists {
+		if err := f.createMount(path, mount.Type(pluginName), nil, nil); err != nil {
+			return err
+		}
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypeTransit)
+	if err != nil {
+		return err
+	}
+
+	if config != nil {
+		configCopy := *config
+		state.TransitConfig = &configCopy
+	}
+
+	return nil
+}
+
+func (f *API) ReadTransitEngine(ctx context.Context, engine core.MountPathEntity) (*transit.Engine, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadTransitEngine"); err != nil {
+		return nil, err
+	}
+
+	path, err := engine.GetMountPath()
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypeTransit)
+	if err != nil {
+		return nil, err
+	}
+
+	config := *state.TransitConfig
+
+	return &transit.Engine{
+		Path:       path,
+		PluginName: string(state.Type),
+		Config:     &config,
+	}, nil
+}
+
+func (f *API) ListKeys(ctx context.Context, engine core.MountPathEntity) ([]transit.KeyName, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ListKeys"); err != nil {
+		return nil, err
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypeTransit)
+	if err != nil || len(state.TransitKeys) == 0 {
+		return nil, nil
+	}
+
+	result := make([]transit.KeyName, 0, len(state.TransitKeys))
+	for name := range state.TransitKeys {
+		result = append(result, transit.KeyName(name))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return result, nil
+}
+
+func (f *API) UpdateTransitKey(ctx context.Context, engine core.MountPathEntity, key transit.KeyEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "UpdateTransitKey"); err != nil {
+		return err
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypeTransit)
+	if err != nil {
+		return err
+	}
+
+	keyName, err := key.GetTransitKeyName()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get transit key name").WithCause(err)
+	}
+
+	keyType, err := key.GetTransitKeyType()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get transit key type").WithCause(err)
+	}
+
+	keyConfig, err := key.GetTransitKeyConfig()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get transit key config").WithCause(err)
+	}
+
+	current, exists := state.TransitKeys[keyName]
+	if !exists {
+		version, err := newTransitKeyVersion(keyType)
+		if err != nil {
+			return err
+		}
+
+		current = &transitKeyState{
+			Type:     keyType,
+			Versions: []*transitKeyVersion{version},
+		}
+		state.TransitKeys[keyName] = current
+	}
+
+	if current.Type != keyType {
+		return core.ErrAPIError.WithDetails("a key with this name but different type already exists in the engine, key type is immutable after creation")
+	}
+
+	current.Config = copyKeyConfig(keyConfig)
+
+	return nil
+}
+
+func (f *API) ReadTransitKey(ctx context.Context, engine core.MountPathEntity, key transit.KeyNameEntity) (*transit.Key, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "ReadTransitKey"); err != nil {
+		return nil, err
+	}
+
+	keyName, current, err := f.getTransitKey(engine, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transit.Key{
+		Name:   keyName,
+		Type:   current.Type,
+		Config: copyKeyConfig(current.Config),
+	}, nil
+}
+
+func (f *API) DeleteTransitKey(ctx context.Context, engine core.MountPathEntity, key transit.KeyNameEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "DeleteTransitKey"); err != nil {
+		return err
+	}
+
+	keyName, current, err := f.getTransitKey(engine, key)
+	switch {
+	case errors.Is(err, core.ErrDoesNotExist):
+		return nil
+	case err != nil:
+		return err
+	case !current.Config.DeletionAllowed:
+		return core.ErrAPIError.WithDetails("deletion is not allowed for this key")
+	}
+
+	state, err := f.getMountOfType(engine, mount.TypeTransit)
+	if err != nil {
+		return err
+	}
+
+	delete(state.TransitKeys, keyName)
+
+	return nil
+}
+
+func (f *API) RotateTransitKey(ctx context.Context, engine core.MountPathEntity, key transit.KeyNameEntity) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "RotateTransitKey"); err != nil {
+		return err
+	}
+
+	_, current, err := f.getTransitKey(engine, key)
+	if err != nil {
+		return err
+	}
+
+	version, err := newTransitKeyVersion(current.Type)
+	if err != nil {
+		return err
+	}
+
+	current.Versions = append(current.Versions, version)
+
+	return nil
+}
+
+func (f *API) TransitEncrypt(ctx context.Context, engine core.MountPathEntity, key transit.KeyNameEntity, plainText []byte) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "TransitEncrypt"); err != nil {
+		return "", err
+	}
+
+	_, current, err := f.getTransitKey(engine, key)
+	if err != nil {
+		return "", err
+	}
+
+	return current.encrypt(plainText)
+}
+
+func (f *API) TransitDecrypt(ctx context.Context, engine core.MountPathEntity, key transit.KeyNameEntity, cipherText string) ([]byte, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "TransitDecrypt"); err != nil {
+		return nil, err
+	}
+
+	_, current, err := f.getTransitKey(engine, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return current.decrypt(cipherText)
+}
+
+func (f *API) TransitRewrap(ctx context.Context, engine core.MountPathEntity, key transit.KeyNameEntity, cipherText string) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "TransitRewrap"); err != nil {
+		return "", err
+	}
+
+	_, current, err := f.getTransitKey(engine, key)
+	if err != nil {
+		return "", err
+	}
+
+	plainText, err := current.decrypt(cipherText)
+	if err != nil {
+		return "", err
+	}
+
+	return current.encrypt(plainText)
+}
+
+func (f *API) TransitSign(ctx context.Context, engine core.MountPathEntity, key transit.KeyNameEntity, input []byte) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "TransitSign"); err != nil {
+		return "", err
+	}
+
+	_, current, err := f.getTransitKey(engine, key)
+	if err != nil {
+		return "", err
+	}
+
+	versionNumber := len(current.Versions)
+	version := current.Versions[versionNumber-1]
+
+	if version.Signer == nil {
+		return "", core.ErrAPIError.WithDetails(fmt.Sprintf("key type %s does not support signing", current.Type))
+	}
+
+	var signature []byte
+
+	switch signer := version.Signer.(type) {
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(signer, input)
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256(input)
+		signature, err = ecdsa.SignASN1(rand.Reader, signer, digest[:])
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256(input)
+		signature, err = rsa.SignPSS(rand.Reader, signer, crypto.SHA256, digest[:], nil)
+	}
+
+	if err != nil {
+		return "", core.ErrAPIError.WithDetails("failed to sign data").WithCause(err)
+	}
+
+	return formatTransitValue(versionNumber, signature), nil
+}
+
+func (f *API) TransitVerify(ctx context.Context, engine core.MountPathEntity, key transit.KeyNameEntity, input []byte, signature string) (bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "TransitVerify"); err != nil {
+		return false, err
+	}
+
+	_, current, err := f.getTransitKey(engine, key)
+	if err != nil {
+		return false, err
+	}
+
+	version, data, err := current.parse(signature)
+	if err != nil {
+		return false, err
+	}
+
+	if version.Signer == nil {
+		return false, core.ErrAPIError.WithDetails(fmt.Sprintf("key type %s does not support signing", current.Type))
+	}
+
+	switch publicKey := version.Signer.Public().(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(publicKey, input, data), nil
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(input)
+		return ecdsa.VerifyASN1(publicKey, digest[:], data), nil
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(input)
+		return rsa.VerifyPSS(publicKey, crypto.SHA256, digest[:], data, nil) == nil, nil
+	default:
+		return false, core.ErrAPIError.WithDetails(fmt.Sprintf("key type %s does not support signing", current.Type))
+	}
+}
+
+func (f *API) TransitHMAC(ctx context.Context, engine core.MountPathEntity, key transit.KeyNameEntity, input []byte) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err := f.call(ctx, "TransitHMAC"); err != nil {
+		return "", err
+	}
+
+	_, current, err := f.getTransitKey(engine, key)
+	if err != nil {
+		return "", err
+	}
+
+	versionNumber := len(current.Versions)
+	mac := hmac.New(sha256.New, current.Versions[versionNumber-1].HMACKey)
+	mac.Write(input)
+
+	return formatTransitValue(versionNumber, mac.Sum(nil)), nil
+}
+
+func (f *API) getTransitKey(engine core.MountPathEntity, key transit.KeyNameEntity) (string, *transitKeyState, error) {
+	state, err := f.getMountOfType(engine, mount.TypeTransit)
+	if err != nil {
+		return "", nil, err
+	}
+
+	keyName, err := key.GetTransitKeyName()
+	if err != nil {
+		return "", nil, core.ErrAPIError.WithDetails("failed to get transit key name").WithCause(err)
+	}
+
+	current, ok := state.TransitKeys[keyName]
+	if !ok {
+		return "", nil, core.ErrDoesNotExist.WithDetails(fmt.Sprintf("transit key %s does not exist", keyName))
+	}
+
+	return keyName, current, nil
+}
+
+func (k *transitKeyState) encrypt(plainText []byte) (string, error) {
+	versionNumber := len(k.Versions)
+	version := k.Versions[versionNumber-1]
+
+	if signer, ok := version.Signer.(*rsa.PrivateKey); ok {
+		cipherText, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, &signer.PublicKey, plainText, nil)
+		if err != nil {
+			return "", core.ErrAPIError.WithDetails("failed to encrypt plain text").WithCause(err)
+		}
+
+		return formatTransitValue(versionNumber, cipherText), nil
+	}
+
+	aead, err := k.newAEAD(version)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", core.ErrAPIError.WithDetails("failed to generate nonce").WithCause(err)
+	}
+
+	return formatTransitValue(versionNumber, aead.Seal(nonce, nonce, plainText, nil)), nil
+}
+
+func (k *transitKeyState) decrypt(cipherText string) ([]byte, error) {
+	version, data, err := k.parse(cipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	if signer, ok := version.Signer.(*rsa.PrivateKey); ok {
+		plainText, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, signer, data, nil)
+		if err != nil {
+			return nil, core.ErrAPIError.WithDetails("failed to decrypt cipher text").WithCause(err)
+		}
+
+		return plainText, nil
+	}
+
+	aead, err := k.newAEAD(version)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < aead.NonceSize() {
+		return nil, core.ErrAPIError.WithDetails("invalid ciphertext: too short")
+	}
+
+	plainText, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to decrypt cipher text").WithCause(err)
+	}
+
+	return plainText, nil
+}
+
+// parse splits a value of the form vault:v<version>:<base64 data> into the
+// key version it has been created with and the decoded data.
+func (k *transitKeyState) parse(value string) (*transitKeyVersion, []byte, error) {
+	if !strings.HasPrefix(value, transitPrefix) {
+		return nil, nil, core.ErrAPIError.WithDetails("invalid value: no prefix")
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(value, transitPrefix), ":", 2)
+	if len(parts) != 2 {
+		return nil, nil, core.ErrAPIError.WithDetails("invalid value: wrong number of fields")
+	}
+
+	versionNumber, err := strconv.Atoi(parts[0])
+	if err != nil || versionNumber < 1 || versionNumber > len(k.Versions) {
+		return nil, nil, core.ErrAPIError.WithDetails(fmt.Sprintf("invalid key version: %s", parts[0]))
+	}
+
+	if k.Config != nil && versionNumber < k.Config.MinimumDecryptionVersion {
+		return nil, nil, core.ErrAPIError.WithDetails("key version is too old (disallowed by policy)")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, nil, core.ErrAPIError.WithDetails("invalid value: failed to decode base64").WithCause(err)
+	}
+
+	return k.Versions[versionNumber-1], data, nil
+}
+
+func (k *transitKeyState) newAEAD(version *transitKeyVersion) (cipher.AEAD, error) {
+	switch k.Type {
+	case transit.TypeAes128Gcm96, transit.TypeAes256Gcm96:
+		block, err := aes.NewCipher(version.Key)
+		if err != nil {
+			return nil, core.ErrAPIError.WithDetails("failed to create cipher").WithCause(err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, core.ErrAPIError.WithDetails("failed to create cipher").WithCause(err)
+		}
+
+		return aead, nil
+	case transit.TypeChacha20Poly1305:
+		aead, err := chacha20poly1305.New(version.Key)
+		if err != nil {
+			return nil, core.ErrAPIError.WithDetails("failed to create cipher").WithCause(err)
+		}
+
+		return aead, nil
+	default:
+		return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("key type %s does not support encryption", k.Type))
+	}
+}
+
+func newTransitKeyVersion(keyType transit.KeyType) (*transitKeyVersion, error) {
+	version := &transitKeyVersion{
+		HMACKey: make([]byte, transitKeyBytes),
+	}
+
+	if _, err := rand.Read(version.HMACKey); err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to generate hmac key").WithCause(err)
+	}
+
+	var err error
+
+	switch keyType {
+	case transit.TypeAes128Gcm96:
+		version.Key, err = randomBytes(transitAES128Size)
+	case transit.TypeAes256Gcm96, transit.TypeChacha20Poly1305:
+		version.Key, err = randomBytes(transitKeyBytes)
+	case transit.TypeED25519:
+		_, version.Signer, err = ed25519.GenerateKey(rand.Reader)
+	case transit.TypeEcdsaP256:
+		version.Signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case transit.TypeEcdsaP384:
+		version.Signer, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case transit.TypeEcdsaP521:
+		version.Signer, err = ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case transit.TypeRSA2048:
+		version.Signer, err = rsa.GenerateKey(rand.Reader, 2048)
+	case transit.TypeRSA3072:
+		version.Signer, err = rsa.GenerateKey(rand.Reader, 3072)
+	case transit.TypeRSA4096:
+		version.Signer, err = rsa.GenerateKey(rand.Reader, 4096)
+	default:
+		return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("unsupported transit key type: %s", keyType))
+	}
+
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to generate transit key").WithCause(err)
+	}
+
+	return version, nil
+}
+
+func formatTransitValue(version int, data []byte) string {
+	return fmt.Sprintf("%s%d:%s", transitPrefix, version, base64.StdEncoding.EncodeToString(data))
+}
+
+func copyKeyConfig(config *transit.KeyConfig) *transit.KeyConfig {
+	if config == nil {
+		return &transit.KeyConfig{}
+	}
+
+	result := *config
+
+	return &result
+}
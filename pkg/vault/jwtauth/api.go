@@ -0,0 +1,81 @@
+package jwtauth
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type RoleEntity interface {
+	core.RoleNameEntity
+	core.RolePoliciesEntity
+	GetBoundAudiences() ([]string, error)
+	GetBoundSubject() (string, error)
+	GetUserClaim() (string, error)
+}
+
+type MethodEntity interface {
+	core.MountPathEntity
+	GetMethodConfig() (*Config, error)
+}
+
+type API interface {
+	UpdateJWTAuthMethod(ctx context.Context, method MethodEntity) error
+	UpdateJWTAuthRole(ctx context.Context, method core.MountPathEntity, role RoleEntity) error
+	DeleteJWTAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error
+	LoginWithJWTAuth(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity, jwt string) (*core.AuthResponse, error)
+}
+
+type Role struct {
+	Name           string
+	Policies       []core.PolicyName
+	BoundAudiences []string
+	BoundSubject   string
+	UserClaim      string
+}
+
+func (r *Role) GetRoleName() (string, error) {
+	return r.Name, nil
+}
+
+func (r *Role) GetRolePolicies() ([]core.PolicyName, error) {
+	return r.Policies, nil
+}
+
+func (r *Role) GetBoundAudiences() ([]string, error) {
+	return r.BoundAudiences, nil
+}
+
+func (r *Role) GetBoundSubject() (string, error) {
+	return r.BoundSubject, nil
+}
+
+func (r *Role) GetUserClaim() (string, error) {
+	return r.UserClaim, nil
+}
+
+type Method struct {
+	Path   string
+	Config *Config
+}
+
+func (m *Method) GetMethodConfig() (*Config, error) {
+	return m.Config, nil
+}
+
+func (m *Method) GetMountPath() (string, error) {
+	return m.Path, nil
+}
+
+type jwtAuthAPI struct {
+	Core core.API
+	Auth auth.API
+}
+
+func NewAPI(coreAPI core.API, authAPI auth.API) API {
+	return &jwtAuthAPI{
+		Core: coreAPI,
+		Auth: authAPI,
+	}
+}
@@ -0,0 +1,75 @@
+package jwtauth
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+// AuthProvider logs in using the JWT auth method. The jwt source is read on every
+// login, so projected service account tokens with a custom audience, which are
+// rotated by the kubelet, can be used directly.
+func AuthProvider(method core.MountPathEntity, role core.StringSource, jwt core.StringSource) core.AuthProvider {
+	return &authProvider{
+		Method: method,
+		Role:   role,
+		JWT:    jwt,
+	}
+}
+
+type authProvider struct {
+	Method core.MountPathEntity
+	Role   core.StringSource
+	JWT    core.StringSource
+}
+
+type loginRequest struct {
+	Role string `json:"role"`
+	JWT  string `json:"jwt"`
+}
+
+func (a *authProvider) Authenticate(ctx context.Context, api core.API) (*core.AuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "vault.jwtauth.Authenticate")
+	defer span.End()
+
+	log := api.Log().WithValues("method", "LoginWithJWTAuth")
+
+	path, err := a.Method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	roleName, err := a.Role.FetchStringValue()
+	if err != nil {
+		log.Info("failed to get jwt role name", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to fetch jwt role name").WithCause(err)
+	}
+
+	log = log.WithValues("role", roleName)
+
+	jwt, err := a.JWT.FetchStringValue()
+	if err != nil {
+		log.Info("failed to fetch jwt", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to fetch jwt").WithCause(err)
+	}
+
+	loginPath := filepath.Join("/v1/auth", path, "login")
+	request := &loginRequest{
+		Role: roleName,
+		JWT:  jwt,
+	}
+	response := &core.AuthResponse{}
+
+	if err := api.MakeRequest(ctx, core.MethodPost, loginPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to login using jwt authentication", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to login using jwt authentication").WithCause(err)
+	}
+
+	return response, nil
+}
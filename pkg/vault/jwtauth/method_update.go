@@ -0,0 +1,81 @@
+package jwtauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"reflect"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/auth"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type Config struct {
+	OIDCDiscoveryURL     string   `json:"oidc_discovery_url,omitempty"`
+	OIDCDiscoveryCAPEM   string   `json:"oidc_discovery_ca_pem,omitempty"`
+	JWKSURL              string   `json:"jwks_url,omitempty"`
+	JWKSCAPEM            string   `json:"jwks_ca_pem,omitempty"`
+	JWTValidationPubKeys []string `json:"jwt_validation_pubkeys,omitempty"`
+	BoundIssuer          string   `json:"bound_issuer,omitempty"`
+}
+
+type readConfigResponse struct {
+	Data *Config `json:"data"`
+}
+
+//nolint:cyclop
+func (j *jwtAuthAPI) UpdateJWTAuthMethod(ctx context.Context, method MethodEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.jwtauth.UpdateJWTAuthMethod")
+	defer span.End()
+
+	log := j.Core.Log().WithValues("method", "UpdateJWTAuthMethod")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	authMethod := &auth.Method{
+		Path: path,
+		Type: auth.MethodJWT,
+	}
+
+	if err := j.Auth.EnsureAuthMethod(ctx, authMethod); err != nil {
+		log.Info("failed to create jwt auth method", "error", err)
+		return core.ErrAPIError.WithDetails("failed to create jwt auth method").WithCause(err)
+	}
+
+	desiredConfig, err := method.GetMethodConfig()
+	if err != nil {
+		log.Info("failed to get jwt auth method config", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get jwt auth method config").WithCause(err)
+	}
+
+	configPath := filepath.Join("/v1/auth", path, "config")
+
+	response := &readConfigResponse{Data: &Config{}}
+	if err := j.Core.MakeRequest(ctx, core.MethodGet, configPath, nil, httpclient.JSON(response)); err != nil {
+		var responseError *core.VaultHTTPError
+		if !errors.As(err, &responseError) || responseError.StatusCode != http.StatusNotFound {
+			log.Info("failed to read jwt auth method config", "error", err)
+			return core.ErrAPIError.WithDetails("failed to read jwt auth method config").WithCause(err)
+		}
+	}
+
+	if reflect.DeepEqual(response.Data, desiredConfig) {
+		return nil
+	}
+
+	if err := j.Core.MakeRequest(ctx, core.MethodPost, configPath, httpclient.JSON(desiredConfig), nil); err != nil {
+		log.Info("failed to configure jwt auth method", "error", err)
+		return core.ErrAPIError.WithDetails("failed to configure jwt auth method").WithCause(err)
+	}
+
+	return nil
+}
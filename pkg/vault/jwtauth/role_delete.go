@@ -0,0 +1,40 @@
+package jwtauth
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (j *jwtAuthAPI) DeleteJWTAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.jwtauth.DeleteJWTAuthRole")
+	defer span.End()
+
+	log := j.Core.Log().WithValues("method", "DeleteJWTAuthRole")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	roleName, err := role.GetRoleName()
+	if err != nil {
+		log.Info("failed to get jwt role name", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get jwt role name").WithCause(err)
+	}
+
+	log = log.WithValues("role", roleName)
+
+	deletePath := filepath.Join("/v1/auth", path, "role", roleName)
+	if err := j.Core.MakeRequest(ctx, core.MethodDelete, deletePath, nil, nil); err != nil {
+		log.Info("failed to delete role", "error", err)
+		return core.ErrAPIError.WithDetails("failed to delete jwt auth role").WithCause(err)
+	}
+
+	return nil
+}
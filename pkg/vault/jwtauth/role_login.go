@@ -0,0 +1,29 @@
+package jwtauth
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (j *jwtAuthAPI) LoginWithJWTAuth(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity, jwt string) (*core.AuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "vault.jwtauth.LoginWithJWTAuth")
+	defer span.End()
+
+	log := j.Core.Log().WithValues("method", "LoginWithJWTAuth")
+
+	roleName, err := role.GetRoleName()
+	if err != nil {
+		log.Info("failed to get role name", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to get jwt role name").WithCause(err)
+	}
+
+	provider := &authProvider{
+		Method: method,
+		Role:   core.Value(roleName),
+		JWT:    core.Value(jwt),
+	}
+
+	return provider.Authenticate(ctx, j.Core)
+}
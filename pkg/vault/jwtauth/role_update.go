@@ -0,0 +1,93 @@
+package jwtauth
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+const roleTypeJWT = "jwt"
+
+type createRoleRequest struct {
+	RoleType       string            `json:"role_type"`
+	BoundAudiences []string          `json:"bound_audiences,omitempty"`
+	BoundSubject   string            `json:"bound_subject,omitempty"`
+	UserClaim      string            `json:"user_claim"`
+	TokenPolicies  []core.PolicyName `json:"token_policies"`
+}
+
+// defaultUserClaim is used if the role doesn't specify a user claim. Projected
+// service account tokens always contain the sub claim.
+const defaultUserClaim = "sub"
+
+func (j *jwtAuthAPI) UpdateJWTAuthRole(ctx context.Context, method core.MountPathEntity, role RoleEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.jwtauth.UpdateJWTAuthRole")
+	defer span.End()
+
+	log := j.Core.Log().WithValues("method", "UpdateJWTAuthRole")
+
+	path, err := method.GetMountPath()
+	if err != nil {
+		log.Info("failed to get mount path", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get auth mount path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	roleName, err := role.GetRoleName()
+	if err != nil {
+		log.Info("failed to get jwt role name", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get jwt role name").WithCause(err)
+	}
+
+	log = log.WithValues("role", roleName)
+
+	rolePolicies, err := role.GetRolePolicies()
+	if err != nil {
+		log.Info("failed to get jwt role policies", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get jwt role policies").WithCause(err)
+	}
+
+	boundAudiences, err := role.GetBoundAudiences()
+	if err != nil {
+		log.Info("failed to get jwt role bound audiences", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get jwt role bound audiences").WithCause(err)
+	}
+
+	boundSubject, err := role.GetBoundSubject()
+	if err != nil {
+		log.Info("failed to get jwt role bound subject", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get jwt role bound subject").WithCause(err)
+	}
+
+	userClaim, err := role.GetUserClaim()
+	if err != nil {
+		log.Info("failed to get jwt role user claim", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get jwt role user claim").WithCause(err)
+	}
+
+	if userClaim == "" {
+		userClaim = defaultUserClaim
+	}
+
+	log = log.WithValues("policies", rolePolicies, "boundAudiences", boundAudiences, "boundSubject", boundSubject)
+
+	rolePath := filepath.Join("/v1/auth", path, "role", roleName)
+	request := &createRoleRequest{
+		RoleType:       roleTypeJWT,
+		BoundAudiences: boundAudiences,
+		BoundSubject:   boundSubject,
+		UserClaim:      userClaim,
+		TokenPolicies:  rolePolicies,
+	}
+
+	if err := j.Core.MakeRequest(ctx, core.MethodPost, rolePath, httpclient.JSON(request), nil); err != nil {
+		log.Info("failed to create jwt auth role", "error", err)
+		return core.ErrAPIError.WithDetails("failed to create jwt auth role").WithCause(err)
+	}
+
+	return nil
+}
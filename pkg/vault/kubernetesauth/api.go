@@ -1,6 +1,8 @@
 package kubernetesauth
 
 import (
+	"context"
+
 	"github.com/youniqx/heist/pkg/vault/auth"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
@@ -18,11 +20,11 @@ type MethodEntity interface {
 }
 
 type API interface {
-	UpdateKubernetesAuthMethod(method MethodEntity) error
-	UpdateKubernetesAuthRole(method core.MountPathEntity, role RoleEntity) error
-	DeleteKubernetesAuthRole(method core.MountPathEntity, role core.RoleNameEntity) error
-	ReadKubernetesAuthRole(method core.MountPathEntity, role core.RoleNameEntity) (*Role, error)
-	LoginWithKubernetesAuth(method core.MountPathEntity, role core.RoleNameEntity, jwt string) (*core.AuthResponse, error)
+	UpdateKubernetesAuthMethod(ctx context.Context, method MethodEntity) error
+	UpdateKubernetesAuthRole(ctx context.Context, method core.MountPathEntity, role RoleEntity) error
+	DeleteKubernetesAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error
+	ReadKubernetesAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (*Role, error)
+	LoginWithKubernetesAuth(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity, jwt string) (*core.AuthResponse, error)
 }
 
 type Role struct {
@@ -1,9 +1,11 @@
 package kubernetesauth
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -21,7 +23,10 @@ type authProvider struct {
 	JWT    core.StringSource
 }
 
-func (a *authProvider) Authenticate(api core.API) (*core.AuthResponse, error) {
+func (a *authProvider) Authenticate(ctx context.Context, api core.API) (*core.AuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "vault.kubernetesauth.Authenticate")
+	defer span.End()
+
 	log := api.Log().WithValues("method", "LoginWithKubernetesAuth")
 
 	path, err := a.Method.GetMountPath()
@@ -53,7 +58,7 @@ func (a *authProvider) Authenticate(api core.API) (*core.AuthResponse, error) {
 	}
 	response := &core.AuthResponse{}
 
-	if err := api.MakeRequest(core.MethodPost, loginPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := api.MakeRequest(ctx, core.MethodPost, loginPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to login using kubernetes authentication", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to login using kubernetes authentication").WithCause(err)
 	}
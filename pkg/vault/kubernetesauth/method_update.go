@@ -1,12 +1,14 @@
 package kubernetesauth
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 	"reflect"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/auth"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
@@ -22,7 +24,10 @@ type Config struct {
 }
 
 //nolint:cyclop
-func (k *kubernetesAuthAPI) UpdateKubernetesAuthMethod(method MethodEntity) error {
+func (k *kubernetesAuthAPI) UpdateKubernetesAuthMethod(ctx context.Context, method MethodEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.kubernetesauth.UpdateKubernetesAuthMethod")
+	defer span.End()
+
 	log := k.Core.Log().WithValues("method", "UpdateKubernetesAuthMethod")
 
 	path, err := method.GetMountPath()
@@ -33,7 +38,7 @@ func (k *kubernetesAuthAPI) UpdateKubernetesAuthMethod(method MethodEntity) erro
 
 	log = log.WithValues("path", path)
 
-	exists, err := k.Auth.HasAuthMethod(method)
+	exists, err := k.Auth.HasAuthMethod(ctx, method)
 	if err != nil {
 		log.Info("failed to check if k8s auth method exists", "error", err)
 		return core.ErrAPIError.WithDetails("failed to check if k8s auth method exists").WithCause(err)
@@ -45,7 +50,7 @@ func (k *kubernetesAuthAPI) UpdateKubernetesAuthMethod(method MethodEntity) erro
 			Type: auth.MethodKubernetes,
 		}
 
-		if err := k.Auth.CreateAuthMethod(authMethod); err != nil {
+		if err := k.Auth.CreateAuthMethod(ctx, authMethod); err != nil {
 			log.Info("failed to create k8s auth method", "error", err)
 			return core.ErrAPIError.WithDetails("failed to create k8s auth method").WithCause(err)
 		}
@@ -60,7 +65,7 @@ func (k *kubernetesAuthAPI) UpdateKubernetesAuthMethod(method MethodEntity) erro
 	configPath := filepath.Join("/v1/auth", path, "config")
 
 	actualConfig := &Config{}
-	if err := k.Core.MakeRequest(core.MethodGet, configPath, nil, httpclient.JSON(actualConfig)); err != nil {
+	if err := k.Core.MakeRequest(ctx, core.MethodGet, configPath, nil, httpclient.JSON(actualConfig)); err != nil {
 		var responseError *core.VaultHTTPError
 		if !errors.As(err, &responseError) || responseError.StatusCode != http.StatusNotFound {
 			log.Info("unable to create auth method", "error", err)
@@ -72,7 +77,7 @@ func (k *kubernetesAuthAPI) UpdateKubernetesAuthMethod(method MethodEntity) erro
 		return nil
 	}
 
-	if err := k.Core.MakeRequest(core.MethodPost, configPath, httpclient.JSON(desiredConfig), nil); err != nil {
+	if err := k.Core.MakeRequest(ctx, core.MethodPost, configPath, httpclient.JSON(desiredConfig), nil); err != nil {
 		log.Info("unable to create auth method", "error", err)
 		return core.ErrAPIError.WithDetails("failed to create auth method").WithCause(err)
 	}
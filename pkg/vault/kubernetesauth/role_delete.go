@@ -1,12 +1,17 @@
 package kubernetesauth
 
 import (
+	"context"
 	"path/filepath"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (k *kubernetesAuthAPI) DeleteKubernetesAuthRole(method core.MountPathEntity, role core.RoleNameEntity) error {
+func (k *kubernetesAuthAPI) DeleteKubernetesAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.kubernetesauth.DeleteKubernetesAuthRole")
+	defer span.End()
+
 	log := k.Core.Log().WithValues("method", "DeleteKubernetesAuthRole")
 
 	path, err := method.GetMountPath()
@@ -26,7 +31,7 @@ func (k *kubernetesAuthAPI) DeleteKubernetesAuthRole(method core.MountPathEntity
 	log = log.WithValues("role", roleName)
 
 	deletePath := filepath.Join("/v1/auth", path, "role", roleName)
-	if err := k.Core.MakeRequest(core.MethodDelete, deletePath, nil, nil); err != nil {
+	if err := k.Core.MakeRequest(ctx, core.MethodDelete, deletePath, nil, nil); err != nil {
 		log.Info("failed to delete role", "error", err)
 		return core.ErrAPIError.WithDetails("failed to delete k8s auth role").WithCause(err)
 	}
@@ -1,6 +1,9 @@
 package kubernetesauth
 
 import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -9,7 +12,10 @@ type loginRequest struct {
 	JWT  string `json:"jwt"`
 }
 
-func (k *kubernetesAuthAPI) LoginWithKubernetesAuth(method core.MountPathEntity, role core.RoleNameEntity, jwt string) (*core.AuthResponse, error) {
+func (k *kubernetesAuthAPI) LoginWithKubernetesAuth(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity, jwt string) (*core.AuthResponse, error) {
+	ctx, span := tracing.Start(ctx, "vault.kubernetesauth.LoginWithKubernetesAuth")
+	defer span.End()
+
 	log := k.Core.Log().WithValues("method", "LoginWithKubernetesAuth")
 
 	roleName, err := role.GetRoleName()
@@ -24,5 +30,5 @@ func (k *kubernetesAuthAPI) LoginWithKubernetesAuth(method core.MountPathEntity,
 		JWT:    core.Value(jwt),
 	}
 
-	return provider.Authenticate(k.Core)
+	return provider.Authenticate(ctx, k.Core)
 }
@@ -1,11 +1,13 @@
 package kubernetesauth
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -19,7 +21,10 @@ type fetchRoleResponseData struct {
 	Policies                      []core.PolicyName `json:"policies"`
 }
 
-func (k *kubernetesAuthAPI) ReadKubernetesAuthRole(method core.MountPathEntity, role core.RoleNameEntity) (*Role, error) {
+func (k *kubernetesAuthAPI) ReadKubernetesAuthRole(ctx context.Context, method core.MountPathEntity, role core.RoleNameEntity) (*Role, error) {
+	ctx, span := tracing.Start(ctx, "vault.kubernetesauth.ReadKubernetesAuthRole")
+	defer span.End()
+
 	log := k.Core.Log().WithValues("method", "ReadKubernetesAuthRole")
 
 	path, err := method.GetMountPath()
@@ -41,7 +46,7 @@ func (k *kubernetesAuthAPI) ReadKubernetesAuthRole(method core.MountPathEntity,
 	fetchPath := filepath.Join("/v1/auth", path, "role", roleName)
 	response := &fetchRoleResponse{}
 
-	if err := k.Core.MakeRequest(core.MethodGet, fetchPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := k.Core.MakeRequest(ctx, core.MethodGet, fetchPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to fetch k8s auth role", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -1,11 +1,13 @@
 package kubernetesauth
 
 import (
+	"context"
 	"errors"
 	"path/filepath"
 	"reflect"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -16,7 +18,10 @@ type createRoleRequest struct {
 }
 
 //nolint:cyclop
-func (k *kubernetesAuthAPI) UpdateKubernetesAuthRole(method core.MountPathEntity, role RoleEntity) error {
+func (k *kubernetesAuthAPI) UpdateKubernetesAuthRole(ctx context.Context, method core.MountPathEntity, role RoleEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.kubernetesauth.UpdateKubernetesAuthRole")
+	defer span.End()
+
 	log := k.Core.Log().WithValues("method", "UpdateKubernetesAuthRole")
 
 	path, err := method.GetMountPath()
@@ -68,7 +73,7 @@ func (k *kubernetesAuthAPI) UpdateKubernetesAuthRole(method core.MountPathEntity
 
 	var updateRequired bool
 
-	switch currentRole, err := k.ReadKubernetesAuthRole(method, role); {
+	switch currentRole, err := k.ReadKubernetesAuthRole(ctx, method, role); {
 	case errors.Is(err, core.ErrDoesNotExist):
 		updateRequired = true
 	case err == nil:
@@ -89,7 +94,7 @@ func (k *kubernetesAuthAPI) UpdateKubernetesAuthRole(method core.MountPathEntity
 		Policies:                      rolePolicies,
 	}
 
-	if err := k.Core.MakeRequest(core.MethodPost, rolePath, httpclient.JSON(request), nil); err != nil {
+	if err := k.Core.MakeRequest(ctx, core.MethodPost, rolePath, httpclient.JSON(request), nil); err != nil {
 		log.Info("failed to create k8s auth role", "error", err)
 		return core.ErrAPIError.WithDetails("failed to create k8s auth role").WithCause(err)
 	}
@@ -1,6 +1,8 @@
 package kvengine
 
 import (
+	"context"
+
 	"github.com/youniqx/heist/pkg/vault/core"
 	"github.com/youniqx/heist/pkg/vault/mount"
 )
@@ -18,11 +20,22 @@ func NewAPI(coreAPI core.API, mountAPI mount.API) API {
 }
 
 type API interface {
-	UpdateKvEngine(engine Entity) error
-	ListKvSecrets(engine core.MountPathEntity) ([]core.SecretPath, error)
-	ReadKvEngine(engine core.MountPathEntity) (*KvEngine, error)
+	UpdateKvEngine(ctx context.Context, engine Entity) error
+	ListKvSecrets(ctx context.Context, engine core.MountPathEntity) ([]core.SecretPath, error)
+	ReadKvEngine(ctx context.Context, engine core.MountPathEntity) (*KvEngine, error)
+	ReadKvEngineVersion(ctx context.Context, engine core.MountPathEntity) (Version, error)
 }
 
+// Version is the version of a KV secret engine.
+type Version string
+
+const (
+	// VersionV1 is the unversioned KV secret engine (kv).
+	VersionV1 Version = "v1"
+	// VersionV2 is the versioned KV secret engine (kv-v2).
+	VersionV2 Version = "v2"
+)
+
 type Config struct {
 	MaxVersions        int    `json:"max_versions"`
 	CasRequired        bool   `json:"cas_required"`
@@ -30,19 +43,35 @@ type Config struct {
 }
 
 type Entity interface {
-	core.MountPathEntity
+	VersionedEntity
 	GetKvEngineConfig() (*Config, error)
 }
 
+// VersionedEntity is implemented by engines which know which version of the
+// KV secret engine they are. Engines that don't implement it are looked up in Vault.
+type VersionedEntity interface {
+	core.MountPathEntity
+	GetKvEngineVersion() (Version, error)
+}
+
 type KvEngine struct {
-	Path   string
-	Config *Config
+	Path    string
+	Version Version
+	Config  *Config
 }
 
 func (k *KvEngine) GetMountPath() (string, error) {
 	return k.Path, nil
 }
 
+func (k *KvEngine) GetKvEngineVersion() (Version, error) {
+	if k.Version == "" {
+		return VersionV2, nil
+	}
+
+	return k.Version, nil
+}
+
 func (k *KvEngine) GetKvEngineConfig() (*Config, error) {
 	return k.Config, nil
 }
@@ -1,6 +1,7 @@
 package kvengine
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
@@ -10,7 +11,7 @@ import (
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (a *engineAPI) updateKvSecretEngineConfig(engine Entity) error {
+func (a *engineAPI) updateKvSecretEngineConfig(ctx context.Context, engine Entity) error {
 	log := a.Core.Log().WithValues("method", "updateKvSecretEngineConfig")
 
 	path, err := engine.GetMountPath()
@@ -23,7 +24,7 @@ func (a *engineAPI) updateKvSecretEngineConfig(engine Entity) error {
 
 	log.Info("checking if kv engine config needs updates")
 
-	currentConfig, err := a.fetchKvSecretEngineConfig(engine)
+	currentConfig, err := a.fetchKvSecretEngineConfig(ctx, engine)
 	if err != nil {
 		log.Info("failed to fetch engine config", "error", err)
 		return core.ErrAPIError.WithDetails("failed to fetch kv engine config").WithCause(err)
@@ -42,7 +43,7 @@ func (a *engineAPI) updateKvSecretEngineConfig(engine Entity) error {
 
 	log.Info("kv engine needs updates, writing desired config to vault", "currentConfig", currentConfig, "desiredConfig", desiredConfig)
 
-	if err := a.writeKvSecretEngineConfig(engine, desiredConfig); err != nil {
+	if err := a.writeKvSecretEngineConfig(ctx, engine, desiredConfig); err != nil {
 		log.Info("failed to update kv engine config", "error", err)
 		return core.ErrAPIError.WithDetails("failed to get write engine config").WithCause(err)
 	}
@@ -54,7 +55,7 @@ type kvEngineConfigResponse struct {
 	Data *Config `json:"data"`
 }
 
-func (a *engineAPI) fetchKvSecretEngineConfig(engine core.MountPathEntity) (*Config, error) {
+func (a *engineAPI) fetchKvSecretEngineConfig(ctx context.Context, engine core.MountPathEntity) (*Config, error) {
 	log := a.Core.Log().WithValues("method", "fetchKvSecretEngineConfig")
 
 	path, err := engine.GetMountPath()
@@ -68,7 +69,7 @@ func (a *engineAPI) fetchKvSecretEngineConfig(engine core.MountPathEntity) (*Con
 	configPath := filepath.Join("/v1", path, "config")
 
 	response := &kvEngineConfigResponse{}
-	if err := a.Core.MakeRequest(core.MethodGet, configPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodGet, configPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to fetch kv engine config", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -82,7 +83,7 @@ func (a *engineAPI) fetchKvSecretEngineConfig(engine core.MountPathEntity) (*Con
 	return response.Data, nil
 }
 
-func (a *engineAPI) writeKvSecretEngineConfig(engine Entity, config *Config) error {
+func (a *engineAPI) writeKvSecretEngineConfig(ctx context.Context, engine Entity, config *Config) error {
 	log := a.Core.Log().WithValues("method", "writeKvSecretEngineConfig")
 
 	path, err := engine.GetMountPath()
@@ -94,7 +95,7 @@ func (a *engineAPI) writeKvSecretEngineConfig(engine Entity, config *Config) err
 	log = log.WithValues("path", path)
 
 	configPath := filepath.Join("/v1", path, "config")
-	if err := a.Core.MakeRequest(core.MethodPost, configPath, httpclient.JSON(config), nil); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodPost, configPath, httpclient.JSON(config), nil); err != nil {
 		log.Info("couldn't write kv engine config", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -1,12 +1,14 @@
 package kvengine
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 	"strings"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -18,7 +20,10 @@ type listSecretData struct {
 	Keys []string `json:"keys"`
 }
 
-func (a *engineAPI) ListKvSecrets(engine core.MountPathEntity) ([]core.SecretPath, error) {
+func (a *engineAPI) ListKvSecrets(ctx context.Context, engine core.MountPathEntity) ([]core.SecretPath, error) {
+	ctx, span := tracing.Start(ctx, "vault.kvengine.ListKvSecrets")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "ListKvSecrets")
 
 	path, err := engine.GetMountPath()
@@ -27,16 +32,33 @@ func (a *engineAPI) ListKvSecrets(engine core.MountPathEntity) ([]core.SecretPat
 		return nil, core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
 	}
 
-	return a.fetchSecretsRecursively(path, "")
+	version, err := a.ReadKvEngineVersion(ctx, engine)
+	if err != nil {
+		log.Info("failed to read engine version", "error", err)
+
+		if errors.Is(err, core.ErrDoesNotExist) {
+			return nil, nil
+		}
+
+		return nil, core.ErrAPIError.WithDetails("failed to read engine version").WithCause(err)
+	}
+
+	return a.fetchSecretsRecursively(ctx, path, version, "")
 }
 
-func (a *engineAPI) fetchSecretsRecursively(mountPath string, relativePath string) ([]core.SecretPath, error) {
+func (a *engineAPI) fetchSecretsRecursively(ctx context.Context, mountPath string, version Version, relativePath string) ([]core.SecretPath, error) {
 	log := a.Core.Log().WithValues("method", "fetchSecretsRecursively", "mountPath", mountPath, "relativePath", relativePath)
 
-	requestPath := filepath.Join("/v1", mountPath, "metadata", relativePath)
+	var requestPath string
+	if version == VersionV1 {
+		requestPath = filepath.Join("/v1", mountPath, relativePath)
+	} else {
+		requestPath = filepath.Join("/v1", mountPath, "metadata", relativePath)
+	}
+
 	response := &listSecretsResponse{}
 
-	if err := a.Core.MakeRequest(core.MethodList, requestPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodList, requestPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to list secrets in kv engine", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -51,7 +73,7 @@ func (a *engineAPI) fetchSecretsRecursively(mountPath string, relativePath strin
 
 	for _, key := range response.Data.Keys {
 		if strings.HasSuffix(key, "/") {
-			nestedSecrets, err := a.fetchSecretsRecursively(mountPath, filepath.Join(relativePath, key))
+			nestedSecrets, err := a.fetchSecretsRecursively(ctx, mountPath, version, filepath.Join(relativePath, key))
 			if err != nil {
 				log.Info("failed to list secrets in kv engine recursively", "error", err)
 				return nil, core.ErrAPIError.WithDetails("failed to list secrets in kv engine recursively").WithCause(err)
@@ -1,10 +1,16 @@
 package kvengine
 
 import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (a *engineAPI) ReadKvEngine(engine core.MountPathEntity) (*KvEngine, error) {
+func (a *engineAPI) ReadKvEngine(ctx context.Context, engine core.MountPathEntity) (*KvEngine, error) {
+	ctx, span := tracing.Start(ctx, "vault.kvengine.ReadKvEngine")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "ReadKvEngine")
 
 	path, err := engine.GetMountPath()
@@ -15,14 +21,29 @@ func (a *engineAPI) ReadKvEngine(engine core.MountPathEntity) (*KvEngine, error)
 
 	log = log.WithValues("path", path)
 
-	config, err := a.fetchKvSecretEngineConfig(engine)
+	version, err := a.ReadKvEngineVersion(ctx, engine)
+	if err != nil {
+		log.Info("failed to read engine version", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to read engine version").WithCause(err)
+	}
+
+	// KV v1 engines don't have a config endpoint
+	if version == VersionV1 {
+		return &KvEngine{
+			Path:    path,
+			Version: version,
+		}, nil
+	}
+
+	config, err := a.fetchKvSecretEngineConfig(ctx, engine)
 	if err != nil {
 		log.Info("failed to fetch engine config", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to fetch engine config").WithCause(err)
 	}
 
 	return &KvEngine{
-		Path:   path,
-		Config: config,
+		Path:    path,
+		Version: version,
+		Config:  config,
 	}, nil
 }
@@ -0,0 +1,68 @@
+package kvengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/mount"
+)
+
+type mountPreflightResponse struct {
+	Data mountPreflightData `json:"data"`
+}
+
+type mountPreflightData struct {
+	Type    mount.Type        `json:"type"`
+	Options map[string]string `json:"options"`
+}
+
+// ReadKvEngineVersion uses the same preflight endpoint as the vault CLI, since
+// unlike sys/mounts it is accessible to any token which has access to the engine.
+func (a *engineAPI) ReadKvEngineVersion(ctx context.Context, engine core.MountPathEntity) (Version, error) {
+	ctx, span := tracing.Start(ctx, "vault.kvengine.ReadKvEngineVersion")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "ReadKvEngineVersion")
+
+	path, err := engine.GetMountPath()
+	if err != nil {
+		log.Info("failed to get engine path", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	requestPath := filepath.Join("/v1/sys/internal/ui/mounts", path)
+	response := &mountPreflightResponse{}
+
+	if err := a.Core.MakeRequest(ctx, core.MethodGet, requestPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to read engine mount", "error", err)
+
+		// Vault responds with permission denied for paths that aren't mounted,
+		// so mounts can't be enumerated using this endpoint.
+		var responseError *core.VaultHTTPError
+		if errors.As(err, &responseError) && (responseError.StatusCode == http.StatusNotFound || responseError.StatusCode == http.StatusForbidden) {
+			return "", core.ErrDoesNotExist.WithCause(err)
+		}
+
+		return "", core.ErrAPIError.WithDetails("failed to read engine mount").WithCause(err)
+	}
+
+	switch {
+	case response.Data.Type == mount.TypeKVV1 && response.Data.Options["version"] == "2":
+		return VersionV2, nil
+	case response.Data.Type == mount.TypeKVV1:
+		return VersionV1, nil
+	case response.Data.Type == mount.TypeKVV2:
+		return VersionV2, nil
+	default:
+		log.Info("engine is not a kv engine", "type", response.Data.Type)
+		return "", core.ErrAPIError.WithDetails(fmt.Sprintf("engine at %s is not a kv engine but of type %s", path, response.Data.Type))
+	}
+}
@@ -1,11 +1,18 @@
 package kvengine
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 	"github.com/youniqx/heist/pkg/vault/mount"
 )
 
-func (a *engineAPI) UpdateKvEngine(engine Entity) error {
+func (a *engineAPI) UpdateKvEngine(ctx context.Context, engine Entity) error {
+	ctx, span := tracing.Start(ctx, "vault.kvengine.UpdateKvEngine")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "UpdateKvEngine")
 
 	path, err := engine.GetMountPath()
@@ -14,9 +21,15 @@ func (a *engineAPI) UpdateKvEngine(engine Entity) error {
 		return core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
 	}
 
-	log = log.WithValues("path", path)
+	version, err := engine.GetKvEngineVersion()
+	if err != nil {
+		log.Info("failed to get engine version", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get engine version").WithCause(err)
+	}
 
-	exists, err := a.Mount.HasEngine(engine)
+	log = log.WithValues("path", path, "version", version)
+
+	exists, err := a.Mount.HasEngine(ctx, engine)
 	if err != nil {
 		log.Info("failed to check if engine exists", "error", err)
 		return core.ErrAPIError.WithDetails("failed to check if engine exists").WithCause(err)
@@ -24,27 +37,64 @@ func (a *engineAPI) UpdateKvEngine(engine Entity) error {
 
 	log = log.WithValues("exists", exists)
 
-	if !exists {
-		mountRequest := &mount.Mount{
-			Path: path,
-			Type: mount.TypeKVV2,
-			Options: map[string]string{
-				"version": "2",
-			},
+	if exists {
+		currentVersion, err := a.ReadKvEngineVersion(ctx, engine)
+		if err != nil {
+			log.Info("failed to read current engine version", "error", err)
+			return core.ErrAPIError.WithDetails("failed to read current engine version").WithCause(err)
+		}
+
+		if currentVersion != version {
+			log.Info("engine version cannot be changed", "currentVersion", currentVersion)
+			return core.ErrAPIError.WithDetails(fmt.Sprintf("kv engine is already mounted as %s, cannot change it to %s", currentVersion, version))
+		}
+	} else {
+		mountRequest, err := getMountRequest(path, version)
+		if err != nil {
+			log.Info("failed to create mount request", "error", err)
+			return err
 		}
 
 		log.Info("creating new kv engine")
 
-		if err := a.Mount.MountEngine(mountRequest); err != nil {
+		if err := a.Mount.MountEngine(ctx, mountRequest); err != nil {
 			log.Info("failed to create kv engine", "error", err)
 			return core.ErrAPIError.WithDetails("failed to create kv engine").WithCause(err)
 		}
 	}
 
-	if err := a.updateKvSecretEngineConfig(engine); err != nil {
+	// KV v1 engines don't have a config endpoint
+	if version == VersionV1 {
+		return nil
+	}
+
+	if err := a.updateKvSecretEngineConfig(ctx, engine); err != nil {
 		log.Info("failed to update kv engine config", "error", err)
 		return core.ErrAPIError.WithDetails("failed to update kv engine config").WithCause(err)
 	}
 
 	return nil
 }
+
+func getMountRequest(path string, version Version) (*mount.Mount, error) {
+	switch version {
+	case VersionV1:
+		return &mount.Mount{
+			Path: path,
+			Type: mount.TypeKVV1,
+			Options: map[string]string{
+				"version": "1",
+			},
+		}, nil
+	case VersionV2:
+		return &mount.Mount{
+			Path: path,
+			Type: mount.TypeKVV2,
+			Options: map[string]string{
+				"version": "2",
+			},
+		}, nil
+	default:
+		return nil, core.ErrAPIError.WithDetails(fmt.Sprintf("unsupported kv engine version: %s", version))
+	}
+}
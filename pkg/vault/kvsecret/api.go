@@ -1,21 +1,44 @@
 package kvsecret
 
 import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/erx"
 	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
 )
 
+// ErrCASConflict is returned when a secret has been modified in Vault since it was last written.
+var ErrCASConflict = core.ErrCASConflict
+
+// AnyVersion can be passed to UpdateKvSecretCAS to overwrite the secret regardless of its current version.
+const AnyVersion = -1
+
+// ErrNotVersioned is returned when a version specific operation is performed on a KV v1 engine.
+var ErrNotVersioned = erx.New("Vault API", "kv v1 engines do not support versioning")
+
 type api struct {
-	Core core.API
+	Core   core.API
+	Engine kvengine.API
 }
 
-func NewAPI(core core.API) API {
-	return &api{Core: core}
+func NewAPI(coreAPI core.API, engineAPI kvengine.API) API {
+	return &api{
+		Core:   coreAPI,
+		Engine: engineAPI,
+	}
 }
 
 type API interface {
-	UpdateKvSecret(engine core.MountPathEntity, secret Entity) error
-	DeleteKvSecret(engine core.MountPathEntity, secret core.SecretPathEntity) error
-	ReadKvSecret(engine core.MountPathEntity, secret core.SecretPathEntity) (*KvSecret, error)
+	UpdateKvSecret(ctx context.Context, engine core.MountPathEntity, secret Entity) error
+	UpdateKvSecretCAS(ctx context.Context, engine core.MountPathEntity, secret Entity, expectedVersion int) (int, error)
+	DeleteKvSecret(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity) error
+	ReadKvSecret(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity) (*KvSecret, error)
+	ReadKvSecretVersion(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, version int) (*KvSecret, error)
+	ListKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity) (*Metadata, error)
+	DeleteKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, versions []int) error
+	UndeleteKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, versions []int) error
+	DestroyKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, versions []int) error
 }
 
 type Entity interface {
@@ -35,3 +58,35 @@ func (k *KvSecret) GetSecretPath() (string, error) {
 func (k *KvSecret) GetFields() (map[string]string, error) {
 	return k.Fields, nil
 }
+
+// Metadata is the version history of a secret stored in a KV v2 engine.
+type Metadata struct {
+	CurrentVersion int
+	OldestVersion  int
+	// Versions is sorted by version number in ascending order.
+	Versions []*VersionMetadata
+}
+
+// VersionMetadata describes a single version of a secret.
+type VersionMetadata struct {
+	Version      int
+	CreatedTime  string
+	DeletionTime string
+	Destroyed    bool
+}
+
+// IsAvailable returns true if the version has neither been deleted nor destroyed.
+func (v *VersionMetadata) IsAvailable() bool {
+	return v.DeletionTime == "" && !v.Destroyed
+}
+
+// GetVersion returns the metadata of the given version or nil if there is no such version.
+func (m *Metadata) GetVersion(version int) *VersionMetadata {
+	for _, info := range m.Versions {
+		if info.Version == version {
+			return info
+		}
+	}
+
+	return nil
+}
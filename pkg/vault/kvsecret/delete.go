@@ -1,23 +1,38 @@
 package kvsecret
 
 import (
+	"context"
 	"errors"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (a *api) DeleteKvSecret(engine core.MountPathEntity, secret core.SecretPathEntity) error {
+func (a *api) DeleteKvSecret(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.kvsecret.DeleteKvSecret")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "DeleteKvSecret")
 
-	path, err := getSecretMetadataPath(engine, secret)
+	version, err := a.getEngineVersion(ctx, engine)
+	if errors.Is(err, core.ErrDoesNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		log.Info("failed to get engine version", "error", err)
+		return core.ErrAPIError.WithDetails("failed to get engine version").WithCause(err)
+	}
+
+	path, err := getSecretMetadataPath(version, engine, secret)
 	if err != nil {
 		log.Info("failed to get secret metadata path", "error", err)
 		return err
 	}
 
-	log = log.WithValues("path", path)
+	log = log.WithValues("path", path, "version", version)
 
-	switch err := a.deleteKvSecret(path); {
+	switch err := a.deleteKvSecret(ctx, path); {
 	case errors.Is(err, core.ErrDoesNotExist):
 		return nil
 	case err == nil:
@@ -1,12 +1,14 @@
 package kvsecret
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
 	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
 )
 
 type setKvSecretOptions struct {
@@ -39,11 +41,28 @@ type getKvSecretResponse struct {
 	Data      data   `json:"data"`
 }
 
-func (a *api) fetchKvSecret(path string) (*getKvSecretResponse, error) {
+type getKvSecretV1Response struct {
+	RequestID string            `json:"request_id"`
+	Data      map[string]string `json:"data"`
+}
+
+func (a *api) getEngineVersion(ctx context.Context, engine core.MountPathEntity) (kvengine.Version, error) {
+	if versioned, ok := engine.(kvengine.VersionedEntity); ok {
+		return versioned.GetKvEngineVersion()
+	}
+
+	return a.Engine.ReadKvEngineVersion(ctx, engine)
+}
+
+func (a *api) fetchKvSecret(ctx context.Context, version kvengine.Version, path string) (*getKvSecretResponse, error) {
 	log := a.Core.Log().WithValues("method", "fetchKvSecret", "path", path)
 
+	if version == kvengine.VersionV1 {
+		return a.fetchKvSecretV1(ctx, path)
+	}
+
 	response := &getKvSecretResponse{}
-	if err := a.Core.MakeRequest(core.MethodGet, path, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodGet, path, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("couldn't fetch secret data", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -57,10 +76,33 @@ func (a *api) fetchKvSecret(path string) (*getKvSecretResponse, error) {
 	return response, nil
 }
 
-func (a *api) deleteKvSecret(path string) error {
+func (a *api) fetchKvSecretV1(ctx context.Context, path string) (*getKvSecretResponse, error) {
+	log := a.Core.Log().WithValues("method", "fetchKvSecretV1", "path", path)
+
+	response := &getKvSecretV1Response{}
+	if err := a.Core.MakeRequest(ctx, core.MethodGet, path, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("couldn't fetch secret data", "error", err)
+
+		var responseError *core.VaultHTTPError
+		if errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound {
+			return nil, core.ErrDoesNotExist.WithCause(err)
+		}
+
+		return nil, core.ErrAPIError.WithDetails("failed to fetch kv secret").WithCause(err)
+	}
+
+	return &getKvSecretResponse{
+		RequestID: response.RequestID,
+		Data: data{
+			Data: response.Data,
+		},
+	}, nil
+}
+
+func (a *api) deleteKvSecret(ctx context.Context, path string) error {
 	log := a.Core.Log().WithValues("method", "deleteKvSecret", "path", path)
 
-	if err := a.Core.MakeRequest(core.MethodDelete, path, nil, nil); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodDelete, path, nil, nil); err != nil {
 		log.Info("could not delete secret", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -74,16 +116,20 @@ func (a *api) deleteKvSecret(path string) error {
 	return nil
 }
 
-func (a *api) writeKvSecret(path string, cas int, fields map[string]string) (*setKvSecretResponse, error) {
+func (a *api) writeKvSecret(ctx context.Context, version kvengine.Version, path string, cas int, fields map[string]string) (*setKvSecretResponse, error) {
 	log := a.Core.Log().WithValues("method", "writeKvSecret", "path", path)
 
+	if version == kvengine.VersionV1 {
+		return a.writeKvSecretV1(ctx, path, fields)
+	}
+
 	request := &setKvSecretRequest{
 		Options: setKvSecretOptions{CAS: cas},
 		Data:    fields,
 	}
 	response := &setKvSecretResponse{}
 
-	if err := a.Core.MakeRequest(core.MethodPost, path, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodPost, path, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("couldn't write secret data", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -91,13 +137,99 @@ func (a *api) writeKvSecret(path string, cas int, fields map[string]string) (*se
 			return nil, core.ErrDoesNotExist.WithCause(err)
 		}
 
+		if errors.Is(err, core.ErrCASConflict) {
+			return nil, ErrCASConflict.WithCause(err)
+		}
+
 		return nil, core.ErrAPIError.WithDetails("failed to write kv secret data").WithCause(err)
 	}
 
 	return response, nil
 }
 
-func getSecretDataPath(engine core.MountPathEntity, secret core.SecretPathEntity) (string, error) {
+func (a *api) writeKvSecretV1(ctx context.Context, path string, fields map[string]string) (*setKvSecretResponse, error) {
+	log := a.Core.Log().WithValues("method", "writeKvSecretV1", "path", path)
+
+	if err := a.Core.MakeRequest(ctx, core.MethodPost, path, httpclient.JSON(fields), nil); err != nil {
+		log.Info("couldn't write secret data", "error", err)
+
+		var responseError *core.VaultHTTPError
+		if errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound {
+			return nil, core.ErrDoesNotExist.WithCause(err)
+		}
+
+		return nil, core.ErrAPIError.WithDetails("failed to write kv secret data").WithCause(err)
+	}
+
+	// KV v1 secrets are not versioned, so there is no metadata to return
+	return &setKvSecretResponse{}, nil
+}
+
+// GetPolicyPath returns the path a policy has to grant access to in order to
+// read or write the secret at secretPath in a kv engine of the given version.
+func GetPolicyPath(version kvengine.Version, enginePath string, secretPath string) string {
+	if version == kvengine.VersionV1 {
+		return filepath.Join(enginePath, secretPath)
+	}
+
+	return filepath.Join(enginePath, "data", secretPath)
+}
+
+func (a *api) ensureVersionedEngine(ctx context.Context, engine core.MountPathEntity) error {
+	version, err := a.getEngineVersion(ctx, engine)
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to get engine version").WithCause(err)
+	}
+
+	if version != kvengine.VersionV2 {
+		return ErrNotVersioned
+	}
+
+	return nil
+}
+
+type versionsRequest struct {
+	Versions []int `json:"versions"`
+}
+
+func (a *api) modifyKvSecretVersions(ctx context.Context, method core.RequestType, path string, versions []int) error {
+	log := a.Core.Log().WithValues("method", "modifyKvSecretVersions", "path", path, "versions", versions)
+
+	request := &versionsRequest{Versions: versions}
+
+	if err := a.Core.MakeRequest(ctx, method, path, httpclient.JSON(request), nil); err != nil {
+		log.Info("couldn't modify secret versions", "error", err)
+
+		var responseError *core.VaultHTTPError
+		if errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound {
+			return core.ErrDoesNotExist.WithCause(err)
+		}
+
+		return core.ErrAPIError.WithDetails("failed to modify kv secret versions").WithCause(err)
+	}
+
+	return nil
+}
+
+// getSecretActionPath returns the path of the KV v2 endpoint for the given action,
+// e.g. undelete or destroy, of a secret.
+func getSecretActionPath(action string, engine core.MountPathEntity, secret core.SecretPathEntity) (string, error) {
+	enginePath, err := engine.GetMountPath()
+	if err != nil {
+		return "", core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
+	}
+
+	secretPath, err := secret.GetSecretPath()
+	if err != nil {
+		return "", core.ErrAPIError.WithDetails("failed to get secret path").WithCause(err)
+	}
+
+	path := filepath.Join("/v1", enginePath, action, secretPath)
+
+	return path, nil
+}
+
+func getSecretDataPath(version kvengine.Version, engine core.MountPathEntity, secret core.SecretPathEntity) (string, error) {
 	enginePath, err := engine.GetMountPath()
 	if err != nil {
 		return "", core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
@@ -108,12 +240,12 @@ func getSecretDataPath(engine core.MountPathEntity, secret core.SecretPathEntity
 		return "", core.ErrAPIError.WithDetails("failed to get secret path").WithCause(err)
 	}
 
-	path := filepath.Join("/v1", enginePath, "data", secretPath)
+	path := filepath.Join("/v1", GetPolicyPath(version, enginePath, secretPath))
 
 	return path, nil
 }
 
-func getSecretMetadataPath(engine core.MountPathEntity, secret core.SecretPathEntity) (string, error) {
+func getSecretMetadataPath(version kvengine.Version, engine core.MountPathEntity, secret core.SecretPathEntity) (string, error) {
 	enginePath, err := engine.GetMountPath()
 	if err != nil {
 		return "", core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
@@ -124,6 +256,11 @@ func getSecretMetadataPath(engine core.MountPathEntity, secret core.SecretPathEn
 		return "", core.ErrAPIError.WithDetails("failed to get secret path").WithCause(err)
 	}
 
+	// KV v1 engines store data and metadata at the same path
+	if version == kvengine.VersionV1 {
+		return filepath.Join("/v1", enginePath, secretPath), nil
+	}
+
 	path := filepath.Join("/v1", enginePath, "metadata", secretPath)
 
 	return path, nil
@@ -1,21 +1,33 @@
 package kvsecret
 
 import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (a *api) ReadKvSecret(engine core.MountPathEntity, secret core.SecretPathEntity) (*KvSecret, error) {
+func (a *api) ReadKvSecret(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity) (*KvSecret, error) {
+	ctx, span := tracing.Start(ctx, "vault.kvsecret.ReadKvSecret")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "ReadKvSecret")
 
-	path, err := getSecretDataPath(engine, secret)
+	version, err := a.getEngineVersion(ctx, engine)
+	if err != nil {
+		log.Info("failed to get engine version", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to get engine version").WithCause(err)
+	}
+
+	path, err := getSecretDataPath(version, engine, secret)
 	if err != nil {
 		log.Info("failed to get secret data path", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to get secret data path").WithCause(err)
 	}
 
-	log = log.WithValues("path", path)
+	log = log.WithValues("path", path, "version", version)
 
-	kvSecret, err := a.fetchKvSecret(path)
+	kvSecret, err := a.fetchKvSecret(ctx, version, path)
 	if err != nil {
 		log.Info("failed to fetch secret data", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to get secret data").WithCause(err)
@@ -1,55 +1,108 @@
 package kvsecret
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"reflect"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
 )
 
-func (a *api) UpdateKvSecret(engine core.MountPathEntity, secret Entity) error {
-	log := a.Core.Log().WithValues("method", "UpdateKvSecret")
+func (a *api) UpdateKvSecret(ctx context.Context, engine core.MountPathEntity, secret Entity) error {
+	ctx, span := tracing.Start(ctx, "vault.kvsecret.UpdateKvSecret")
+	defer span.End()
 
-	path, err := getSecretDataPath(engine, secret)
+	_, err := a.UpdateKvSecretCAS(ctx, engine, secret, AnyVersion)
+	return err
+}
+
+func (a *api) UpdateKvSecretCAS(ctx context.Context, engine core.MountPathEntity, secret Entity, expectedVersion int) (int, error) {
+	ctx, span := tracing.Start(ctx, "vault.kvsecret.UpdateKvSecretCAS")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "UpdateKvSecretCAS", "expectedVersion", expectedVersion)
+
+	version, err := a.getEngineVersion(ctx, engine)
+	if err != nil {
+		log.Info("failed to get engine version", "error", err)
+		return 0, core.ErrAPIError.WithDetails("failed to get engine version").WithCause(err)
+	}
+
+	path, err := getSecretDataPath(version, engine, secret)
 	if err != nil {
 		log.Info("failed to get secret data path", "error", err)
-		return core.ErrAPIError.WithDetails("failed to get secret data path").WithCause(err)
+		return 0, core.ErrAPIError.WithDetails("failed to get secret data path").WithCause(err)
 	}
 
-	log = log.WithValues("path", path)
+	log = log.WithValues("path", path, "version", version)
 
 	expectedFields, err := secret.GetFields()
 	if err != nil {
-		return core.ErrAPIError.WithDetails("failed to get secret fields").WithCause(err)
+		return 0, core.ErrAPIError.WithDetails("failed to get secret fields").WithCause(err)
 	}
 
 	var (
-		cas            int
+		currentVersion int
 		updateRequired bool
 	)
 
-	switch kvSecret, err := a.fetchKvSecret(path); {
+	switch kvSecret, err := a.fetchKvSecret(ctx, version, path); {
 	case errors.Is(err, core.ErrDoesNotExist):
-		cas = 0
+		currentVersion, err = a.getLatestVersion(ctx, version, engine, secret)
+		if err != nil {
+			return 0, core.ErrAPIError.WithDetails("failed to check state of secret in Vault").WithCause(err)
+		}
 		updateRequired = true
 	case err == nil:
-		cas = kvSecret.Data.Metadata.Version
+		currentVersion = kvSecret.Data.Metadata.Version
 		updateRequired = !reflect.DeepEqual(expectedFields, kvSecret.Data.Data)
 	default:
-		return core.ErrAPIError.WithDetails("failed to check state of secret in Vault").WithCause(err)
+		return 0, core.ErrAPIError.WithDetails("failed to check state of secret in Vault").WithCause(err)
 	}
 
 	if !updateRequired {
-		return nil
+		return currentVersion, nil
+	}
+
+	// KV v1 engines are not versioned, so there is nothing to compare against
+	if version == kvengine.VersionV2 && expectedVersion != AnyVersion && expectedVersion != currentVersion {
+		log.Info("secret has been modified outside of Heist", "currentVersion", currentVersion)
+		return currentVersion, ErrCASConflict.WithDetails(fmt.Sprintf("expected version %d of the secret but found version %d", expectedVersion, currentVersion))
 	}
 
-	writeResponse, err := a.writeKvSecret(path, cas, expectedFields)
+	writeResponse, err := a.writeKvSecret(ctx, version, path, currentVersion, expectedFields)
 	if err != nil {
 		log.Info("failed to write secret data", "error", err)
-		return core.ErrAPIError.WithDetails("failed to write secret to Vault").WithCause(err)
+
+		if errors.Is(err, ErrCASConflict) {
+			return currentVersion, err
+		}
+
+		return 0, core.ErrAPIError.WithDetails("failed to write secret to Vault").WithCause(err)
 	}
 
 	log.Info("secret has been updated", "newVersion", writeResponse.Metadata.Version)
 
-	return nil
+	return writeResponse.Metadata.Version, nil
+}
+
+// getLatestVersion returns the latest version of a secret which can't be read,
+// because it either never existed or its latest version has been deleted.
+func (a *api) getLatestVersion(ctx context.Context, version kvengine.Version, engine core.MountPathEntity, secret core.SecretPathEntity) (int, error) {
+	if version != kvengine.VersionV2 {
+		return 0, nil
+	}
+
+	metadata, err := a.ListKvSecretVersions(ctx, engine, secret)
+	switch {
+	case errors.Is(err, core.ErrDoesNotExist):
+		return 0, nil
+	case err != nil:
+		return 0, err
+	default:
+		return metadata.CurrentVersion, nil
+	}
 }
@@ -0,0 +1,47 @@
+package kvsecret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+)
+
+func (a *api) ReadKvSecretVersion(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, version int) (*KvSecret, error) {
+	ctx, span := tracing.Start(ctx, "vault.kvsecret.ReadKvSecretVersion")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "ReadKvSecretVersion", "version", version)
+
+	if err := a.ensureVersionedEngine(ctx, engine); err != nil {
+		log.Info("engine does not support versioning", "error", err)
+		return nil, err
+	}
+
+	path, err := getSecretDataPath(kvengine.VersionV2, engine, secret)
+	if err != nil {
+		log.Info("failed to get secret data path", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to get secret data path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	kvSecret, err := a.fetchKvSecret(ctx, kvengine.VersionV2, fmt.Sprintf("%s?version=%d", path, version))
+	if err != nil {
+		log.Info("failed to fetch secret data", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to get secret data").WithCause(err)
+	}
+
+	secretPath, err := secret.GetSecretPath()
+	if err != nil {
+		log.Info("failed to get secret path", "error", err)
+		return nil, core.ErrAPIError.WithDetails("failed to get secret path").WithCause(err)
+	}
+
+	return &KvSecret{
+		Path:   secretPath,
+		Fields: kvSecret.Data.Data,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package kvsecret
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (a *api) DeleteKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, versions []int) error {
+	ctx, span := tracing.Start(ctx, "vault.kvsecret.DeleteKvSecretVersions")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "DeleteKvSecretVersions", "versions", versions)
+
+	if err := a.ensureVersionedEngine(ctx, engine); err != nil {
+		log.Info("engine does not support versioning", "error", err)
+		return err
+	}
+
+	path, err := getSecretActionPath("delete", engine, secret)
+	if err != nil {
+		log.Info("failed to get secret delete path", "error", err)
+		return err
+	}
+
+	log = log.WithValues("path", path)
+
+	if err := a.modifyKvSecretVersions(ctx, core.MethodPost, path, versions); err != nil {
+		log.Info("failed to soft delete secret versions", "error", err)
+		return core.ErrAPIError.WithDetails("failed to soft delete secret versions").WithCause(err)
+	}
+
+	return nil
+}
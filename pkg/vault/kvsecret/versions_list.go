@@ -0,0 +1,83 @@
+package kvsecret
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+	"github.com/youniqx/heist/pkg/vault/kvengine"
+)
+
+type getKvSecretMetadataResponse struct {
+	Data secretMetadata `json:"data"`
+}
+
+type secretMetadata struct {
+	CurrentVersion int                  `json:"current_version"`
+	OldestVersion  int                  `json:"oldest_version"`
+	Versions       map[string]*metadata `json:"versions"`
+}
+
+func (a *api) ListKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity) (*Metadata, error) {
+	ctx, span := tracing.Start(ctx, "vault.kvsecret.ListKvSecretVersions")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "ListKvSecretVersions")
+
+	if err := a.ensureVersionedEngine(ctx, engine); err != nil {
+		log.Info("engine does not support versioning", "error", err)
+		return nil, err
+	}
+
+	path, err := getSecretMetadataPath(kvengine.VersionV2, engine, secret)
+	if err != nil {
+		log.Info("failed to get secret metadata path", "error", err)
+		return nil, err
+	}
+
+	log = log.WithValues("path", path)
+
+	response := &getKvSecretMetadataResponse{}
+	if err := a.Core.MakeRequest(ctx, core.MethodGet, path, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("couldn't fetch secret metadata", "error", err)
+
+		var responseError *core.VaultHTTPError
+		if errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound {
+			return nil, core.ErrDoesNotExist.WithCause(err)
+		}
+
+		return nil, core.ErrAPIError.WithDetails("failed to fetch kv secret metadata").WithCause(err)
+	}
+
+	result := &Metadata{
+		CurrentVersion: response.Data.CurrentVersion,
+		OldestVersion:  response.Data.OldestVersion,
+		Versions:       make([]*VersionMetadata, 0, len(response.Data.Versions)),
+	}
+
+	for key, info := range response.Data.Versions {
+		version, err := strconv.Atoi(key)
+		if err != nil {
+			log.Info("vault returned an invalid version number", "version", key, "error", err)
+			return nil, core.ErrAPIError.WithDetails("vault returned an invalid version number").WithCause(err)
+		}
+
+		result.Versions = append(result.Versions, &VersionMetadata{
+			Version:      version,
+			CreatedTime:  info.CreatedTime,
+			DeletionTime: info.DeletionTime,
+			Destroyed:    info.Destroyed,
+		})
+	}
+
+	sort.Slice(result.Versions, func(i, j int) bool {
+		return result.Versions[i].Version < result.Versions[j].Version
+	})
+
+	return result, nil
+}
@@ -0,0 +1,35 @@
+package kvsecret
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (a *api) UndeleteKvSecretVersions(ctx context.Context, engine core.MountPathEntity, secret core.SecretPathEntity, versions []int) error {
+	ctx, span := tracing.Start(ctx, "vault.kvsecret.UndeleteKvSecretVersions")
+	defer span.End()
+
+	log := a.Core.Log().WithValues("method", "UndeleteKvSecretVersions", "versions", versions)
+
+	if err := a.ensureVersionedEngine(ctx, engine); err != nil {
+		log.Info("engine does not support versioning", "error", err)
+		return err
+	}
+
+	path, err := getSecretActionPath("undelete", engine, secret)
+	if err != nil {
+		log.Info("failed to get secret undelete path", "error", err)
+		return err
+	}
+
+	log = log.WithValues("path", path)
+
+	if err := a.modifyKvSecretVersions(ctx, core.MethodPost, path, versions); err != nil {
+		log.Info("failed to undelete secret versions", "error", err)
+		return core.ErrAPIError.WithDetails("failed to undelete secret versions").WithCause(err)
+	}
+
+	return nil
+}
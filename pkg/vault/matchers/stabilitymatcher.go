@@ -1,6 +1,7 @@
 package matchers
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"time"
@@ -48,7 +49,7 @@ func (p *stabilityMatcher) testStability(data testenv.TestSecret) (bool, error)
 	for time.Now().Before(timeout) {
 		time.Sleep(interval)
 
-		newSecret, err := data.API().ReadKvSecret(data.Engine(), data)
+		newSecret, err := data.API().ReadKvSecret(context.TODO(), data.Engine(), data)
 		if err != nil {
 			return false, fmt.Errorf("failed to read kv secret: %w", err)
 		}
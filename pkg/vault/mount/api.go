@@ -1,18 +1,20 @@
 package mount
 
 import (
+	"context"
+
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
 type API interface {
-	HasEngine(engine core.MountPathEntity) (bool, error)
-	ListMounts() ([]*Mount, error)
-	DeleteEngine(engine core.MountPathEntity) error
-	MountEngine(engine Entity) error
-	ReadMount(engine core.MountPathEntity) (*Mount, error)
-	ReloadPluginBackends(plugin Plugin) error
-	TuneEngine(engine core.MountPathEntity, config *TuneConfig) error
-	ReadTuneConfig(engine core.MountPathEntity) (*TuneConfig, error)
+	HasEngine(ctx context.Context, engine core.MountPathEntity) (bool, error)
+	ListMounts(ctx context.Context) ([]*Mount, error)
+	DeleteEngine(ctx context.Context, engine core.MountPathEntity) error
+	MountEngine(ctx context.Context, engine Entity) error
+	ReadMount(ctx context.Context, engine core.MountPathEntity) (*Mount, error)
+	ReloadPluginBackends(ctx context.Context, plugin Plugin) error
+	TuneEngine(ctx context.Context, engine core.MountPathEntity, config *TuneConfig) error
+	ReadTuneConfig(ctx context.Context, engine core.MountPathEntity) (*TuneConfig, error)
 }
 
 type Type string
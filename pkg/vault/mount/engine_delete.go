@@ -1,12 +1,17 @@
 package mount
 
 import (
+	"context"
 	"path/filepath"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (a *mountAPI) DeleteEngine(engine core.MountPathEntity) error {
+func (a *mountAPI) DeleteEngine(ctx context.Context, engine core.MountPathEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.mount.DeleteEngine")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "DeleteEngine")
 
 	path, err := engine.GetMountPath()
@@ -20,7 +25,7 @@ func (a *mountAPI) DeleteEngine(engine core.MountPathEntity) error {
 	log.Info("trying to delete secret engine")
 
 	mountPath := filepath.Join("/v1/sys/mounts", path)
-	if err := a.Core.MakeRequest(core.MethodDelete, mountPath, nil, nil); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodDelete, mountPath, nil, nil); err != nil {
 		log.Info("unable to delete engine", "error", err)
 		return core.ErrAPIError.WithDetails("failed to delete engine").WithCause(err)
 	}
@@ -1,10 +1,16 @@
 package mount
 
 import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (a *mountAPI) HasEngine(engine core.MountPathEntity) (bool, error) {
+func (a *mountAPI) HasEngine(ctx context.Context, engine core.MountPathEntity) (bool, error) {
+	ctx, span := tracing.Start(ctx, "vault.mount.HasEngine")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "HasEngine")
 
 	path, err := engine.GetMountPath()
@@ -15,7 +21,7 @@ func (a *mountAPI) HasEngine(engine core.MountPathEntity) (bool, error) {
 
 	log = log.WithValues("path", path)
 
-	mounts, err := a.ListMounts()
+	mounts, err := a.ListMounts(ctx)
 	if err != nil {
 		log.Info("error during mount list", "error", err)
 		return false, core.ErrAPIError.WithDetails("failed to list mounts").WithCause(err)
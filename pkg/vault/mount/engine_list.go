@@ -1,9 +1,11 @@
 package mount
 
 import (
+	"context"
 	"strings"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -19,11 +21,14 @@ type mountInfo struct {
 	Config      *TuneConfig       `json:"config,omitempty"`
 }
 
-func (a *mountAPI) ListMounts() ([]*Mount, error) {
+func (a *mountAPI) ListMounts(ctx context.Context) ([]*Mount, error) {
+	ctx, span := tracing.Start(ctx, "vault.mount.ListMounts")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "ListMounts")
 
 	response := &listMountsResponse{}
-	if err := a.Core.MakeRequest(core.MethodGet, "/v1/sys/mounts", nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodGet, "/v1/sys/mounts", nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to list mounted engines", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to list mounts").WithCause(err)
 	}
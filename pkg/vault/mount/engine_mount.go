@@ -1,9 +1,11 @@
 package mount
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -17,7 +19,10 @@ type mountRequestConfig struct {
 	Options map[string]string `json:"options" mapstructure:"options"`
 }
 
-func (a *mountAPI) MountEngine(engine Entity) error {
+func (a *mountAPI) MountEngine(ctx context.Context, engine Entity) error {
+	ctx, span := tracing.Start(ctx, "vault.mount.MountEngine")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "MountEngine")
 
 	path, err := engine.GetMountPath()
@@ -57,7 +62,7 @@ func (a *mountAPI) MountEngine(engine Entity) error {
 		Options: mountOptions,
 	}
 
-	if err := a.Core.MakeRequest(core.MethodPost, mountPath, httpclient.JSON(request), nil); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodPost, mountPath, httpclient.JSON(request), nil); err != nil {
 		log.Info("unable to mount engine", "error", err)
 		return core.ErrAPIError.WithDetails("failed to mount engine").WithCause(err)
 	}
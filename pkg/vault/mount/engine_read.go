@@ -1,8 +1,15 @@
 package mount
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (a *mountAPI) ReadMount(ctx context.Context, engine core.MountPathEntity) (*Mount, error) {
+	ctx, span := tracing.Start(ctx, "vault.mount.ReadMount")
+	defer span.End()
 
-func (a *mountAPI) ReadMount(engine core.MountPathEntity) (*Mount, error) {
 	log := a.Core.Log().WithValues("method", "ReadMount")
 
 	path, err := engine.GetMountPath()
@@ -13,7 +20,7 @@ func (a *mountAPI) ReadMount(engine core.MountPathEntity) (*Mount, error) {
 
 	log = log.WithValues("path", path)
 
-	mounts, err := a.ListMounts()
+	mounts, err := a.ListMounts(ctx)
 	if err != nil {
 		log.Info("error during mount list", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to list mounts").WithCause(err)
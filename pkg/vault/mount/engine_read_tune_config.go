@@ -1,13 +1,18 @@
 package mount
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (a *mountAPI) ReadTuneConfig(engine core.MountPathEntity) (*TuneConfig, error) {
+func (a *mountAPI) ReadTuneConfig(ctx context.Context, engine core.MountPathEntity) (*TuneConfig, error) {
+	ctx, span := tracing.Start(ctx, "vault.mount.ReadTuneConfig")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "MountEngine")
 
 	path, err := engine.GetMountPath()
@@ -20,7 +25,7 @@ func (a *mountAPI) ReadTuneConfig(engine core.MountPathEntity) (*TuneConfig, err
 
 	config := &TuneConfig{}
 
-	if err := a.Core.MakeRequest(core.MethodGet, filepath.Join("/v1/sys/mounts", path, "tune"), nil, httpclient.JSON(config)); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodGet, filepath.Join("/v1/sys/mounts", path, "tune"), nil, httpclient.JSON(config)); err != nil {
 		log.Info("failed to read tune configuration from engine", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to read tune configuration from engine").WithCause(err)
 	}
@@ -1,13 +1,18 @@
 package mount
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (a *mountAPI) TuneEngine(engine core.MountPathEntity, config *TuneConfig) error {
+func (a *mountAPI) TuneEngine(ctx context.Context, engine core.MountPathEntity, config *TuneConfig) error {
+	ctx, span := tracing.Start(ctx, "vault.mount.TuneEngine")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "MountEngine")
 
 	path, err := engine.GetMountPath()
@@ -18,7 +23,7 @@ func (a *mountAPI) TuneEngine(engine core.MountPathEntity, config *TuneConfig) e
 
 	log = log.WithValues("path", path)
 
-	if err := a.Core.MakeRequest(core.MethodPost, filepath.Join("/v1/sys/mounts", path, "tune"), httpclient.JSON(config), nil); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1/sys/mounts", path, "tune"), httpclient.JSON(config), nil); err != nil {
 		log.Info("failed to tune engine", "error", err)
 		return core.ErrAPIError.WithDetails("failed to tune engine").WithCause(err)
 	}
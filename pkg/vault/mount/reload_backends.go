@@ -1,7 +1,10 @@
 package mount
 
 import (
+	"context"
+
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -17,7 +20,10 @@ type reloadRequest struct {
 	Scope  reloadScope `json:"scope,omitempty"`
 }
 
-func (a *mountAPI) ReloadPluginBackends(plugin Plugin) error {
+func (a *mountAPI) ReloadPluginBackends(ctx context.Context, plugin Plugin) error {
+	ctx, span := tracing.Start(ctx, "vault.mount.ReloadPluginBackends")
+	defer span.End()
+
 	log := a.Core.Log().WithValues("method", "ReloadPluginBackends", "plugin", plugin)
 
 	request := &reloadRequest{
@@ -26,7 +32,7 @@ func (a *mountAPI) ReloadPluginBackends(plugin Plugin) error {
 		Scope:  reloadScopeGlobal,
 	}
 
-	if err := a.Core.MakeRequest(core.MethodPut, "/v1/sys/plugins/reload/backend", httpclient.JSON(request), nil); err != nil {
+	if err := a.Core.MakeRequest(ctx, core.MethodPut, "/v1/sys/plugins/reload/backend", httpclient.JSON(request), nil); err != nil {
 		log.Info("failed to reload plugin backends", "error", err)
 		return core.ErrAPIError.WithDetails("failed to reload plugin backends").WithCause(err)
 	}
@@ -0,0 +1,31 @@
+package passwordpolicy
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type passwordPolicyAPI struct {
+	Core core.API
+}
+
+func NewAPI(core core.API) API {
+	return &passwordPolicyAPI{Core: core}
+}
+
+type API interface {
+	UpdatePasswordPolicy(ctx context.Context, policy Entity) error
+	DeletePasswordPolicy(ctx context.Context, policy core.PolicyNameEntity) error
+	ReadPasswordPolicy(ctx context.Context, policy core.PolicyNameEntity) (*PasswordPolicy, error)
+	GeneratePassword(ctx context.Context, policy core.PolicyNameEntity) (string, error)
+}
+
+type Entity interface {
+	core.PolicyNameEntity
+	Body
+}
+
+type Body interface {
+	GetPasswordPolicy() (*PasswordPolicy, error)
+}
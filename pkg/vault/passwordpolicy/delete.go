@@ -0,0 +1,20 @@
+package passwordpolicy
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (p *passwordPolicyAPI) DeletePasswordPolicy(ctx context.Context, policy core.PolicyNameEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.passwordpolicy.DeletePasswordPolicy")
+	defer span.End()
+
+	policyPath, err := getPasswordPolicyPath(policy)
+	if err != nil {
+		return err
+	}
+
+	return p.deletePasswordPolicy(ctx, policyPath)
+}
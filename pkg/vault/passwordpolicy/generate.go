@@ -0,0 +1,22 @@
+package passwordpolicy
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+// GeneratePassword generates a new password using the password generator of
+// the referenced password policy.
+func (p *passwordPolicyAPI) GeneratePassword(ctx context.Context, policy core.PolicyNameEntity) (string, error) {
+	ctx, span := tracing.Start(ctx, "vault.passwordpolicy.GeneratePassword")
+	defer span.End()
+
+	policyPath, err := getPasswordPolicyPath(policy)
+	if err != nil {
+		return "", err
+	}
+
+	return p.generatePassword(ctx, policyPath)
+}
@@ -0,0 +1,88 @@
+package passwordpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+// CharsetRuleType is the type of rule used to configure the characters of
+// generated passwords. It is the only rule type supported by Vault.
+const CharsetRuleType = "charset"
+
+// CharsetRule configures a set of characters passwords are generated from and
+// how many of them a password has to contain at least.
+type CharsetRule struct {
+	Type     string `hcl:"type,label"`
+	Charset  string `hcl:"charset"`
+	MinChars int    `hcl:"min-chars,optional"`
+}
+
+// PasswordPolicy configures how Vault generates passwords.
+type PasswordPolicy struct {
+	Name   string
+	Length int            `hcl:"length"`
+	Rules  []*CharsetRule `hcl:"rule,block"`
+}
+
+func (p *PasswordPolicy) GetPolicyName() (string, error) {
+	return p.Name, nil
+}
+
+func (p *PasswordPolicy) GetPasswordPolicy() (*PasswordPolicy, error) {
+	return p, nil
+}
+
+func (p *PasswordPolicy) MarshalJSON() ([]byte, error) {
+	policy := &PasswordPolicy{
+		Name:   p.Name,
+		Length: p.Length,
+		Rules:  make([]*CharsetRule, 0, len(p.Rules)),
+	}
+
+	for _, rule := range p.Rules {
+		policy.Rules = append(policy.Rules, &CharsetRule{
+			Type:     CharsetRuleType,
+			Charset:  rule.Charset,
+			MinChars: rule.MinChars,
+		})
+	}
+
+	hcl := hclwrite.NewEmptyFile()
+	gohcl.EncodeIntoBody(policy, hcl.Body())
+
+	policyString := string(hcl.Bytes())
+	policyString = strings.TrimSpace(policyString)
+
+	data, err := json.Marshal(policyString)
+	if err != nil {
+		return nil, core.ErrAPIError.WithDetails("failed to encode password policy hcl to json").WithCause(err)
+	}
+
+	return data, nil
+}
+
+func (p *PasswordPolicy) UnmarshalJSON(bytes []byte) error {
+	var policy string
+	if err := json.Unmarshal(bytes, &policy); err != nil {
+		return core.ErrAPIError.WithDetails("failed to decode password policy hcl from json").WithCause(err)
+	}
+
+	parser := hclparse.NewParser()
+	hcl, diagnostics := parser.ParseHCL([]byte(policy), "password_policy.hcl")
+
+	if diagnostics != nil {
+		return diagnostics
+	}
+
+	if err := gohcl.DecodeBody(hcl.Body, nil, p); err != nil {
+		return fmt.Errorf("failed to decode hcl: %s: %w", string(bytes), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package passwordpolicy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestPasswordPolicy_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *PasswordPolicy
+		want   string
+	}{
+		{
+			name: "should correctly encode policy without rules",
+			policy: &PasswordPolicy{
+				Name:   "policy-name",
+				Length: 20,
+			},
+			want: "length = 20",
+		},
+		{
+			name: "should correctly encode charset rules",
+			policy: &PasswordPolicy{
+				Name:   "policy-name",
+				Length: 20,
+				Rules: []*CharsetRule{
+					{Charset: "abcdefghijklmnopqrstuvwxyz", MinChars: 1},
+					{Charset: "0123456789"},
+				},
+			},
+			want: "length = 20\n\nrule \"charset\" {\n  charset   = \"abcdefghijklmnopqrstuvwxyz\"\n  min-chars = 1\n}\nrule \"charset\" {\n  charset   = \"0123456789\"\n  min-chars = 0\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.policy)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+
+			var got string
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("failed to decode encoded policy: %v", err)
+			}
+
+			if diff := deep.Equal(got, tt.want); diff != nil {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicy_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		want    *PasswordPolicy
+		wantErr bool
+	}{
+		{
+			name:   "should decode policy written by vault",
+			policy: "length = 16\n\nrule \"charset\" {\n  charset = \"abc\"\n  min-chars = 2\n}\n\nrule \"charset\" {\n  charset = \"123\"\n}\n",
+			want: &PasswordPolicy{
+				Length: 16,
+				Rules: []*CharsetRule{
+					{Type: CharsetRuleType, Charset: "abc", MinChars: 2},
+					{Type: CharsetRuleType, Charset: "123"},
+				},
+			},
+		},
+		{
+			name:    "should fail on invalid hcl",
+			policy:  "length = ",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.policy)
+			if err != nil {
+				t.Fatalf("failed to encode policy: %v", err)
+			}
+
+			got := &PasswordPolicy{}
+			if err := got.UnmarshalJSON(data); (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if diff := deep.Equal(got, tt.want); diff != nil {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_isPasswordPolicyEqual(t *testing.T) {
+	policy := &PasswordPolicy{
+		Length: 20,
+		Rules: []*CharsetRule{
+			{Charset: "abc", MinChars: 1},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		actual *PasswordPolicy
+		want   bool
+	}{
+		{name: "should ignore rule type", actual: &PasswordPolicy{Length: 20, Rules: []*CharsetRule{{Type: CharsetRuleType, Charset: "abc", MinChars: 1}}}, want: true},
+		{name: "should detect changed length", actual: &PasswordPolicy{Length: 21, Rules: []*CharsetRule{{Charset: "abc", MinChars: 1}}}, want: false},
+		{name: "should detect changed charset", actual: &PasswordPolicy{Length: 20, Rules: []*CharsetRule{{Charset: "abcd", MinChars: 1}}}, want: false},
+		{name: "should detect changed min chars", actual: &PasswordPolicy{Length: 20, Rules: []*CharsetRule{{Charset: "abc"}}}, want: false},
+		{name: "should detect removed rules", actual: &PasswordPolicy{Length: 20}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isPasswordPolicyEqual(policy, tt.actual); got != tt.want {
+				t.Errorf("isPasswordPolicyEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
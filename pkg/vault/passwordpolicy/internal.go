@@ -0,0 +1,105 @@
+package passwordpolicy
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type getPasswordPolicyResponse struct {
+	RequestID string                `json:"request_id"`
+	Data      getPasswordPolicyData `json:"data"`
+}
+
+type getPasswordPolicyData struct {
+	Policy *PasswordPolicy `json:"policy"`
+}
+
+type setPasswordPolicyRequest struct {
+	Policy *PasswordPolicy `json:"policy"`
+}
+
+type generatePasswordResponse struct {
+	Data generatePasswordData `json:"data"`
+}
+
+type generatePasswordData struct {
+	Password string `json:"password"`
+}
+
+func (p *passwordPolicyAPI) fetchPasswordPolicy(ctx context.Context, path string) (*getPasswordPolicyResponse, error) {
+	log := p.Core.Log().WithValues("method", "fetchPasswordPolicy", "path", path)
+
+	response := &getPasswordPolicyResponse{}
+	if err := p.Core.MakeRequest(ctx, core.MethodGet, path, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to fetch password policy data", "error", err)
+
+		if errors.Is(err, core.ErrNotFound) {
+			return nil, core.ErrDoesNotExist.WithCause(err)
+		}
+
+		return nil, core.ErrAPIError.WithDetails("failed to fetch password policy data").WithCause(err)
+	}
+
+	if response.Data.Policy == nil {
+		return nil, core.ErrAPIError.WithDetails("password policy response did not contain a policy")
+	}
+
+	return response, nil
+}
+
+func (p *passwordPolicyAPI) deletePasswordPolicy(ctx context.Context, path string) error {
+	log := p.Core.Log().WithValues("method", "deletePasswordPolicy", "path", path)
+
+	if err := p.Core.MakeRequest(ctx, core.MethodDelete, path, nil, nil); err != nil {
+		log.Info("failed to delete password policy", "error", err)
+		return core.ErrAPIError.WithDetails("failed to delete password policy").WithCause(err)
+	}
+
+	return nil
+}
+
+func (p *passwordPolicyAPI) writePasswordPolicy(ctx context.Context, path string, policy *PasswordPolicy) error {
+	log := p.Core.Log().WithValues("method", "writePasswordPolicy", "path", path)
+
+	request := &setPasswordPolicyRequest{
+		Policy: policy,
+	}
+
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, path, httpclient.JSON(request), nil); err != nil {
+		log.Info("couldn't write password policy data", "error", err)
+		return core.ErrAPIError.WithDetails("failed to write password policy data").WithCause(err)
+	}
+
+	return nil
+}
+
+func (p *passwordPolicyAPI) generatePassword(ctx context.Context, path string) (string, error) {
+	log := p.Core.Log().WithValues("method", "generatePassword", "path", path)
+
+	response := &generatePasswordResponse{}
+	if err := p.Core.MakeRequest(ctx, core.MethodGet, filepath.Join(path, "generate"), nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to generate password", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to generate password").WithCause(err)
+	}
+
+	return response.Data.Password, nil
+}
+
+func getPasswordPolicyPath(policy core.PolicyNameEntity) (string, error) {
+	name, err := policy.GetPolicyName()
+	if err != nil {
+		return "", core.ErrAPIError.WithDetails("failed to fetch password policy name").WithCause(err)
+	}
+
+	if name == "" {
+		return "", core.ErrAPIError.WithDetails("password policy name must not be empty")
+	}
+
+	path := filepath.Join("/v1/sys/policies/password/", name)
+
+	return path, nil
+}
@@ -0,0 +1,28 @@
+package passwordpolicy
+
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (p *passwordPolicyAPI) ReadPasswordPolicy(ctx context.Context, policy core.PolicyNameEntity) (*PasswordPolicy, error) {
+	ctx, span := tracing.Start(ctx, "vault.passwordpolicy.ReadPasswordPolicy")
+	defer span.End()
+
+	policyPath, err := getPasswordPolicyPath(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.fetchPasswordPolicy(ctx, policyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	result := response.Data.Policy
+	result.Name, _ = policy.GetPolicyName()
+
+	return result, nil
+}
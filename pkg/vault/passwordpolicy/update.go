@@ -0,0 +1,61 @@
+package passwordpolicy
+
+import (
+	"context"
+	"errors"
+
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (p *passwordPolicyAPI) UpdatePasswordPolicy(ctx context.Context, policy Entity) error {
+	ctx, span := tracing.Start(ctx, "vault.passwordpolicy.UpdatePasswordPolicy")
+	defer span.End()
+
+	policyPath, err := getPasswordPolicyPath(policy)
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to fetch password policy path").WithCause(err)
+	}
+
+	expectedPolicy, err := policy.GetPasswordPolicy()
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to fetch password policy").WithCause(err)
+	}
+
+	var updateRequired bool
+
+	switch fetchResponse, err := p.fetchPasswordPolicy(ctx, policyPath); {
+	case errors.Is(err, core.ErrDoesNotExist):
+		updateRequired = true
+	case err == nil:
+		updateRequired = !isPasswordPolicyEqual(expectedPolicy, fetchResponse.Data.Policy)
+	default:
+		return core.ErrAPIError.WithDetails("failed to fetch password policy from vault").WithCause(err)
+	}
+
+	if !updateRequired {
+		return nil
+	}
+
+	err = p.writePasswordPolicy(ctx, policyPath, expectedPolicy)
+	if err != nil {
+		return core.ErrAPIError.WithDetails("failed to write password policy to vault").WithCause(err)
+	}
+
+	return nil
+}
+
+func isPasswordPolicyEqual(expected *PasswordPolicy, actual *PasswordPolicy) bool {
+	if expected.Length != actual.Length || len(expected.Rules) != len(actual.Rules) {
+		return false
+	}
+
+	for index, rule := range expected.Rules {
+		other := actual.Rules[index]
+		if rule.Charset != other.Charset || rule.MinChars != other.MinChars {
+			return false
+		}
+	}
+
+	return true
+}
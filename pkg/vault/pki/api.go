@@ -1,6 +1,7 @@
 package pki
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 	"time"
@@ -10,10 +11,10 @@ import (
 )
 
 type EngineAPI interface {
-	UpdatePKIEngine(engine EngineEntity) error
-	ReadPKIEngine(engine core.MountPathEntity) (*Engine, error)
-	ListCerts(engine core.MountPathEntity) ([]string, error)
-	ReadCACertificatePEM(ca core.MountPathEntity) (string, error)
+	UpdatePKIEngine(ctx context.Context, engine EngineEntity) error
+	ReadPKIEngine(ctx context.Context, engine core.MountPathEntity) (*Engine, error)
+	ListCerts(ctx context.Context, engine core.MountPathEntity) ([]string, error)
+	ReadCACertificatePEM(ctx context.Context, ca core.MountPathEntity) (string, error)
 }
 
 type CAInfo struct {
@@ -44,20 +45,20 @@ type SignCsr struct {
 
 type API interface {
 	EngineAPI
-	IsPKIEngineInitialized(ca core.MountPathEntity) (bool, error)
-	CreateRootCA(mode Mode, ca CAEntity) (*CAInfo, error)
-	UpdateRootCA(ca CAEntity) error
-	CreateIntermediateCA(mode Mode, issuer core.MountPathEntity, ca CAEntity) (*CAInfo, error)
-	UpdateIntermediateCA(issuer core.MountPathEntity, ca CAEntity) error
-	ReadCA(ca core.MountPathEntity) (*CA, error)
-	UpdateCertificateRole(ca core.MountPathEntity, role CertificateRoleEntity) error
-	ReadCertificateRole(ca core.MountPathEntity, role core.RoleNameEntity) (*CertificateRole, error)
-	DeleteCertificateRole(ca core.MountPathEntity, role core.RoleNameEntity) error
-	SignCertificateSigningRequest(ca core.MountPathEntity, role core.RoleNameEntity, request *SignCsr) (*Certificate, error)
-	IssueCertificate(ca core.MountPathEntity, role core.RoleNameEntity, options *IssueCertOptions) (*Certificate, error)
-	RevokeCertificate(ca core.MountPathEntity, serial SerialNumberEntity) error
-	Tidy(ca core.MountPathEntity, settings *TidySettings) error
-	RotateCRLs(ca core.MountPathEntity) error
+	IsPKIEngineInitialized(ctx context.Context, ca core.MountPathEntity) (bool, error)
+	CreateRootCA(ctx context.Context, mode Mode, ca CAEntity) (*CAInfo, error)
+	UpdateRootCA(ctx context.Context, ca CAEntity) error
+	CreateIntermediateCA(ctx context.Context, mode Mode, issuer core.MountPathEntity, ca CAEntity) (*CAInfo, error)
+	UpdateIntermediateCA(ctx context.Context, issuer core.MountPathEntity, ca CAEntity) error
+	ReadCA(ctx context.Context, ca core.MountPathEntity) (*CA, error)
+	UpdateCertificateRole(ctx context.Context, ca core.MountPathEntity, role CertificateRoleEntity) error
+	ReadCertificateRole(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity) (*CertificateRole, error)
+	DeleteCertificateRole(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity) error
+	SignCertificateSigningRequest(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity, request *SignCsr) (*Certificate, error)
+	IssueCertificate(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity, options *IssueCertOptions) (*Certificate, error)
+	RevokeCertificate(ctx context.Context, ca core.MountPathEntity, serial SerialNumberEntity) error
+	Tidy(ctx context.Context, ca core.MountPathEntity, settings *TidySettings) error
+	RotateCRLs(ctx context.Context, ca core.MountPathEntity) error
 }
 
 type KeyType string
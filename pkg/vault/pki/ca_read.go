@@ -1,13 +1,18 @@
 package pki
 
 import (
+	"context"
 	"crypto/x509"
 	"encoding/pem"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (p *pkiAPI) ReadCA(ca core.MountPathEntity) (*CA, error) {
+func (p *pkiAPI) ReadCA(ctx context.Context, ca core.MountPathEntity) (*CA, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.ReadCA")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "ReadCA")
 
 	path, err := ca.GetMountPath()
@@ -18,7 +23,7 @@ func (p *pkiAPI) ReadCA(ca core.MountPathEntity) (*CA, error) {
 
 	log = log.WithValues("path", path)
 
-	pemData, err := p.ReadCACertificatePEM(ca)
+	pemData, err := p.ReadCACertificatePEM(ctx, ca)
 	if err != nil {
 		log.Info("failed to read ca certificate", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to read ca certificate").WithCause(err)
@@ -36,7 +41,7 @@ func (p *pkiAPI) ReadCA(ca core.MountPathEntity) (*CA, error) {
 		return nil, core.ErrAPIError.WithDetails("failed to parse ca certificate").WithCause(err)
 	}
 
-	tuneConfig, err := p.Mount.ReadTuneConfig(ca)
+	tuneConfig, err := p.Mount.ReadTuneConfig(ctx, ca)
 	if err != nil {
 		log.Info("failed to read pki tune config", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to pki tune config").WithCause(err)
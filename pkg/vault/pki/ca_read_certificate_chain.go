@@ -2,13 +2,18 @@ package pki
 
 import (
 	"bytes"
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (p *pkiAPI) ReadCACertificateChain(ca core.MountPathEntity) (string, error) {
+func (p *pkiAPI) ReadCACertificateChain(ctx context.Context, ca core.MountPathEntity) (string, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.ReadCACertificateChain")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "ReadCACertificateChain")
 
 	path, err := ca.GetMountPath()
@@ -20,7 +25,7 @@ func (p *pkiAPI) ReadCACertificateChain(ca core.MountPathEntity) (string, error)
 	log = log.WithValues("path", path)
 
 	var buffer bytes.Buffer
-	if err := p.Core.MakeRequest(core.MethodGet, filepath.Join("/v1", path, "ca_chain"), nil, httpclient.Raw(&buffer)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodGet, filepath.Join("/v1", path, "ca_chain"), nil, httpclient.Raw(&buffer)); err != nil {
 		log.Info("failed to get ca cert chain in pem format", "error", err)
 		return "", core.ErrAPIError.WithDetails("failed to get ca cert chain in pem format").WithCause(err)
 	}
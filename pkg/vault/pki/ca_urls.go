@@ -1,9 +1,11 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -13,7 +15,10 @@ type CertificateURLs struct {
 	OcspServers           []string `json:"ocsp_servers"`
 }
 
-func (p *pkiAPI) SetCertificateURLs(ca core.MountPathEntity, urls *CertificateURLs) error {
+func (p *pkiAPI) SetCertificateURLs(ctx context.Context, ca core.MountPathEntity, urls *CertificateURLs) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.SetCertificateURLs")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "SetCertificateURLs")
 
 	log = log.WithValues(
@@ -30,7 +35,7 @@ func (p *pkiAPI) SetCertificateURLs(ca core.MountPathEntity, urls *CertificateUR
 
 	log = log.WithValues("path", path)
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "config", "urls"), httpclient.JSON(urls), nil); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "config", "urls"), httpclient.JSON(urls), nil); err != nil {
 		log.Info("failed to set certificate urls", "error", err)
 		return core.ErrAPIError.WithDetails("failed to generate root ca in pki engine").WithCause(err)
 	}
@@ -1,11 +1,13 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -45,7 +47,10 @@ type IssueCertOptions struct {
 	ExcludeCNFromSans bool
 }
 
-func (p *pkiAPI) IssueCertificate(ca core.MountPathEntity, role core.RoleNameEntity, options *IssueCertOptions) (*Certificate, error) {
+func (p *pkiAPI) IssueCertificate(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity, options *IssueCertOptions) (*Certificate, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.IssueCertificate")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "IssueCertificate")
 	log = log.WithValues(
 		"common_name", options.CommonName,
@@ -86,7 +91,7 @@ func (p *pkiAPI) IssueCertificate(ca core.MountPathEntity, role core.RoleNameEnt
 
 	response := &issueCertResponse{}
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "issue", roleName), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "issue", roleName), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
 		log.Info("failed to issue certificate from role", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to issue certificate from role").WithCause(err)
 	}
@@ -1,9 +1,11 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -17,7 +19,10 @@ type revokeResponse struct {
 	} `json:"data"`
 }
 
-func (p *pkiAPI) RevokeCertificate(ca core.MountPathEntity, serial SerialNumberEntity) error {
+func (p *pkiAPI) RevokeCertificate(ctx context.Context, ca core.MountPathEntity, serial SerialNumberEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.RevokeCertificate")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "RevokeCertificate")
 
 	path, err := ca.GetMountPath()
@@ -41,7 +46,7 @@ func (p *pkiAPI) RevokeCertificate(ca core.MountPathEntity, serial SerialNumberE
 	}
 	response := &revokeResponse{}
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "revoke"), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "revoke"), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
 		log.Info("failed to revoke certificate in role", "error", err)
 		return core.ErrAPIError.WithDetails("failed to revoke certificate in role").WithCause(err)
 	}
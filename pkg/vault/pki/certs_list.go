@@ -1,10 +1,12 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 	"strings"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -14,7 +16,10 @@ type listCertsResponse struct {
 	} `json:"data"`
 }
 
-func (p *pkiAPI) ListCerts(engine core.MountPathEntity) ([]string, error) {
+func (p *pkiAPI) ListCerts(ctx context.Context, engine core.MountPathEntity) ([]string, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.ListCerts")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "ListCerts")
 
 	path, err := engine.GetMountPath()
@@ -26,7 +31,7 @@ func (p *pkiAPI) ListCerts(engine core.MountPathEntity) ([]string, error) {
 	log = log.WithValues("path", path)
 
 	response := &listCertsResponse{}
-	if err := p.Core.MakeRequest(core.MethodList, filepath.Join("/v1", path, "certs"), nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodList, filepath.Join("/v1", path, "certs"), nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to list certs in pki engine", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to list certs in pki engine").WithCause(err)
 	}
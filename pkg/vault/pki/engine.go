@@ -1,8 +1,10 @@
 package pki
 
 import (
+	"context"
 	"reflect"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 	"github.com/youniqx/heist/pkg/vault/mount"
 )
@@ -31,11 +33,14 @@ func (e *Engine) GetPKIEngineConfig() (*mount.TuneConfig, error) {
 	return e.Config, nil
 }
 
-func (p *pkiAPI) ReadPKIEngine(engine core.MountPathEntity) (*Engine, error) {
+func (p *pkiAPI) ReadPKIEngine(ctx context.Context, engine core.MountPathEntity) (*Engine, error) {
 	panic("implement me")
 }
 
-func (p *pkiAPI) UpdatePKIEngine(engine EngineEntity) error {
+func (p *pkiAPI) UpdatePKIEngine(ctx context.Context, engine EngineEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.UpdatePKIEngine")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "UpdatePKIEngine")
 
 	path, err := engine.GetMountPath()
@@ -46,7 +51,7 @@ func (p *pkiAPI) UpdatePKIEngine(engine EngineEntity) error {
 
 	log = log.WithValues("path", path)
 
-	exists, err := p.Mount.HasEngine(engine)
+	exists, err := p.Mount.HasEngine(ctx, engine)
 	if err != nil {
 		log.Info("failed to check if engine exists", "error", err)
 		return core.ErrAPIError.WithDetails("failed to check if pki engine exists").WithCause(err)
@@ -79,7 +84,7 @@ func (p *pkiAPI) UpdatePKIEngine(engine EngineEntity) error {
 
 		log.Info("creating new pki engine")
 
-		if err := p.Mount.MountEngine(mountRequest); err != nil {
+		if err := p.Mount.MountEngine(ctx, mountRequest); err != nil {
 			log.Info("failed to create pki engine", "error", err)
 			return core.ErrAPIError.WithDetails("failed to create pki engine").WithCause(err)
 		}
@@ -87,7 +92,7 @@ func (p *pkiAPI) UpdatePKIEngine(engine EngineEntity) error {
 
 	log.Info("updating pki engine")
 
-	if err := p.tunePKIEngine(engine); err != nil {
+	if err := p.tunePKIEngine(ctx, engine); err != nil {
 		log.Info("failed to update pki engine config", "error", err)
 		return core.ErrAPIError.WithDetails("failed to update pki engine config").WithCause(err)
 	}
@@ -95,7 +100,7 @@ func (p *pkiAPI) UpdatePKIEngine(engine EngineEntity) error {
 	return nil
 }
 
-func (p *pkiAPI) tunePKIEngine(entity EngineEntity) error {
+func (p *pkiAPI) tunePKIEngine(ctx context.Context, entity EngineEntity) error {
 	log := p.Core.Log().WithValues("method", "tunePKIEngine")
 
 	desiredConfig, err := entity.GetPKIEngineConfig()
@@ -104,7 +109,7 @@ func (p *pkiAPI) tunePKIEngine(entity EngineEntity) error {
 		return core.ErrAPIError.WithDetails("failed to get desired pki mount config").WithCause(err)
 	}
 
-	currentConfig, err := p.Mount.ReadTuneConfig(entity)
+	currentConfig, err := p.Mount.ReadTuneConfig(ctx, entity)
 	if err != nil {
 		log.Info("failed to read pki tune mount config", "error", err)
 		return core.ErrAPIError.WithDetails("failed to read pki tune mount config").WithCause(err)
@@ -114,7 +119,7 @@ func (p *pkiAPI) tunePKIEngine(entity EngineEntity) error {
 		return nil
 	}
 
-	if err := p.Mount.TuneEngine(entity, desiredConfig); err != nil {
+	if err := p.Mount.TuneEngine(ctx, entity, desiredConfig); err != nil {
 		log.Info("failed to write desired pki tune configuration", "error", err)
 		return core.ErrAPIError.WithDetails("failed to write desired pki tune configuration").WithCause(err)
 	}
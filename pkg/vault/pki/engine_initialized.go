@@ -1,14 +1,21 @@
 package pki
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (p *pkiAPI) IsPKIEngineInitialized(ctx context.Context, ca core.MountPathEntity) (bool, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.IsPKIEngineInitialized")
+	defer span.End()
 
-func (p *pkiAPI) IsPKIEngineInitialized(ca core.MountPathEntity) (bool, error) {
-	exists, err := p.Mount.HasEngine(ca)
+	exists, err := p.Mount.HasEngine(ctx, ca)
 	if err != nil {
 		return false, err
 	}
 	if exists {
-		cert, err := p.ReadCACertificatePEM(ca)
+		cert, err := p.ReadCACertificatePEM(ctx, ca)
 		if err != nil {
 			return false, err
 		}
@@ -25,8 +32,11 @@ const (
 	UpdateActionUpdate UpdateAction = "update"
 )
 
-func (p *pkiAPI) DeterminePKIUpdateAction(ca core.MountPathEntity) (UpdateAction, error) {
-	initialized, err := p.IsPKIEngineInitialized(ca)
+func (p *pkiAPI) DeterminePKIUpdateAction(ctx context.Context, ca core.MountPathEntity) (UpdateAction, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.DeterminePKIUpdateAction")
+	defer span.End()
+
+	initialized, err := p.IsPKIEngineInitialized(ctx, ca)
 	if err != nil {
 		return UpdateActionNone, err
 	}
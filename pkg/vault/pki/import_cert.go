@@ -2,6 +2,7 @@ package pki
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
@@ -10,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -17,7 +19,10 @@ type importCertRequest struct {
 	PEMBundle string `json:"pem_bundle,omitempty"`
 }
 
-func (p *pkiAPI) ImportCert(ca CAEntity, cert *ImportedCert) error {
+func (p *pkiAPI) ImportCert(ctx context.Context, ca CAEntity, cert *ImportedCert) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.ImportCert")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "ImportCert")
 
 	path, err := ca.GetMountPath()
@@ -33,7 +38,7 @@ func (p *pkiAPI) ImportCert(ca CAEntity, cert *ImportedCert) error {
 	}
 
 	var result bytes.Buffer
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "config", "ca"), httpclient.JSON(request), httpclient.Raw(&result)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "config", "ca"), httpclient.JSON(request), httpclient.Raw(&result)); err != nil {
 		log.Info("failed to import cert in pki engine", "error", err)
 		return core.ErrAPIError.WithDetails("failed to import cert in pki engine").WithCause(err)
 	}
@@ -1,13 +1,18 @@
 package pki
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
 //nolint:cyclop
-func (p *pkiAPI) CreateIntermediateCA(mode Mode, issuer core.MountPathEntity, ca CAEntity) (*CAInfo, error) {
+func (p *pkiAPI) CreateIntermediateCA(ctx context.Context, mode Mode, issuer core.MountPathEntity, ca CAEntity) (*CAInfo, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.CreateIntermediateCA")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "CreateIntermediateCA")
 
 	path, err := ca.GetMountPath()
@@ -27,7 +32,7 @@ func (p *pkiAPI) CreateIntermediateCA(mode Mode, issuer core.MountPathEntity, ca
 
 	log = log.WithValues("mode", mode)
 
-	initialized, err := p.IsPKIEngineInitialized(ca)
+	initialized, err := p.IsPKIEngineInitialized(ctx, ca)
 	if err != nil {
 		log.Info("failed to check if pki engine has been initialized", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to check if pki engine has been initialized").WithCause(err)
@@ -38,7 +43,7 @@ func (p *pkiAPI) CreateIntermediateCA(mode Mode, issuer core.MountPathEntity, ca
 		return nil, core.ErrAPIError.WithDetails("ca already exists, cannot create it again")
 	}
 
-	if err := p.UpdatePKIEngine(ca); err != nil {
+	if err := p.UpdatePKIEngine(ctx, ca); err != nil {
 		log.Info("failed to update pki engine", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to update pki engine").WithCause(err)
 	}
@@ -49,7 +54,7 @@ func (p *pkiAPI) CreateIntermediateCA(mode Mode, issuer core.MountPathEntity, ca
 		return nil, core.ErrAPIError.WithDetails("failed to get imported cert from ca entity").WithCause(err)
 	}
 
-	data, err := p.importOrCreateIntermediateCA(issuer, ca, mode, importedCert)
+	data, err := p.importOrCreateIntermediateCA(ctx, issuer, ca, mode, importedCert)
 	if err != nil {
 		log.Info("failed to get import or create intermediate ca pki engine", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to get import or create intermediate ca pki engine").WithCause(err)
@@ -61,7 +66,7 @@ func (p *pkiAPI) CreateIntermediateCA(mode Mode, issuer core.MountPathEntity, ca
 		"private_key_exported", data.PrivateKey != "",
 	)
 
-	chain, err := p.ReadCACertificateChain(ca)
+	chain, err := p.ReadCACertificateChain(ctx, ca)
 	if err != nil {
 		log.Info("failed to read ca certificate chain", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to read ca certificate chain").WithCause(err)
@@ -69,7 +74,7 @@ func (p *pkiAPI) CreateIntermediateCA(mode Mode, issuer core.MountPathEntity, ca
 
 	log = log.WithValues("cert_chain", chain)
 
-	cert, err := p.ReadCACertificatePEM(ca)
+	cert, err := p.ReadCACertificatePEM(ctx, ca)
 	if err != nil {
 		log.Info("failed to read ca certificate pem", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to read ca certificate pem").WithCause(err)
@@ -89,7 +94,7 @@ func (p *pkiAPI) CreateIntermediateCA(mode Mode, issuer core.MountPathEntity, ca
 		"ocsp_servers", urls.OcspServers,
 	)
 
-	if err := p.SetCertificateURLs(ca, urls); err != nil {
+	if err := p.SetCertificateURLs(ctx, ca, urls); err != nil {
 		log.Info("failed to set certificate urls", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to set certificate urls").WithCause(err)
 	}
@@ -107,7 +112,7 @@ func (p *pkiAPI) CreateIntermediateCA(mode Mode, issuer core.MountPathEntity, ca
 	}, nil
 }
 
-func (p *pkiAPI) importOrCreateIntermediateCA(issuer core.MountPathEntity, ca CAEntity, mode Mode, importedCert *ImportedCert) (*newCAData, error) {
+func (p *pkiAPI) importOrCreateIntermediateCA(ctx context.Context, issuer core.MountPathEntity, ca CAEntity, mode Mode, importedCert *ImportedCert) (*newCAData, error) {
 	log := p.Core.Log().WithValues("method", "importOrCreateIntermediateCA")
 	if importedCert != nil {
 		parsed, err := parseImportedCert(importedCert)
@@ -115,13 +120,13 @@ func (p *pkiAPI) importOrCreateIntermediateCA(issuer core.MountPathEntity, ca CA
 			return nil, core.ErrAPIError.WithDetails("failed to parse imported cert").WithCause(err)
 		}
 
-		issuerPEM, err := p.ReadCACertificatePEM(issuer)
+		issuerPEM, err := p.ReadCACertificatePEM(ctx, issuer)
 		if err != nil {
 			log.Info("failed to read issuer cert PEM", "error", err)
 			return nil, core.ErrAPIError.WithDetails("failed to read issuer cert PEM").WithCause(err)
 		}
 
-		if err := p.ImportCert(ca, importedCert); err != nil {
+		if err := p.ImportCert(ctx, ca, importedCert); err != nil {
 			return nil, core.ErrAPIError.WithDetails("failed to import intermediate ca in pki engine").WithCause(err)
 		}
 
@@ -143,7 +148,7 @@ func (p *pkiAPI) importOrCreateIntermediateCA(issuer core.MountPathEntity, ca CA
 		}, nil
 	}
 
-	csrInfo, err := p.GenerateIntermediateCSR(mode, ca)
+	csrInfo, err := p.GenerateIntermediateCSR(ctx, mode, ca)
 	if err != nil {
 		log.Info("failed to generated intermediate csr", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to generate csr for intermediate ca").WithCause(err)
@@ -151,13 +156,13 @@ func (p *pkiAPI) importOrCreateIntermediateCA(issuer core.MountPathEntity, ca CA
 
 	log = log.WithValues("csr", csrInfo.CSR)
 
-	signResult, err := p.SignIntermediateCSR(issuer, ca, csrInfo.CSR)
+	signResult, err := p.SignIntermediateCSR(ctx, issuer, ca, csrInfo.CSR)
 	if err != nil {
 		log.Info("failed to generated intermediate csr", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to sign csr of intermediate ca").WithCause(err)
 	}
 
-	if err := p.SetIntermediateCACert(ca, signResult.Certificate); err != nil {
+	if err := p.SetIntermediateCACert(ctx, ca, signResult.Certificate); err != nil {
 		log.Info("failed to generated intermediate csr", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to set signed intermediate cert").WithCause(err)
 	}
@@ -1,10 +1,12 @@
 package pki
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -23,7 +25,10 @@ type IntermediateCAInfo struct {
 	PrivateKeyType KeyType `json:"private_key_type"`
 }
 
-func (p *pkiAPI) GenerateIntermediateCSR(mode Mode, ca CAEntity) (*IntermediateCAInfo, error) {
+func (p *pkiAPI) GenerateIntermediateCSR(ctx context.Context, mode Mode, ca CAEntity) (*IntermediateCAInfo, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.GenerateIntermediateCSR")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "GenerateIntermediateCSR")
 
 	switch mode {
@@ -61,7 +66,7 @@ func (p *pkiAPI) GenerateIntermediateCSR(mode Mode, ca CAEntity) (*IntermediateC
 	}
 	response := &generateIntermediateCSRResponse{}
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "intermediate", "generate", string(mode)), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "intermediate", "generate", string(mode)), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
 		return nil, core.ErrAPIError.WithDetails("failed to generate intermediate csr in pki engine").WithCause(err)
 	}
 
@@ -1,9 +1,11 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -11,7 +13,10 @@ type setIntermediateCertRequest struct {
 	Certificate string `json:"certificate"`
 }
 
-func (p *pkiAPI) SetIntermediateCACert(ca core.MountPathEntity, cert string) error {
+func (p *pkiAPI) SetIntermediateCACert(ctx context.Context, ca core.MountPathEntity, cert string) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.SetIntermediateCACert")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "SignIntermediateCSR")
 
 	path, err := ca.GetMountPath()
@@ -24,7 +29,7 @@ func (p *pkiAPI) SetIntermediateCACert(ca core.MountPathEntity, cert string) err
 		Certificate: cert,
 	}
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "intermediate", "set-signed"), httpclient.JSON(request), nil); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "intermediate", "set-signed"), httpclient.JSON(request), nil); err != nil {
 		return core.ErrAPIError.WithDetails("failed to sign intermediate csr").WithCause(err)
 	}
 
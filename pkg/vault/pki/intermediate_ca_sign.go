@@ -1,9 +1,11 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -24,7 +26,10 @@ type SignIntermediateCSRData struct {
 	SerialNumber string `json:"serial_number"`
 }
 
-func (p *pkiAPI) SignIntermediateCSR(issuer core.MountPathEntity, ca CAEntity, csr string) (*SignIntermediateCSRData, error) {
+func (p *pkiAPI) SignIntermediateCSR(ctx context.Context, issuer core.MountPathEntity, ca CAEntity, csr string) (*SignIntermediateCSRData, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.SignIntermediateCSR")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "SignIntermediateCSR")
 
 	path, err := issuer.GetMountPath()
@@ -53,7 +58,7 @@ func (p *pkiAPI) SignIntermediateCSR(issuer core.MountPathEntity, ca CAEntity, c
 	}
 	response := &signIntermediateCSRResponse{}
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "root", "sign-intermediate"), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "root", "sign-intermediate"), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
 		return nil, core.ErrAPIError.WithDetails("failed to sign intermediate csr").WithCause(err)
 	}
 
@@ -1,23 +1,28 @@
 package pki
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (p *pkiAPI) UpdateIntermediateCA(issuer core.MountPathEntity, ca CAEntity) error {
-	switch action, err := p.DeterminePKIUpdateAction(ca); {
+func (p *pkiAPI) UpdateIntermediateCA(ctx context.Context, issuer core.MountPathEntity, ca CAEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.UpdateIntermediateCA")
+	defer span.End()
+
+	switch action, err := p.DeterminePKIUpdateAction(ctx, ca); {
 	case err != nil:
 		return core.ErrAPIError.WithDetails("failed to determine action for intermediate ca").WithCause(err)
 	case action == UpdateActionCreate:
-		_, err := p.CreateIntermediateCA(ModeInternal, issuer, ca)
+		_, err := p.CreateIntermediateCA(ctx, ModeInternal, issuer, ca)
 		if err != nil {
 			return core.ErrAPIError.WithDetails("failed to create intermediate ca").WithCause(err)
 		}
 		return nil
 	case action == UpdateActionUpdate:
-		if err := p.UpdatePKIEngine(ca); err != nil {
+		if err := p.UpdatePKIEngine(ctx, ca); err != nil {
 			return core.ErrAPIError.WithDetails("failed to update pki engine of intermediate ca").WithCause(err)
 		}
 		return nil
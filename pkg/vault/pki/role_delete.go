@@ -1,14 +1,19 @@
 package pki
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (p *pkiAPI) DeleteCertificateRole(ca core.MountPathEntity, role core.RoleNameEntity) error {
+func (p *pkiAPI) DeleteCertificateRole(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.DeleteCertificateRole")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "DeleteCertificateRole")
 
 	path, err := ca.GetMountPath()
@@ -27,7 +32,7 @@ func (p *pkiAPI) DeleteCertificateRole(ca core.MountPathEntity, role core.RoleNa
 
 	log = log.WithValues("role_name", roleName)
 
-	if err := p.Core.MakeRequest(core.MethodDelete, filepath.Join("/v1", path, "roles", roleName), nil, nil); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodDelete, filepath.Join("/v1", path, "roles", roleName), nil, nil); err != nil {
 		log.Info("failed to delete cert role from pki engine", "error", err)
 
 		var responseError *core.VaultHTTPError
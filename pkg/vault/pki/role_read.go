@@ -1,9 +1,11 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -14,7 +16,10 @@ type readRoleResponse struct {
 	} `json:"data"`
 }
 
-func (p *pkiAPI) ReadCertificateRole(ca core.MountPathEntity, role core.RoleNameEntity) (*CertificateRole, error) {
+func (p *pkiAPI) ReadCertificateRole(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity) (*CertificateRole, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.ReadCertificateRole")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "ReadCertificateRole")
 
 	path, err := ca.GetMountPath()
@@ -35,7 +40,7 @@ func (p *pkiAPI) ReadCertificateRole(ca core.MountPathEntity, role core.RoleName
 
 	response := &readRoleResponse{}
 
-	if err := p.Core.MakeRequest(core.MethodGet, filepath.Join("/v1", path, "roles", roleName), nil, httpclient.JSON(response)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodGet, filepath.Join("/v1", path, "roles", roleName), nil, httpclient.JSON(response)); err != nil {
 		log.Info("failed to read cert role from pki engine", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to read cert role from pki engine").WithCause(err)
 	}
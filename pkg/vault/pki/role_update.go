@@ -1,9 +1,11 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -12,7 +14,10 @@ type updateRoleRequest struct {
 	*SubjectSettings
 }
 
-func (p *pkiAPI) UpdateCertificateRole(ca core.MountPathEntity, role CertificateRoleEntity) error {
+func (p *pkiAPI) UpdateCertificateRole(ctx context.Context, ca core.MountPathEntity, role CertificateRoleEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.UpdateCertificateRole")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "UpdateRole")
 
 	path, err := ca.GetMountPath()
@@ -48,7 +53,7 @@ func (p *pkiAPI) UpdateCertificateRole(ca core.MountPathEntity, role Certificate
 		SubjectSettings: subject,
 	}
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "roles", roleName), httpclient.JSON(request), nil); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "roles", roleName), httpclient.JSON(request), nil); err != nil {
 		return core.ErrAPIError.WithDetails("failed to update role in pki engine").WithCause(err)
 	}
 
@@ -1,10 +1,12 @@
 package pki
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -25,7 +27,10 @@ type generateRootCAResponse struct {
 }
 
 //nolint:cyclop
-func (p *pkiAPI) CreateRootCA(mode Mode, ca CAEntity) (*CAInfo, error) {
+func (p *pkiAPI) CreateRootCA(ctx context.Context, mode Mode, ca CAEntity) (*CAInfo, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.CreateRootCA")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "CreateRootCA")
 
 	path, err := ca.GetMountPath()
@@ -45,7 +50,7 @@ func (p *pkiAPI) CreateRootCA(mode Mode, ca CAEntity) (*CAInfo, error) {
 
 	log = log.WithValues("mode", mode)
 
-	initialized, err := p.IsPKIEngineInitialized(ca)
+	initialized, err := p.IsPKIEngineInitialized(ctx, ca)
 	if err != nil {
 		log.Info("failed to check if pki engine has been initialized", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to check if pki engine has been initialized").WithCause(err)
@@ -56,7 +61,7 @@ func (p *pkiAPI) CreateRootCA(mode Mode, ca CAEntity) (*CAInfo, error) {
 		return nil, core.ErrAPIError.WithDetails("ca already exists, cannot create it again")
 	}
 
-	if err := p.UpdatePKIEngine(ca); err != nil {
+	if err := p.UpdatePKIEngine(ctx, ca); err != nil {
 		log.Info("failed to update pki engine", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to update pki engine").WithCause(err)
 	}
@@ -67,7 +72,7 @@ func (p *pkiAPI) CreateRootCA(mode Mode, ca CAEntity) (*CAInfo, error) {
 		return nil, core.ErrAPIError.WithDetails("failed to get imported cert from ca entity").WithCause(err)
 	}
 
-	data, err := p.importOrCreateRootCA(ca, importedCert, mode)
+	data, err := p.importOrCreateRootCA(ctx, ca, importedCert, mode)
 	if err != nil {
 		log.Info("failed to import or create cert root ca pki engine", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to import or create cert root ca pki engine").WithCause(err)
@@ -79,7 +84,7 @@ func (p *pkiAPI) CreateRootCA(mode Mode, ca CAEntity) (*CAInfo, error) {
 		"private_key_exported", data.PrivateKey != "",
 	)
 
-	chain, err := p.ReadCACertificateChain(ca)
+	chain, err := p.ReadCACertificateChain(ctx, ca)
 	if err != nil {
 		log.Info("failed to read ca certificate chain", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to read ca certificate chain").WithCause(err)
@@ -87,7 +92,7 @@ func (p *pkiAPI) CreateRootCA(mode Mode, ca CAEntity) (*CAInfo, error) {
 
 	log = log.WithValues("cert_chain", chain)
 
-	cert, err := p.ReadCACertificatePEM(ca)
+	cert, err := p.ReadCACertificatePEM(ctx, ca)
 	if err != nil {
 		log.Info("failed to read ca certificate pem", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to read ca certificate pem").WithCause(err)
@@ -107,7 +112,7 @@ func (p *pkiAPI) CreateRootCA(mode Mode, ca CAEntity) (*CAInfo, error) {
 		"ocsp_servers", urls.OcspServers,
 	)
 
-	if err := p.SetCertificateURLs(ca, urls); err != nil {
+	if err := p.SetCertificateURLs(ctx, ca, urls); err != nil {
 		log.Info("failed to set certificate urls", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to set certificate urls").WithCause(err)
 	}
@@ -125,7 +130,7 @@ func (p *pkiAPI) CreateRootCA(mode Mode, ca CAEntity) (*CAInfo, error) {
 	}, nil
 }
 
-func (p *pkiAPI) importOrCreateRootCA(ca CAEntity, importedCert *ImportedCert, mode Mode) (*newCAData, error) {
+func (p *pkiAPI) importOrCreateRootCA(ctx context.Context, ca CAEntity, importedCert *ImportedCert, mode Mode) (*newCAData, error) {
 	log := p.Core.Log().WithValues("method", "importOrCreateRootCA")
 
 	path, err := ca.GetMountPath()
@@ -143,7 +148,7 @@ func (p *pkiAPI) importOrCreateRootCA(ca CAEntity, importedCert *ImportedCert, m
 			return nil, core.ErrAPIError.WithDetails("failed to parse imported cert").WithCause(err)
 		}
 
-		if err := p.ImportCert(ca, importedCert); err != nil {
+		if err := p.ImportCert(ctx, ca, importedCert); err != nil {
 			log.Info("failed to import cert into root pki engine", "error", err)
 			return nil, core.ErrAPIError.WithDetails("failed to import root ca in pki engine").WithCause(err)
 		}
@@ -184,7 +189,7 @@ func (p *pkiAPI) importOrCreateRootCA(ca CAEntity, importedCert *ImportedCert, m
 	}
 	response := &generateRootCAResponse{}
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "root", "generate", string(mode)), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "root", "generate", string(mode)), httpclient.JSON(request), httpclient.JSON(response)); err != nil {
 		return nil, core.ErrAPIError.WithDetails("failed to generate root ca in pki engine").WithCause(err)
 	}
 
@@ -1,23 +1,28 @@
 package pki
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (p *pkiAPI) UpdateRootCA(ca CAEntity) error {
-	switch action, err := p.DeterminePKIUpdateAction(ca); {
+func (p *pkiAPI) UpdateRootCA(ctx context.Context, ca CAEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.UpdateRootCA")
+	defer span.End()
+
+	switch action, err := p.DeterminePKIUpdateAction(ctx, ca); {
 	case err != nil:
 		return core.ErrAPIError.WithDetails("failed to determine action for root ca").WithCause(err)
 	case action == UpdateActionCreate:
-		_, err := p.CreateRootCA(ModeInternal, ca)
+		_, err := p.CreateRootCA(ctx, ModeInternal, ca)
 		if err != nil {
 			return core.ErrAPIError.WithDetails("failed to create root ca").WithCause(err)
 		}
 		return nil
 	case action == UpdateActionUpdate:
-		if err := p.UpdatePKIEngine(ca); err != nil {
+		if err := p.UpdatePKIEngine(ctx, ca); err != nil {
 			return core.ErrAPIError.WithDetails("failed to update pki engine of root ca").WithCause(err)
 		}
 		return nil
@@ -1,9 +1,11 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -13,7 +15,10 @@ type rotateCRLsResponse struct {
 	} `json:"data"`
 }
 
-func (p *pkiAPI) RotateCRLs(ca core.MountPathEntity) error {
+func (p *pkiAPI) RotateCRLs(ctx context.Context, ca core.MountPathEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.RotateCRLs")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "RotateCRLs")
 
 	path, err := ca.GetMountPath()
@@ -26,7 +31,7 @@ func (p *pkiAPI) RotateCRLs(ca core.MountPathEntity) error {
 
 	response := &rotateCRLsResponse{}
 
-	if err := p.Core.MakeRequest(core.MethodGet, filepath.Join("/v1", path, "crl", "rotate"), nil, httpclient.JSON(response)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodGet, filepath.Join("/v1", path, "crl", "rotate"), nil, httpclient.JSON(response)); err != nil {
 		log.Info("failed to rotate CRLs", "error", err)
 		return core.ErrAPIError.WithDetails("failed to rotate CRLs").WithCause(err)
 	}
@@ -1,10 +1,12 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 	"strings"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -22,7 +24,10 @@ type signCsrResponse struct {
 	Data *Certificate `json:"data"`
 }
 
-func (p *pkiAPI) SignCertificateSigningRequest(ca core.MountPathEntity, role core.RoleNameEntity, request *SignCsr) (*Certificate, error) {
+func (p *pkiAPI) SignCertificateSigningRequest(ctx context.Context, ca core.MountPathEntity, role core.RoleNameEntity, request *SignCsr) (*Certificate, error) {
+	ctx, span := tracing.Start(ctx, "vault.pki.SignCertificateSigningRequest")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "IssueCertificate")
 	log = log.WithValues("csr", request.CSR)
 
@@ -54,7 +59,7 @@ func (p *pkiAPI) SignCertificateSigningRequest(ca core.MountPathEntity, role cor
 
 	csrResponse := &signCsrResponse{}
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "sign", roleName), httpclient.JSON(csrRequest), httpclient.JSON(csrResponse)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "sign", roleName), httpclient.JSON(csrRequest), httpclient.JSON(csrResponse)); err != nil {
 		log.Info("failed to issue certificate from role", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to issue certificate from role").WithCause(err)
 	}
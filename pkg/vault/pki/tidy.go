@@ -1,13 +1,18 @@
 package pki
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (p *pkiAPI) Tidy(ca core.MountPathEntity, settings *TidySettings) error {
+func (p *pkiAPI) Tidy(ctx context.Context, ca core.MountPathEntity, settings *TidySettings) error {
+	ctx, span := tracing.Start(ctx, "vault.pki.Tidy")
+	defer span.End()
+
 	log := p.Core.Log().WithValues("method", "Tidy")
 
 	path, err := ca.GetMountPath()
@@ -18,7 +23,7 @@ func (p *pkiAPI) Tidy(ca core.MountPathEntity, settings *TidySettings) error {
 
 	log = log.WithValues("path", path)
 
-	if err := p.Core.MakeRequest(core.MethodPost, filepath.Join("/v1", path, "tidy"), httpclient.JSON(settings), nil); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, filepath.Join("/v1", path, "tidy"), httpclient.JSON(settings), nil); err != nil {
 		log.Info("failed to tidy vault pki storage", "error", err)
 		return core.ErrAPIError.WithDetails("failed to tidy vault pki storage").WithCause(err)
 	}
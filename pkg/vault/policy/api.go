@@ -1,6 +1,9 @@
 package policy
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
 
 type policyAPI struct {
 	Core core.API
@@ -11,9 +14,9 @@ func NewAPI(core core.API) API {
 }
 
 type API interface {
-	UpdatePolicy(policy Entity) error
-	DeletePolicy(policy core.PolicyNameEntity) error
-	ReadPolicy(policy core.PolicyNameEntity) (*Policy, error)
+	UpdatePolicy(ctx context.Context, policy Entity) error
+	DeletePolicy(ctx context.Context, policy core.PolicyNameEntity) error
+	ReadPolicy(ctx context.Context, policy core.PolicyNameEntity) (*Policy, error)
 }
 
 type Entity interface {
@@ -1,12 +1,19 @@
 package policy
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (p *policyAPI) DeletePolicy(ctx context.Context, policy core.PolicyNameEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.policy.DeletePolicy")
+	defer span.End()
 
-func (p *policyAPI) DeletePolicy(policy core.PolicyNameEntity) error {
 	policyPath, err := getPolicyPath(policy)
 	if err != nil {
 		return err
 	}
 
-	return p.deletePolicy(policyPath)
+	return p.deletePolicy(ctx, policyPath)
 }
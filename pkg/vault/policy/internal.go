@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
@@ -23,11 +24,11 @@ type setPolicyRequest struct {
 	Policy *Policy `json:"policy"`
 }
 
-func (p *policyAPI) fetchPolicy(path string) (*getPolicyResponse, error) {
+func (p *policyAPI) fetchPolicy(ctx context.Context, path string) (*getPolicyResponse, error) {
 	log := p.Core.Log().WithValues("method", "fetchPolicy", "path", path)
 
 	response := &getPolicyResponse{}
-	if err := p.Core.MakeRequest(core.MethodGet, path, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodGet, path, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to fetch policy data", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -41,10 +42,10 @@ func (p *policyAPI) fetchPolicy(path string) (*getPolicyResponse, error) {
 	return response, nil
 }
 
-func (p *policyAPI) deletePolicy(path string) error {
+func (p *policyAPI) deletePolicy(ctx context.Context, path string) error {
 	log := p.Core.Log().WithValues("deletePolicy", path)
 
-	if err := p.Core.MakeRequest(core.MethodDelete, path, nil, nil); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodDelete, path, nil, nil); err != nil {
 		log.Info("failed to delete policy", "error", err)
 		return core.ErrAPIError.WithDetails("failed to delete policy").WithCause(err)
 	}
@@ -52,7 +53,7 @@ func (p *policyAPI) deletePolicy(path string) error {
 	return nil
 }
 
-func (p *policyAPI) writePolicy(path string, rules []*Rule) error {
+func (p *policyAPI) writePolicy(ctx context.Context, path string, rules []*Rule) error {
 	log := p.Core.Log().WithValues("writePolicy", path, "rules", rules)
 
 	request := &setPolicyRequest{
@@ -61,7 +62,7 @@ func (p *policyAPI) writePolicy(path string, rules []*Rule) error {
 		},
 	}
 
-	if err := p.Core.MakeRequest(core.MethodPost, path, httpclient.JSON(request), nil); err != nil {
+	if err := p.Core.MakeRequest(ctx, core.MethodPost, path, httpclient.JSON(request), nil); err != nil {
 		log.Info("couldn't write policy data", "path", path, "error", err)
 		return core.ErrAPIError.WithDetails("failed to write policy data").WithCause(err)
 	}
@@ -1,14 +1,21 @@
 package policy
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (p *policyAPI) ReadPolicy(ctx context.Context, policy core.PolicyNameEntity) (*Policy, error) {
+	ctx, span := tracing.Start(ctx, "vault.policy.ReadPolicy")
+	defer span.End()
 
-func (p *policyAPI) ReadPolicy(policy core.PolicyNameEntity) (*Policy, error) {
 	policyPath, err := getPolicyPath(policy)
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := p.fetchPolicy(policyPath)
+	response, err := p.fetchPolicy(ctx, policyPath)
 	if err != nil {
 		return nil, err
 	}
@@ -1,13 +1,18 @@
 package policy
 
 import (
+	"context"
 	"errors"
 	"reflect"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (p *policyAPI) UpdatePolicy(policy Entity) error {
+func (p *policyAPI) UpdatePolicy(ctx context.Context, policy Entity) error {
+	ctx, span := tracing.Start(ctx, "vault.policy.UpdatePolicy")
+	defer span.End()
+
 	policyPath, err := getPolicyPath(policy)
 	if err != nil {
 		return core.ErrAPIError.WithDetails("failed to fetch policy path").WithCause(err)
@@ -20,7 +25,7 @@ func (p *policyAPI) UpdatePolicy(policy Entity) error {
 
 	var updateRequired bool
 
-	switch fetchResponse, err := p.fetchPolicy(policyPath); {
+	switch fetchResponse, err := p.fetchPolicy(ctx, policyPath); {
 	case errors.Is(err, core.ErrDoesNotExist):
 		updateRequired = true
 	case err == nil:
@@ -33,7 +38,7 @@ func (p *policyAPI) UpdatePolicy(policy Entity) error {
 		return nil
 	}
 
-	err = p.writePolicy(policyPath, expectedRules)
+	err = p.writePolicy(ctx, policyPath, expectedRules)
 	if err != nil {
 		return core.ErrAPIError.WithDetails("failed to write policy to vault").WithCause(err)
 	}
@@ -1,10 +1,13 @@
 package random
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
 
 type API interface {
-	GenerateRandomBytes(length int) ([]byte, error)
-	GenerateRandomString(length int) (string, error)
+	GenerateRandomBytes(ctx context.Context, length int) ([]byte, error)
+	GenerateRandomString(ctx context.Context, length int) (string, error)
 }
 
 type randomAPI struct {
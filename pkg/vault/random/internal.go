@@ -1,6 +1,7 @@
 package random
 
 import (
+	"context"
 	"path/filepath"
 	"strconv"
 
@@ -16,7 +17,7 @@ type randomResponseData struct {
 	Base64RandomData string `json:"random_bytes"`
 }
 
-func (r *randomAPI) fetchRandomBase64String(length int) (string, error) {
+func (r *randomAPI) fetchRandomBase64String(ctx context.Context, length int) (string, error) {
 	log := r.Core.Log().WithValues("method", "fetchRandomBase64String", "length", length)
 	if length == 0 {
 		log.Info("cannot generate random byte sequence with length 0")
@@ -26,7 +27,7 @@ func (r *randomAPI) fetchRandomBase64String(length int) (string, error) {
 	randomPath := filepath.Join("/v1/sys/tools/random", strconv.Itoa(length))
 	response := &randomResponse{}
 
-	if err := r.Core.MakeRequest(core.MethodPost, randomPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := r.Core.MakeRequest(ctx, core.MethodPost, randomPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to fetch random byte sequence", "error", err)
 		return "", core.ErrAPIError.WithDetails("failed to fetch random byte sequence").WithCause(err)
 	}
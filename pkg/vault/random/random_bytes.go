@@ -1,15 +1,20 @@
 package random
 
 import (
+	"context"
 	"encoding/base64"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (r *randomAPI) GenerateRandomBytes(length int) ([]byte, error) {
+func (r *randomAPI) GenerateRandomBytes(ctx context.Context, length int) ([]byte, error) {
+	ctx, span := tracing.Start(ctx, "vault.random.GenerateRandomBytes")
+	defer span.End()
+
 	log := r.Core.Log().WithValues("method", "GenerateRandomBytes", "length", length)
 
-	randomBase64String, err := r.fetchRandomBase64String(length)
+	randomBase64String, err := r.fetchRandomBase64String(ctx, length)
 	if err != nil {
 		log.Info("failed to fetch random base64 encoded string", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to fetch random base64 string").WithCause(err)
@@ -1,9 +1,18 @@
 package random
 
-func (r *randomAPI) GenerateRandomString(length int) (string, error) {
+import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
+)
+
+func (r *randomAPI) GenerateRandomString(ctx context.Context, length int) (string, error) {
+	ctx, span := tracing.Start(ctx, "vault.random.GenerateRandomString")
+	defer span.End()
+
 	log := r.Core.Log().WithValues("method", "GenerateRandomString", "length", length)
 
-	randomBase64String, err := r.fetchRandomBase64String(length)
+	randomBase64String, err := r.fetchRandomBase64String(ctx, length)
 	if err != nil {
 		log.Info("failed to fetch random base64 encoded string", "error", err)
 		return "", err
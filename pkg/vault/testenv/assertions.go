@@ -1,6 +1,7 @@
 package testenv
 
 import (
+	"context"
 	"time"
 
 	"github.com/onsi/gomega"
@@ -43,7 +44,7 @@ func (t *testSecret) API() vault.API {
 
 func (e *testEnv) KvSecret(engine core.MountPathEntity, secret core.SecretPathEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		secret, _ := api.ReadKvSecret(engine, secret)
+		secret, _ := api.ReadKvSecret(context.TODO(), engine, secret)
 		if secret == nil {
 			return nil
 		}
@@ -57,70 +58,70 @@ func (e *testEnv) KvSecret(engine core.MountPathEntity, secret core.SecretPathEn
 
 func (e *testEnv) KvEngine(engine core.MountPathEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		engine, _ := api.ReadKvEngine(engine)
+		engine, _ := api.ReadKvEngine(context.TODO(), engine)
 		return engine
 	})
 }
 
 func (e *testEnv) TransitEngine(engine core.MountPathEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		engine, _ := api.ReadTransitEngine(engine)
+		engine, _ := api.ReadTransitEngine(context.TODO(), engine)
 		return engine
 	})
 }
 
 func (e *testEnv) TransitKey(engine core.MountPathEntity, key transit.KeyNameEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		engine, _ := api.ReadTransitKey(engine, key)
+		engine, _ := api.ReadTransitKey(context.TODO(), engine, key)
 		return engine
 	})
 }
 
 func (e *testEnv) Policy(policy core.PolicyNameEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		engine, _ := api.ReadPolicy(policy)
+		engine, _ := api.ReadPolicy(context.TODO(), policy)
 		return engine
 	})
 }
 
 func (e *testEnv) KubernetesAuthRole(auth core.MountPathEntity, role core.RoleNameEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		role, _ := api.ReadKubernetesAuthRole(auth, role)
+		role, _ := api.ReadKubernetesAuthRole(context.TODO(), auth, role)
 		return role
 	})
 }
 
 func (e *testEnv) AuthMethod(auth core.MountPathEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		auth, _ := api.ReadAuthMethod(auth)
+		auth, _ := api.ReadAuthMethod(context.TODO(), auth)
 		return auth
 	})
 }
 
 func (e *testEnv) Mount(mount core.MountPathEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		mount, _ := api.ReadMount(mount)
+		mount, _ := api.ReadMount(context.TODO(), mount)
 		return mount
 	})
 }
 
 func (e *testEnv) CA(mount core.MountPathEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		ca, _ := api.ReadCA(mount)
+		ca, _ := api.ReadCA(context.TODO(), mount)
 		return ca
 	})
 }
 
 func (e *testEnv) TuneConfig(engine core.MountPathEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		config, _ := api.ReadTuneConfig(engine)
+		config, _ := api.ReadTuneConfig(context.TODO(), engine)
 		return config
 	})
 }
 
 func (e *testEnv) CertificateRole(ca core.MountPathEntity, role core.RoleNameEntity) gomega.AsyncAssertion {
 	return e.fetch(func(api vault.API) interface{} {
-		certRole, _ := api.ReadCertificateRole(ca, role)
+		certRole, _ := api.ReadCertificateRole(context.TODO(), ca, role)
 		return certRole
 	})
 }
@@ -1,22 +1,26 @@
 package transit
 
 import (
+	"context"
+
 	"github.com/youniqx/heist/pkg/vault/core"
 	"github.com/youniqx/heist/pkg/vault/mount"
 )
 
 type API interface {
-	UpdateTransitEngine(engine EngineEntity) error
-	ReadTransitEngine(engine core.MountPathEntity) (*Engine, error)
-	ListKeys(engine core.MountPathEntity) ([]KeyName, error)
-	UpdateTransitKey(engine core.MountPathEntity, key KeyEntity) error
-	ReadTransitKey(engine core.MountPathEntity, key KeyNameEntity) (*Key, error)
-	DeleteTransitKey(engine core.MountPathEntity, key KeyNameEntity) error
-	RotateTransitKey(engine core.MountPathEntity, key KeyNameEntity) error
-	TransitEncrypt(engine core.MountPathEntity, key KeyNameEntity, plainText []byte) (string, error)
-	TransitDecrypt(engine core.MountPathEntity, key KeyNameEntity, cipherText string) ([]byte, error)
-	TransitSign(engine core.MountPathEntity, key KeyNameEntity, input []byte) (string, error)
-	TransitVerify(engine core.MountPathEntity, key KeyNameEntity, input []byte, signature string) (bool, error)
+	UpdateTransitEngine(ctx context.Context, engine EngineEntity) error
+	ReadTransitEngine(ctx context.Context, engine core.MountPathEntity) (*Engine, error)
+	ListKeys(ctx context.Context, engine core.MountPathEntity) ([]KeyName, error)
+	UpdateTransitKey(ctx context.Context, engine core.MountPathEntity, key KeyEntity) error
+	ReadTransitKey(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity) (*Key, error)
+	DeleteTransitKey(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity) error
+	RotateTransitKey(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity) error
+	TransitEncrypt(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, plainText []byte) (string, error)
+	TransitDecrypt(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, cipherText string) ([]byte, error)
+	TransitSign(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, input []byte) (string, error)
+	TransitVerify(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, input []byte, signature string) (bool, error)
+	TransitHMAC(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, input []byte) (string, error)
+	TransitRewrap(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, cipherText string) (string, error)
 }
 
 type EngineEntity interface {
@@ -1,6 +1,7 @@
 package transit
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
@@ -11,10 +12,10 @@ import (
 	"github.com/youniqx/heist/pkg/vault/mount"
 )
 
-func (t *transitAPI) updateTransitEngineConfig(engine core.MountPathEntity, config *EngineConfig) error {
+func (t *transitAPI) updateTransitEngineConfig(ctx context.Context, engine core.MountPathEntity, config *EngineConfig) error {
 	log := t.Core.Log().WithValues("method", "updateTransitEngineConfig")
 
-	currentConfig, err := t.fetchTransitEngineConfig(engine)
+	currentConfig, err := t.fetchTransitEngineConfig(ctx, engine)
 	if err != nil {
 		log.Info("failed to fetch current transit engine config", "error", err)
 		return core.ErrAPIError.WithDetails("failed to fetch current transit engine config").WithCause(err)
@@ -24,12 +25,12 @@ func (t *transitAPI) updateTransitEngineConfig(engine core.MountPathEntity, conf
 		return nil
 	}
 
-	if err := t.writeTransitEngineConfig(engine, config); err != nil {
+	if err := t.writeTransitEngineConfig(ctx, engine, config); err != nil {
 		log.Info("failed to write transit engine config", "error", err)
 		return core.ErrAPIError.WithDetails("failed to write transit engine config").WithCause(err)
 	}
 
-	if err := t.Mount.ReloadPluginBackends(mount.PluginTransit); err != nil {
+	if err := t.Mount.ReloadPluginBackends(ctx, mount.PluginTransit); err != nil {
 		log.Info("failed to reload plugin backends so changes to the transit cache are live immediately", "error", err)
 		return core.ErrAPIError.WithDetails("failed to reload plugin backends so changes to the transit cache are live immediately").WithCause(err)
 	}
@@ -41,7 +42,7 @@ type fetchCacheConfigResponse struct {
 	Data EngineCacheConfig `json:"data"`
 }
 
-func (t *transitAPI) fetchTransitEngineConfig(engine core.MountPathEntity) (*EngineConfig, error) {
+func (t *transitAPI) fetchTransitEngineConfig(ctx context.Context, engine core.MountPathEntity) (*EngineConfig, error) {
 	log := t.Core.Log().WithValues("method", "fetchTransitEngineConfig")
 
 	path, err := engine.GetMountPath()
@@ -55,7 +56,7 @@ func (t *transitAPI) fetchTransitEngineConfig(engine core.MountPathEntity) (*Eng
 	configPath := filepath.Join("/v1", path, "cache-config")
 
 	response := &fetchCacheConfigResponse{}
-	if err := t.Core.MakeRequest(core.MethodGet, configPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodGet, configPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to fetch transit engine config", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -69,7 +70,7 @@ func (t *transitAPI) fetchTransitEngineConfig(engine core.MountPathEntity) (*Eng
 	return &EngineConfig{Cache: response.Data}, nil
 }
 
-func (t *transitAPI) writeTransitEngineConfig(engine core.MountPathEntity, config *EngineConfig) error {
+func (t *transitAPI) writeTransitEngineConfig(ctx context.Context, engine core.MountPathEntity, config *EngineConfig) error {
 	log := t.Core.Log().WithValues("method", "writeTransitEngineConfig")
 
 	path, err := engine.GetMountPath()
@@ -85,7 +86,7 @@ func (t *transitAPI) writeTransitEngineConfig(engine core.MountPathEntity, confi
 	}
 
 	configPath := filepath.Join("/v1", path, "cache-config")
-	if err := t.Core.MakeRequest(core.MethodPost, configPath, httpclient.JSON(config.Cache), nil); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodPost, configPath, httpclient.JSON(config.Cache), nil); err != nil {
 		log.Info("failed to write transit engine config", "error", err)
 
 		var responseError *core.VaultHTTPError
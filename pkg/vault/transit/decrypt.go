@@ -1,12 +1,14 @@
 package transit
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"net/http"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -22,7 +24,10 @@ type decryptResponseData struct {
 	Base64PlainText string `json:"plaintext"`
 }
 
-func (t *transitAPI) TransitDecrypt(engine core.MountPathEntity, key KeyNameEntity, cipherText string) ([]byte, error) {
+func (t *transitAPI) TransitDecrypt(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, cipherText string) ([]byte, error) {
+	ctx, span := tracing.Start(ctx, "vault.transit.TransitDecrypt")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "TransitDecrypt")
 
 	path, err := engine.GetMountPath()
@@ -47,7 +52,7 @@ func (t *transitAPI) TransitDecrypt(engine core.MountPathEntity, key KeyNameEnti
 	}
 	response := &decryptResponse{}
 
-	if err := t.Core.MakeRequest(core.MethodPost, decryptPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodPost, decryptPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to decrypt plain text", "error", err)
 
 		var responseError *core.VaultHTTPError
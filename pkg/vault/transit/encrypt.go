@@ -1,6 +1,7 @@
 package transit
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -8,6 +9,7 @@ import (
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -36,7 +38,10 @@ type encryptResponseData struct {
 	CipherText string `json:"ciphertext"`
 }
 
-func (t *transitAPI) TransitEncrypt(engine core.MountPathEntity, key KeyNameEntity, plainText []byte) (string, error) {
+func (t *transitAPI) TransitEncrypt(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, plainText []byte) (string, error) {
+	ctx, span := tracing.Start(ctx, "vault.transit.TransitEncrypt")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "TransitEncrypt")
 
 	path, err := engine.GetMountPath()
@@ -61,7 +66,7 @@ func (t *transitAPI) TransitEncrypt(engine core.MountPathEntity, key KeyNameEnti
 	}
 	response := &encryptResponse{}
 
-	if err := t.Core.MakeRequest(core.MethodPost, encryptPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodPost, encryptPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to encrypt plain text", "error", err)
 
 		var responseError *core.VaultHTTPError
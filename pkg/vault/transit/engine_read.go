@@ -1,8 +1,15 @@
 package transit
 
-import "github.com/youniqx/heist/pkg/vault/core"
+import (
+	"context"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+func (t *transitAPI) ReadTransitEngine(ctx context.Context, engine core.MountPathEntity) (*Engine, error) {
+	ctx, span := tracing.Start(ctx, "vault.transit.ReadTransitEngine")
+	defer span.End()
 
-func (t *transitAPI) ReadTransitEngine(engine core.MountPathEntity) (*Engine, error) {
 	log := t.Core.Log().WithValues("method", "ReadTransitEngine")
 
 	path, err := engine.GetMountPath()
@@ -13,7 +20,7 @@ func (t *transitAPI) ReadTransitEngine(engine core.MountPathEntity) (*Engine, er
 
 	log = log.WithValues("path", path)
 
-	config, err := t.fetchTransitEngineConfig(engine)
+	config, err := t.fetchTransitEngineConfig(ctx, engine)
 	if err != nil {
 		log.Info("failed to fetch current transit engine config", "error", err)
 		return nil, core.ErrAPIError.WithDetails("failed to fetch transit engine").WithCause(err)
@@ -1,11 +1,17 @@
 package transit
 
 import (
+	"context"
+
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 	"github.com/youniqx/heist/pkg/vault/mount"
 )
 
-func (t *transitAPI) UpdateTransitEngine(engine EngineEntity) error {
+func (t *transitAPI) UpdateTransitEngine(ctx context.Context, engine EngineEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.transit.UpdateTransitEngine")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "UpdateTransitEngine")
 
 	path, err := engine.GetMountPath()
@@ -16,7 +22,7 @@ func (t *transitAPI) UpdateTransitEngine(engine EngineEntity) error {
 
 	log = log.WithValues("path", path)
 
-	exists, err := t.Mount.HasEngine(engine)
+	exists, err := t.Mount.HasEngine(ctx, engine)
 	if err != nil {
 		log.Info("failed to check if engine exists", "error", err)
 		return core.ErrAPIError.WithDetails("failed to check if engine exists").WithCause(err)
@@ -42,7 +48,7 @@ func (t *transitAPI) UpdateTransitEngine(engine EngineEntity) error {
 
 		log.Info("creating new transit engine")
 
-		if err := t.Mount.MountEngine(mountRequest); err != nil {
+		if err := t.Mount.MountEngine(ctx, mountRequest); err != nil {
 			log.Info("failed to create transit engine", "error", err)
 			return core.ErrAPIError.WithDetails("failed to create transit engine").WithCause(err)
 		}
@@ -54,7 +60,7 @@ func (t *transitAPI) UpdateTransitEngine(engine EngineEntity) error {
 		return core.ErrAPIError.WithDetails("failed to fetch desired transit engine config").WithCause(err)
 	}
 
-	if err := t.updateTransitEngineConfig(engine, config); err != nil {
+	if err := t.updateTransitEngineConfig(ctx, engine, config); err != nil {
 		log.Info("failed to update transit engine config", "error", err)
 		return core.ErrAPIError.WithDetails("failed to update transit engine config").WithCause(err)
 	}
@@ -0,0 +1,66 @@
+package transit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type hmacRequest struct {
+	Input Base64EncodedBlob `json:"input"`
+}
+
+type hmacResponse struct {
+	Data hmacResponseData `json:"data"`
+}
+
+type hmacResponseData struct {
+	HMAC string `json:"hmac"`
+}
+
+func (t *transitAPI) TransitHMAC(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, input []byte) (string, error) {
+	ctx, span := tracing.Start(ctx, "vault.transit.TransitHMAC")
+	defer span.End()
+
+	log := t.Core.Log().WithValues("method", "TransitHMAC")
+
+	path, err := engine.GetMountPath()
+	if err != nil {
+		log.Info("failed to get engine path", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	keyName, err := key.GetTransitKeyName()
+	if err != nil {
+		log.Info("failed to get transit key name", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to get transit key name").WithCause(err)
+	}
+
+	log = log.WithValues("key", keyName)
+
+	hmacPath := filepath.Join("/v1", path, "hmac", keyName)
+	request := &hmacRequest{
+		Input: Base64EncodedBlob(input),
+	}
+	response := &hmacResponse{}
+
+	if err := t.Core.MakeRequest(ctx, core.MethodPost, hmacPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to generate hmac", "error", err)
+
+		var responseError *core.VaultHTTPError
+		if errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound {
+			return "", core.ErrDoesNotExist.WithCause(err)
+		}
+
+		return "", core.ErrAPIError.WithDetails("failed to generate hmac").WithCause(err)
+	}
+
+	return response.Data.HMAC, nil
+}
@@ -1,15 +1,20 @@
 package transit
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 	"strings"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (t *transitAPI) DeleteTransitKey(engine core.MountPathEntity, key KeyNameEntity) error {
+func (t *transitAPI) DeleteTransitKey(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.transit.DeleteTransitKey")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "DeleteTransitKey")
 
 	path, err := engine.GetMountPath()
@@ -30,7 +35,7 @@ func (t *transitAPI) DeleteTransitKey(engine core.MountPathEntity, key KeyNameEn
 
 	deletePath := filepath.Join("/v1", path, "keys", keyName)
 
-	if err := t.Core.MakeRequest(core.MethodDelete, deletePath, nil, nil); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodDelete, deletePath, nil, nil); err != nil {
 		log.Info("failed to delete transit key", "error", err)
 
 		var responseError *core.VaultHTTPError
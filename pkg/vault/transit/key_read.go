@@ -1,11 +1,13 @@
 package transit
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -19,7 +21,10 @@ type readKeyData struct {
 	*KeyConfig `json:",inline"`
 }
 
-func (t *transitAPI) ReadTransitKey(engine core.MountPathEntity, key KeyNameEntity) (*Key, error) {
+func (t *transitAPI) ReadTransitKey(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity) (*Key, error) {
+	ctx, span := tracing.Start(ctx, "vault.transit.ReadTransitKey")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "ReadTransitKey")
 
 	path, err := engine.GetMountPath()
@@ -41,7 +46,7 @@ func (t *transitAPI) ReadTransitKey(engine core.MountPathEntity, key KeyNameEnti
 	configPath := filepath.Join("/v1", path, "keys", keyName)
 
 	response := &readKeyResponse{}
-	if err := t.Core.MakeRequest(core.MethodGet, configPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodGet, configPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to fetch transit key config", "error", err)
 
 		var responseError *core.VaultHTTPError
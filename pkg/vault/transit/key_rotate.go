@@ -1,14 +1,19 @@
 package transit
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
-func (t *transitAPI) RotateTransitKey(engine core.MountPathEntity, key KeyNameEntity) error {
+func (t *transitAPI) RotateTransitKey(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.transit.RotateTransitKey")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "RotateTransitKey")
 
 	path, err := engine.GetMountPath()
@@ -28,7 +33,7 @@ func (t *transitAPI) RotateTransitKey(engine core.MountPathEntity, key KeyNameEn
 	log = log.WithValues("key", keyName)
 
 	rotatePath := filepath.Join("/v1", path, "keys", keyName, "rotate")
-	if err := t.Core.MakeRequest(core.MethodPost, rotatePath, nil, nil); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodPost, rotatePath, nil, nil); err != nil {
 		log.Info("failed to rotate transit key", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -1,12 +1,14 @@
 package transit
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 	"reflect"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -15,7 +17,10 @@ type createKeyRequest struct {
 }
 
 //nolint:cyclop
-func (t *transitAPI) UpdateTransitKey(engine core.MountPathEntity, key KeyEntity) error {
+func (t *transitAPI) UpdateTransitKey(ctx context.Context, engine core.MountPathEntity, key KeyEntity) error {
+	ctx, span := tracing.Start(ctx, "vault.transit.UpdateTransitKey")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "UpdateTransitKey")
 
 	path, err := engine.GetMountPath()
@@ -55,7 +60,7 @@ func (t *transitAPI) UpdateTransitKey(engine core.MountPathEntity, key KeyEntity
 		configUpdateRequired bool
 	)
 
-	switch currentKey, err := t.ReadTransitKey(engine, key); {
+	switch currentKey, err := t.ReadTransitKey(ctx, engine, key); {
 	case errors.Is(err, core.ErrDoesNotExist):
 		keyCreationRequired = true
 		configUpdateRequired = true
@@ -77,7 +82,7 @@ func (t *transitAPI) UpdateTransitKey(engine core.MountPathEntity, key KeyEntity
 			Type: keyType,
 		}
 
-		if err := t.Core.MakeRequest(core.MethodPost, createPath, httpclient.JSON(request), nil); err != nil {
+		if err := t.Core.MakeRequest(ctx, core.MethodPost, createPath, httpclient.JSON(request), nil); err != nil {
 			log.Info("failed to create new transit key", "error", err)
 			return core.ErrAPIError.WithDetails("failed to create new transit key").WithCause(err)
 		}
@@ -85,7 +90,7 @@ func (t *transitAPI) UpdateTransitKey(engine core.MountPathEntity, key KeyEntity
 
 	if configUpdateRequired {
 		configPath := filepath.Join("/v1", path, "keys", keyName, "config")
-		if err := t.Core.MakeRequest(core.MethodPost, configPath, httpclient.JSON(keyConfig), nil); err != nil {
+		if err := t.Core.MakeRequest(ctx, core.MethodPost, configPath, httpclient.JSON(keyConfig), nil); err != nil {
 			log.Info("failed to update transit key configuration", "error", err)
 
 			var responseError *core.VaultHTTPError
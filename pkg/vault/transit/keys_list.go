@@ -1,11 +1,13 @@
 package transit
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -17,7 +19,10 @@ type listKeysData struct {
 	Keys []string `json:"keys"`
 }
 
-func (t *transitAPI) ListKeys(engine core.MountPathEntity) ([]KeyName, error) {
+func (t *transitAPI) ListKeys(ctx context.Context, engine core.MountPathEntity) ([]KeyName, error) {
+	ctx, span := tracing.Start(ctx, "vault.transit.ListKeys")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "ListKeys")
 
 	path, err := engine.GetMountPath()
@@ -31,7 +36,7 @@ func (t *transitAPI) ListKeys(engine core.MountPathEntity) ([]KeyName, error) {
 	keyPath := filepath.Join("/v1", path, "keys")
 
 	response := &listKeysResponse{}
-	if err := t.Core.MakeRequest(core.MethodList, keyPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodList, keyPath, nil, httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to list keys in transit engine", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -0,0 +1,66 @@
+package transit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+
+	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
+	"github.com/youniqx/heist/pkg/vault/core"
+)
+
+type rewrapRequest struct {
+	CipherText string `json:"ciphertext"`
+}
+
+type rewrapResponse struct {
+	Data rewrapResponseData `json:"data"`
+}
+
+type rewrapResponseData struct {
+	CipherText string `json:"ciphertext"`
+}
+
+func (t *transitAPI) TransitRewrap(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, cipherText string) (string, error) {
+	ctx, span := tracing.Start(ctx, "vault.transit.TransitRewrap")
+	defer span.End()
+
+	log := t.Core.Log().WithValues("method", "TransitRewrap")
+
+	path, err := engine.GetMountPath()
+	if err != nil {
+		log.Info("failed to get engine path", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to get engine path").WithCause(err)
+	}
+
+	log = log.WithValues("path", path)
+
+	keyName, err := key.GetTransitKeyName()
+	if err != nil {
+		log.Info("failed to get transit key name", "error", err)
+		return "", core.ErrAPIError.WithDetails("failed to get transit key name").WithCause(err)
+	}
+
+	log = log.WithValues("key", keyName)
+
+	rewrapPath := filepath.Join("/v1", path, "rewrap", keyName)
+	request := &rewrapRequest{
+		CipherText: cipherText,
+	}
+	response := &rewrapResponse{}
+
+	if err := t.Core.MakeRequest(ctx, core.MethodPost, rewrapPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+		log.Info("failed to rewrap cipher text", "error", err)
+
+		var responseError *core.VaultHTTPError
+		if errors.As(err, &responseError) && responseError.StatusCode == http.StatusNotFound {
+			return "", core.ErrDoesNotExist.WithCause(err)
+		}
+
+		return "", core.ErrAPIError.WithDetails("failed to rewrap cipher text").WithCause(err)
+	}
+
+	return response.Data.CipherText, nil
+}
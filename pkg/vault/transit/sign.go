@@ -1,11 +1,13 @@
 package transit
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -21,7 +23,10 @@ type signResponseData struct {
 	Signature string `json:"signature"`
 }
 
-func (t *transitAPI) TransitSign(engine core.MountPathEntity, key KeyNameEntity, input []byte) (string, error) {
+func (t *transitAPI) TransitSign(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, input []byte) (string, error) {
+	ctx, span := tracing.Start(ctx, "vault.transit.TransitSign")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "TransitSign")
 
 	path, err := engine.GetMountPath()
@@ -46,7 +51,7 @@ func (t *transitAPI) TransitSign(engine core.MountPathEntity, key KeyNameEntity,
 	}
 	response := &signResponse{}
 
-	if err := t.Core.MakeRequest(core.MethodPost, signPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodPost, signPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to sign data", "error", err)
 
 		var responseError *core.VaultHTTPError
@@ -1,11 +1,13 @@
 package transit
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"path/filepath"
 
 	"github.com/youniqx/heist/pkg/httpclient"
+	"github.com/youniqx/heist/pkg/tracing"
 	"github.com/youniqx/heist/pkg/vault/core"
 )
 
@@ -22,7 +24,10 @@ type verifyResponseData struct {
 	Valid bool `json:"valid"`
 }
 
-func (t *transitAPI) TransitVerify(engine core.MountPathEntity, key KeyNameEntity, input []byte, signature string) (bool, error) {
+func (t *transitAPI) TransitVerify(ctx context.Context, engine core.MountPathEntity, key KeyNameEntity, input []byte, signature string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "vault.transit.TransitVerify")
+	defer span.End()
+
 	log := t.Core.Log().WithValues("method", "TransitVerify")
 
 	path, err := engine.GetMountPath()
@@ -48,7 +53,7 @@ func (t *transitAPI) TransitVerify(engine core.MountPathEntity, key KeyNameEntit
 	}
 	response := &verifyResponse{}
 
-	if err := t.Core.MakeRequest(core.MethodPost, verifyPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
+	if err := t.Core.MakeRequest(ctx, core.MethodPost, verifyPath, httpclient.JSON(request), httpclient.JSON(response, httpclient.ConstraintSuccess)); err != nil {
 		log.Info("failed to verify signature", "error", err)
 
 		var responseError *core.VaultHTTPError